@@ -0,0 +1,275 @@
+package trusttrack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// ErrCircuitOpen is the cause of the [connect.CodeUnavailable] error returned
+// for requests rejected by an open circuit breaker.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker configures a circuit breaker that rejects requests while the API is failing.
+//
+// The breaker opens when the ratio of failed requests within a sliding window exceeds the failure ratio.
+// The window slides in steps of a tenth of its length.
+// After the open timeout it lets probe requests through, and closes again when they all succeed.
+// Transport errors, timeouts and server errors are failures.
+//
+// Zero fields use the defaults.
+type CircuitBreaker struct {
+	// FailureRatio is the ratio of failed requests that opens the breaker.
+	// Defaults to 0.5.
+	FailureRatio float64
+
+	// MinRequests is the minimum number of requests within a window before the breaker can open.
+	// Defaults to 10.
+	MinRequests int
+
+	// Window is the length of the sliding window over which failures are counted.
+	// Defaults to 1 minute.
+	Window time.Duration
+
+	// OpenTimeout is how long the breaker stays open before letting probe requests through.
+	// Defaults to 30 seconds.
+	OpenTimeout time.Duration
+
+	// HalfOpenProbes is the number of probe requests that must succeed to close the breaker.
+	// Defaults to 1.
+	HalfOpenProbes int
+
+	// OnStateChange, when set, is called when the breaker changes state.
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through.
+	CircuitHalfOpen
+)
+
+// String implements [fmt.Stringer].
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// windowBuckets is the number of buckets the window of a circuit breaker is divided into.
+const windowBuckets = 10
+
+// windowBucket counts the requests within a bucket of the window of a circuit breaker.
+type windowBucket struct {
+	requests int
+	failures int
+}
+
+// circuitBreaker is a circuit breaker shared by all requests of a [Client].
+type circuitBreaker struct {
+	config CircuitBreaker
+
+	mu             sync.Mutex
+	state          CircuitState
+	window         [windowBuckets]windowBucket
+	bucket         int
+	bucketStart    time.Time
+	openedAt       time.Time
+	probes         int
+	probeSuccesses int
+}
+
+func newCircuitBreaker(config CircuitBreaker) *circuitBreaker {
+	if config.FailureRatio <= 0 {
+		config.FailureRatio = 0.5
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = 10
+	}
+	if config.Window <= 0 {
+		config.Window = time.Minute
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = 30 * time.Second
+	}
+	if config.HalfOpenProbes <= 0 {
+		config.HalfOpenProbes = 1
+	}
+	return &circuitBreaker{config: config}
+}
+
+// allow reports whether a request may be sent, and whether it is a probe.
+func (b *circuitBreaker) allow(now time.Time) (allowed bool, probe bool) {
+	b.mu.Lock()
+	var notify func()
+	defer func() {
+		b.mu.Unlock()
+		if notify != nil {
+			notify()
+		}
+	}()
+	if b.state == CircuitOpen && now.Sub(b.openedAt) >= b.config.OpenTimeout {
+		notify = b.setState(CircuitHalfOpen, now)
+	}
+	switch b.state {
+	case CircuitOpen:
+		return false, false
+	case CircuitHalfOpen:
+		if b.probes >= b.config.HalfOpenProbes {
+			return false, false
+		}
+		b.probes++
+		return true, true
+	default:
+		return true, false
+	}
+}
+
+// record records the outcome of a request.
+func (b *circuitBreaker) record(now time.Time, probe bool, failed bool) {
+	b.mu.Lock()
+	var notify func()
+	defer func() {
+		b.mu.Unlock()
+		if notify != nil {
+			notify()
+		}
+	}()
+	switch b.state {
+	case CircuitHalfOpen:
+		if !probe {
+			return
+		}
+		if failed {
+			notify = b.setState(CircuitOpen, now)
+			return
+		}
+		b.probeSuccesses++
+		if b.probeSuccesses >= b.config.HalfOpenProbes {
+			notify = b.setState(CircuitClosed, now)
+		}
+	case CircuitClosed:
+		b.advance(now)
+		b.window[b.bucket].requests++
+		if failed {
+			b.window[b.bucket].failures++
+		}
+		var requests, failures int
+		for _, bucket := range b.window {
+			requests += bucket.requests
+			failures += bucket.failures
+		}
+		if requests >= b.config.MinRequests &&
+			float64(failures)/float64(requests) >= b.config.FailureRatio {
+			notify = b.setState(CircuitOpen, now)
+		}
+	}
+}
+
+// advance slides the window to now, clearing the buckets that fell out of it.
+// It must be called with the lock held.
+func (b *circuitBreaker) advance(now time.Time) {
+	width := max(b.config.Window/windowBuckets, 1)
+	if b.bucketStart.IsZero() {
+		b.bucketStart = now
+		return
+	}
+	steps := int(now.Sub(b.bucketStart) / width)
+	if steps <= 0 {
+		return
+	}
+	for range min(steps, windowBuckets) {
+		b.bucket = (b.bucket + 1) % windowBuckets
+		b.window[b.bucket] = windowBucket{}
+	}
+	b.bucketStart = b.bucketStart.Add(time.Duration(steps) * width)
+}
+
+// setState changes the state of the breaker, and returns the state change callback to call, if any.
+// It must be called with the lock held.
+func (b *circuitBreaker) setState(state CircuitState, now time.Time) func() {
+	from := b.state
+	b.state = state
+	b.probes, b.probeSuccesses = 0, 0
+	switch state {
+	case CircuitOpen:
+		b.openedAt = now
+	case CircuitClosed:
+		b.window, b.bucket, b.bucketStart = [windowBuckets]windowBucket{}, 0, now
+	}
+	if b.config.OnStateChange == nil || from == state {
+		return nil
+	}
+	return func() { b.config.OnStateChange(from, state) }
+}
+
+func (b *circuitBreaker) currentState() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// release returns an unused probe of a half-open breaker.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+// circuitBreakerTransport is an HTTP transport that rejects requests while the circuit breaker is open.
+type circuitBreakerTransport struct {
+	breaker *circuitBreaker
+	next    http.RoundTripper
+}
+
+var _ http.RoundTripper = &circuitBreakerTransport{}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	allowed, probe := t.breaker.allow(time.Now())
+	if !allowed {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, connect.NewError(connect.CodeUnavailable, ErrCircuitOpen)
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil && errors.Is(err, context.Canceled) && errors.Is(req.Context().Err(), context.Canceled) {
+		// Requests canceled by the caller say nothing about the health of the API.
+		// Timeouts, including the client timeout, are failures.
+		if probe {
+			t.breaker.release()
+		}
+		return res, err
+	}
+	t.breaker.record(time.Now(), probe, err != nil || res.StatusCode >= 500)
+	return res, err
+}
+
+// CircuitState returns the state of the circuit breaker.
+// It returns [CircuitClosed] when no circuit breaker is configured with [WithCircuitBreaker].
+func (c *Client) CircuitState() CircuitState {
+	if c.config.circuitBreaker == nil {
+		return CircuitClosed
+	}
+	return c.config.circuitBreaker.currentState()
+}
@@ -1,10 +1,18 @@
 package cli
 
 import (
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"buf.build/go/protovalidate"
@@ -15,6 +23,7 @@ import (
 	"golang.org/x/term"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -37,12 +46,32 @@ func NewCommand(opts ...Option) *cobra.Command {
 	cmd.AddCommand(newGetObjectGroupCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "drivers", Title: "Drivers"})
 	cmd.AddCommand(newListDriversCommand(&cfg))
+	cmd.AddCommand(newListDriverStatesCommand(&cfg))
+	cmd.AddCommand(newListDriverViolationsCommand(&cfg))
+	cmd.AddCommand(newDriverCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "coordinates", Title: "Coordinates"})
 	cmd.AddCommand(newListObjectCoordinatesCommand(&cfg))
+	cmd.AddCommand(newGetObjectCoordinateCommand(&cfg))
+	cmd.AddCommand(newStreamObjectCoordinatesCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "trips", Title: "Trips"})
 	cmd.AddCommand(newListTripsCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "fuel-events", Title: "Fuel Events"})
 	cmd.AddCommand(newListFuelEventsCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "ecodriving", Title: "Ecodriving"})
+	cmd.AddCommand(newEcodrivingCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "country-visits", Title: "Country Visits"})
+	cmd.AddCommand(newCountryVisitsCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "detected-events", Title: "Detected Events"})
+	cmd.AddCommand(newListDetectedEventsCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "geozones", Title: "Geozones"})
+	cmd.AddCommand(newListGeozonesCommand(&cfg))
+	cmd.AddCommand(newListGeozoneVisitsCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "share-links", Title: "Share Links"})
+	cmd.AddCommand(newShareLinksCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "tacho", Title: "Tachograph"})
+	cmd.AddCommand(newTachoCommand(&cfg))
+	cmd.AddGroup(&cobra.Group{ID: "users", Title: "Users"})
+	cmd.AddCommand(newListUsersCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "auth", Title: "Authentication"})
 	cmd.AddCommand(newAuthCommand(&cfg))
 	cmd.AddGroup(&cobra.Group{ID: "utils", Title: "Utils"})
@@ -150,15 +179,27 @@ func resolveCredentials(cfg *config) (*Credentials, error) {
 
 func newListObjectsCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "objects",
-		Short:   "List objects",
+		Use:     "objects [object-id]",
+		Short:   "List objects, or get a single object",
 		GroupID: "objects",
+		Args:    cobra.MaximumNArgs(1),
 	}
-	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
+		if len(args) > 0 {
+			response, err := client.GetObject(cmd.Context(), trusttrackv1.GetObjectRequest_builder{
+				ObjectId: new(args[0]),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			printJSON(cmd, response.GetObject())
+			validate(cmd, response.GetObject())
+			return nil
+		}
 		response, err := client.ListObjects(cmd.Context(), trusttrackv1.ListObjectsRequest_builder{}.Build())
 		if err != nil {
 			return err
@@ -174,31 +215,36 @@ func newListObjectsCommand(cfg *config) *cobra.Command {
 
 func newListObjectsLastPositionCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "objects-last-position",
-		Short:   "List objects with their last position",
+		Use:     "objects-last-position [object-id]",
+		Short:   "List objects with their last position, or get a single object",
 		GroupID: "objects",
+		Args:    cobra.MaximumNArgs(1),
 	}
-	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
+		if len(args) > 0 {
+			response, err := client.GetObjectLastPosition(cmd.Context(), trusttrackv1.GetObjectLastPositionRequest_builder{
+				ObjectId: new(args[0]),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			printJSON(cmd, response.GetObject())
+			validate(cmd, response.GetObject())
+			return nil
+		}
 		request := trusttrackv1.ListObjectsLastPositionRequest_builder{
 			Limit: new(int32(1000)),
 		}.Build()
-		for {
-			response, err := client.ListObjectsLastPosition(cmd.Context(), request)
+		for object, err := range client.AllObjectsLastPosition(cmd.Context(), request) {
 			if err != nil {
 				return err
 			}
-			for _, object := range response.GetObjects() {
-				printJSON(cmd, object)
-				validate(cmd, object)
-			}
-			if response.GetContinuationToken() == "" {
-				break
-			}
-			request.SetContinuationToken(response.GetContinuationToken())
+			printJSON(cmd, object)
+			validate(cmd, object)
 		}
 		return nil
 	}
@@ -226,6 +272,8 @@ func newListObjectCoordinatesCommand(cfg *config) *cobra.Command {
 	)
 	includeGeozones := cmd.Flags().Bool("include-geozones", false, "Include geozone information")
 	includeTireParameters := cmd.Flags().Bool("include-tire-parameters", false, "Include tire pressure information")
+	includeNearestGeozone := cmd.Flags().Bool("include-nearest-geozone", false, "Include the nearest geozone (API version 3)")
+	apiVersion := cmd.Flags().Int32("api-version", 0, "Coordinates history API version (2 or 3)")
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
@@ -238,26 +286,90 @@ func newListObjectCoordinatesCommand(cfg *config) *cobra.Command {
 			Limit:                 new(int32(1000)),
 			IncludeGeozones:       new(*includeGeozones),
 			IncludeTireParameters: new(*includeTireParameters),
+			IncludeNearestGeozone: new(*includeNearestGeozone),
+			ApiVersion:            new(*apiVersion),
 		}.Build()
-		for {
-			response, err := client.ListObjectCoordinates(cmd.Context(), request)
+		for coordinate, err := range client.AllObjectCoordinates(cmd.Context(), request) {
 			if err != nil {
 				return err
 			}
-			for _, coordinate := range response.GetCoordinates() {
-				printJSON(cmd, coordinate)
-				validate(cmd, coordinate)
-			}
-			if response.GetContinuationToken() == "" {
-				break
-			}
-			request.SetContinuationToken(response.GetContinuationToken())
+			printJSON(cmd, coordinate)
+			validate(cmd, coordinate)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetObjectCoordinateCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "coordinate-at [object-id]",
+		Short:   "Get the object coordinate recorded at a specific time",
+		GroupID: "coordinates",
+		Args:    cobra.ExactArgs(1),
+	}
+	at := cmd.Flags().Time("time", time.Time{}, []string{time.RFC3339}, "Coordinate time (RFC3339 format)")
+	_ = cmd.MarkFlagRequired("time")
+	includeGeozones := cmd.Flags().Bool("include-geozones", false, "Include geozone information")
+	includeTireParameters := cmd.Flags().Bool("include-tire-parameters", false, "Include tire pressure information")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetObjectCoordinate(cmd.Context(),
+			trusttrackv1.GetObjectCoordinateRequest_builder{
+				ObjectId:              new(args[0]),
+				Time:                  timestamppb.New(*at),
+				IncludeGeozones:       new(*includeGeozones),
+				IncludeTireParameters: new(*includeTireParameters),
+			}.Build())
+		if err != nil {
+			return err
 		}
+		printJSON(cmd, response.GetCoordinate())
+		validate(cmd, response.GetCoordinate())
 		return nil
 	}
 	return cmd
 }
 
+func newStreamObjectCoordinatesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stream [object-id]",
+		Short:   "Stream real-time coordinates of all objects or a single object",
+		GroupID: "coordinates",
+		Args:    cobra.MaximumNArgs(1),
+	}
+	includeGeozones := cmd.Flags().Bool("include-geozones", false, "Include geozone information")
+	includeTireParameters := cmd.Flags().Bool("include-tire-parameters", false, "Include tire pressure information")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.StreamObjectCoordinatesRequest_builder{
+			IncludeGeozones:       new(*includeGeozones),
+			IncludeTireParameters: new(*includeTireParameters),
+		}.Build()
+		if len(args) > 0 {
+			request.SetObjectId(args[0])
+		}
+		stream, err := client.StreamObjectCoordinates(cmd.Context(), request)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = stream.Close() }()
+		for stream.Receive() {
+			coordinate := stream.Msg().GetCoordinate()
+			printJSON(cmd, coordinate)
+			validate(cmd, coordinate)
+		}
+		return stream.Err()
+	}
+	return cmd
+}
+
 func newListObjectGroupsCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "object-groups",
@@ -272,19 +384,12 @@ func newListObjectGroupsCommand(cfg *config) *cobra.Command {
 		request := trusttrackv1.ListObjectGroupsRequest_builder{
 			Limit: new(int32(1000)),
 		}.Build()
-		for {
-			response, err := client.ListObjectGroups(cmd.Context(), request)
+		for objectGroup, err := range client.AllObjectGroups(cmd.Context(), request) {
 			if err != nil {
 				return err
 			}
-			for _, objectGroup := range response.GetObjectGroups() {
-				printJSON(cmd, objectGroup)
-				validate(cmd, objectGroup)
-			}
-			if response.GetContinuationToken() == "" {
-				break
-			}
-			request.SetContinuationToken(response.GetContinuationToken())
+			printJSON(cmd, objectGroup)
+			validate(cmd, objectGroup)
 		}
 		return nil
 	}
@@ -298,6 +403,7 @@ func newGetObjectGroupCommand(cfg *config) *cobra.Command {
 		GroupID: "object-groups",
 		Args:    cobra.ExactArgs(1),
 	}
+	cmd.AddCommand(newSetObjectGroupMembersCommand(cfg))
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		client, err := newClient(cmd, cfg)
 		if err != nil {
@@ -317,12 +423,105 @@ func newGetObjectGroupCommand(cfg *config) *cobra.Command {
 	return cmd
 }
 
+func newSetObjectGroupMembersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-members [external-id] [object-id...]",
+		Short: "Set the member objects of an object group",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	file := cmd.Flags().StringP("file", "f", "", "File with one object ID per line, or - for stdin")
+	dryRun := cmd.Flags().Bool("dry-run", false, "Print the changes without updating the object group")
+	clearMembers := cmd.Flags().Bool("clear", false, "Allow removing all member objects when no object IDs are given")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		objectIDs := args[1:]
+		if *file != "" {
+			var data []byte
+			var err error
+			if *file == "-" {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				data, err = os.ReadFile(*file)
+			}
+			if err != nil {
+				return err
+			}
+			for line := range strings.Lines(string(data)) {
+				if objectID := strings.TrimSpace(line); objectID != "" {
+					objectIDs = append(objectIDs, objectID)
+				}
+			}
+		}
+		if len(objectIDs) == 0 && !*clearMembers {
+			return fmt.Errorf("no object IDs given, use --clear to remove all member objects")
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		current, err := client.GetObjectGroup(cmd.Context(), trusttrackv1.GetObjectGroupRequest_builder{
+			ExternalId: new(args[0]),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		desired := make(map[string]bool, len(objectIDs))
+		for _, objectID := range objectIDs {
+			desired[objectID] = true
+		}
+		existing := make(map[string]bool, len(current.GetObjectGroup().GetObjectIds()))
+		var add, remove []string
+		for _, objectID := range current.GetObjectGroup().GetObjectIds() {
+			existing[objectID] = true
+			if !desired[objectID] {
+				remove = append(remove, objectID)
+			}
+		}
+		for _, objectID := range objectIDs {
+			if !existing[objectID] {
+				existing[objectID] = true
+				add = append(add, objectID)
+			}
+		}
+		addStyle := lipgloss.NewStyle().Foreground(lipgloss.Green)
+		removeStyle := lipgloss.NewStyle().Foreground(lipgloss.Red)
+		for _, objectID := range add {
+			cmd.Println(addStyle.Render("+ " + objectID))
+		}
+		for _, objectID := range remove {
+			cmd.Println(removeStyle.Render("- " + objectID))
+		}
+		if len(add) == 0 && len(remove) == 0 {
+			cmd.Println("No changes.")
+			return nil
+		}
+		if *dryRun {
+			cmd.Printf("Dry run: %d to add, %d to remove.\n", len(add), len(remove))
+			return nil
+		}
+		response, err := client.UpdateObjectGroup(cmd.Context(), trusttrackv1.UpdateObjectGroupRequest_builder{
+			ExternalId:      new(args[0]),
+			AddObjectIds:    add,
+			RemoveObjectIds: remove,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetObjectGroup())
+		validate(cmd, response.GetObjectGroup())
+		return nil
+	}
+	return cmd
+}
+
 func newListDriversCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "drivers",
 		Short:   "List drivers",
 		GroupID: "drivers",
 	}
+	cmd.AddCommand(newCreateDriverCommand(cfg))
+	cmd.AddCommand(newUpdateDriverCommand(cfg))
+	cmd.AddCommand(newDeleteDriverCommand(cfg))
 	identifierType := cmd.Flags().String("identifier-type", "", "Filter by identifier type")
 	identifier := cmd.Flags().String("identifier", "", "Filter by identifier value")
 	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
@@ -338,103 +537,1336 @@ func newListDriversCommand(cfg *config) *cobra.Command {
 			IdentifierType: new(*identifierType),
 			Identifier:     new(*identifier),
 		}.Build()
-		for {
-			response, err := client.ListDrivers(cmd.Context(), request)
+		for driver, err := range client.AllDrivers(cmd.Context(), request) {
 			if err != nil {
 				return err
 			}
-			for _, driver := range response.GetDrivers() {
-				printJSON(cmd, driver)
-				validate(cmd, driver)
-			}
-			if response.GetContinuationToken() == "" {
-				break
-			}
-			request.SetContinuationToken(response.GetContinuationToken())
+			printJSON(cmd, driver)
+			validate(cmd, driver)
 		}
 		return nil
 	}
 	return cmd
 }
 
-func newListTripsCommand(cfg *config) *cobra.Command {
+func newCreateDriverCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "trips [object-id]",
-		Short:   "List trips for an object",
-		GroupID: "trips",
-		Args:    cobra.ExactArgs(1),
+		Use:   "create",
+		Short: "Create a driver from protojson",
+		Args:  cobra.NoArgs,
 	}
-	fromTime := cmd.Flags().Time(
-		"from", time.Now().Add(-24*time.Hour), []string{time.RFC3339}, "From time (RFC3339 format)",
-	)
-	toTime := cmd.Flags().Time(
-		"to", time.Time{}, []string{time.RFC3339}, "To time (RFC3339 format, optional)",
+	file := cmd.Flags().StringP("file", "f", "-", "File with the driver as protojson, or - for stdin")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		var driver trusttrackv1.Driver
+		if err := readProtoJSON(cmd, *file, &driver); err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateDriver(cmd.Context(), trusttrackv1.CreateDriverRequest_builder{
+			Driver: &driver,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetDriver())
+		validate(cmd, response.GetDriver())
+		return nil
+	}
+	return cmd
+}
+
+func newUpdateDriverCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [driver-id]",
+		Short: "Update a driver from protojson",
+		Args:  cobra.ExactArgs(1),
+	}
+	file := cmd.Flags().StringP("file", "f", "-", "File with the driver as protojson, or - for stdin")
+	updateMask := cmd.Flags().StringSlice(
+		"update-mask", nil, "Fields to update, e.g. first_name,phone (default: replace all fields)",
 	)
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var driver trusttrackv1.Driver
+		if err := readProtoJSON(cmd, *file, &driver); err != nil {
+			return err
+		}
+		driver.SetId(args[0])
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		b := trusttrackv1.ListTripsRequest_builder{
-			ObjectId: new(args[0]),
-			FromTime: timestamppb.New(*fromTime),
-			Limit:    new(int32(1000)),
+		response, err := client.UpdateDriver(cmd.Context(), trusttrackv1.UpdateDriverRequest_builder{
+			Driver:     &driver,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: *updateMask},
+		}.Build())
+		if err != nil {
+			return err
 		}
-		if !toTime.IsZero() {
-			b.ToTime = timestamppb.New(*toTime)
+		printJSON(cmd, response.GetDriver())
+		validate(cmd, response.GetDriver())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteDriverCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [driver-id]",
+		Short: "Delete a driver",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
 		}
-		request := b.Build()
-		for {
-			response, err := client.ListTrips(cmd.Context(), request)
-			if err != nil {
-				return err
-			}
-			for _, trip := range response.GetTrips() {
-				printJSON(cmd, trip)
-				validate(cmd, trip)
-			}
-			if response.GetContinuationToken() == "" {
-				break
-			}
-			request.SetContinuationToken(response.GetContinuationToken())
+		if _, err := client.DeleteDriver(cmd.Context(), trusttrackv1.DeleteDriverRequest_builder{
+			DriverId: new(args[0]),
+		}.Build()); err != nil {
+			return err
 		}
+		cmd.Printf("Deleted driver %s.\n", args[0])
 		return nil
 	}
 	return cmd
 }
 
-func newListFuelEventsCommand(cfg *config) *cobra.Command {
+// readProtoJSON reads a protojson message from a file, or from stdin when the path is "-".
+func readProtoJSON(cmd *cobra.Command, path string, msg proto.Message) error {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(data, msg)
+}
+
+func newDriverCommand(cfg *config) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "fuel-events [object-id]",
-		Short:   "List fuel events for an object",
-		GroupID: "fuel-events",
-		Args:    cobra.ExactArgs(1),
+		Use:     "driver",
+		Short:   "Manage drivers",
+		GroupID: "drivers",
 	}
-	fromTime := cmd.Flags().Time(
-		"from", time.Now().Add(-7*24*time.Hour), []string{time.RFC3339}, "From time (RFC3339 format)",
-	)
-	toTime := cmd.Flags().Time(
-		"to", time.Now(), []string{time.RFC3339}, "To time (RFC3339 format)",
+	cmd.AddCommand(newCreateDriverAssignationCommand(cfg))
+	cmd.AddCommand(newGetLastDriverAssignationCommand(cfg))
+	cmd.AddCommand(newGetDriverTimeAnalysisCommand(cfg))
+	cmd.AddCommand(newGetDriverTimeTableCommand(cfg))
+	return cmd
+}
+
+func newCreateDriverAssignationCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assign [driver-id] [object-id]",
+		Short: "Assign a driver to an object",
+		Args:  cobra.ExactArgs(2),
+	}
+	event := cmd.Flags().String("event", "start", "Assignation event type (start or stop)")
+	eventTime := cmd.Flags().Time(
+		"time", time.Now(), []string{time.RFC3339}, "Assignation event time (RFC3339 format)",
 	)
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		eventType, ok := trusttrackv1.DriverAssignation_EventType_value[strings.ToUpper(*event)]
+		if !ok {
+			return fmt.Errorf("unknown event type: %s", *event)
+		}
 		client, err := newClient(cmd, cfg)
 		if err != nil {
 			return err
 		}
-		request := trusttrackv1.ListFuelEventsRequest_builder{
-			ObjectId: new(args[0]),
-			FromTime: timestamppb.New(*fromTime),
-			ToTime:   timestamppb.New(*toTime),
-			Limit:    new(int32(1000)),
+		response, err := client.CreateDriverAssignation(cmd.Context(),
+			trusttrackv1.CreateDriverAssignationRequest_builder{
+				DriverAssignation: trusttrackv1.DriverAssignation_builder{
+					DriverId:  new(args[0]),
+					ObjectId:  new(args[1]),
+					Time:      timestamppb.New(*eventTime),
+					EventType: new(trusttrackv1.DriverAssignation_EventType(eventType)),
+				}.Build(),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetDriverAssignation())
+		validate(cmd, response.GetDriverAssignation())
+		return nil
+	}
+	return cmd
+}
+
+func newGetLastDriverAssignationCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-assignation",
+		Short: "Get the last assignation of a driver or an object",
+	}
+	driverID := cmd.Flags().String("driver-id", "", "Get the last assignation of this driver")
+	objectID := cmd.Flags().String("object-id", "", "Get the last assignation of this object")
+	cmd.MarkFlagsOneRequired("driver-id", "object-id")
+	cmd.MarkFlagsMutuallyExclusive("driver-id", "object-id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetLastDriverAssignation(cmd.Context(),
+			trusttrackv1.GetLastDriverAssignationRequest_builder{
+				DriverId: new(*driverID),
+				ObjectId: new(*objectID),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetDriverAssignation())
+		validate(cmd, response.GetDriverAssignation())
+		return nil
+	}
+	return cmd
+}
+
+func newGetDriverTimeAnalysisCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-analysis [driver-id]",
+		Short: "Get the current driving and rest times of a driver",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetDriverTimeAnalysis(cmd.Context(),
+			trusttrackv1.GetDriverTimeAnalysisRequest_builder{
+				DriverId: new(args[0]),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		analysis := response.GetDriverTimeAnalysis()
+		printJSON(cmd, analysis)
+		validate(cmd, analysis)
+		fmt.Fprintf(
+			cmd.OutOrStdout(),
+			"Remaining daily driving time: %s (extended: %s)\n",
+			remainingDuration(analysis.GetCurrentDay().GetRegularDriving()),
+			remainingDuration(analysis.GetCurrentDay().GetExtendedDriving()),
+		)
+		fmt.Fprintf(
+			cmd.OutOrStdout(),
+			"Remaining weekly driving time: %s\n",
+			remainingDuration(analysis.GetCurrentWeek().GetDriving()),
+		)
+		return nil
+	}
+	return cmd
+}
+
+// remainingDuration formats the time left until the limit is reached, which is zero if the limit is exceeded.
+// It returns "unknown" when the API reports no limit, rather than suggesting that no time is left.
+func remainingDuration(d *trusttrackv1.DriverTimeAnalysis_LimitedDuration) string {
+	if !d.HasLimit() {
+		return "unknown"
+	}
+	return max(0, d.GetLimit().AsDuration()-d.GetDuration().AsDuration()).String()
+}
+
+func newGetDriverTimeTableCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-table [identifier]",
+		Short: "Get the monthly working time table of a driver",
+		Args:  cobra.ExactArgs(1),
+	}
+	now := time.Now()
+	identifierType := cmd.Flags().String("type", "tachograph", "Identifier type (tachograph, ibutton, wireless or dlt)")
+	timeZone := cmd.Flags().String("time-zone", "UTC", "IANA time zone of the time table")
+	month := cmd.Flags().Int32("month", int32(now.Month()), "Month of the time table")
+	year := cmd.Flags().Int32("year", int32(now.Year()), "Year of the time table")
+	businessHoursStart := cmd.Flags().String("business-hours-start", "06:00", "Start of business hours (HH:MM)")
+	businessHoursEnd := cmd.Flags().String("business-hours-end", "22:00", "End of business hours (HH:MM)")
+	availableAsWorkingTime := cmd.Flags().Bool(
+		"available-as-working-time", false, "Count time with the available status as working time",
+	)
+	asCSV := cmd.Flags().Bool("csv", false, "Print the time table as CSV")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		typeValue, ok := trusttrackv1.DriverIdentifier_IdentifierType_value[strings.ToUpper(*identifierType)]
+		if !ok {
+			return fmt.Errorf("unknown identifier type: %s", *identifierType)
+		}
+		location, err := time.LoadLocation(*timeZone)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.GetDriverTimeTableRequest_builder{
+			DriverIdentifier: trusttrackv1.DriverIdentifier_builder{
+				Identifier: new(args[0]),
+				Type:       new(trusttrackv1.DriverIdentifier_IdentifierType(typeValue)),
+			}.Build(),
+			TimeZone:               new(*timeZone),
+			Month:                  new(*month),
+			Year:                   new(*year),
+			BusinessHoursStart:     new(*businessHoursStart),
+			BusinessHoursEnd:       new(*businessHoursEnd),
+			AvailableAsWorkingTime: new(*availableAsWorkingTime),
+		}.Build()
+		if err := protovalidate.Validate(request); err != nil {
+			return err
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.GetDriverTimeTable(cmd.Context(), request)
+		if err != nil {
+			return err
+		}
+		timeTable := response.GetDriverTimeTable()
+		if *asCSV {
+			return writeDriverTimeTableCSV(cmd.OutOrStdout(), timeTable, location)
+		}
+		printJSON(cmd, timeTable)
+		validate(cmd, timeTable)
+		return nil
+	}
+	return cmd
+}
+
+// writeDriverTimeTableCSV writes one row per day of the time table, with working times in hours.
+func writeDriverTimeTableCSV(w io.Writer, timeTable *trusttrackv1.DriverTimeTable, location *time.Location) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{
+		"identifier", "driver_name", "date", "holiday", "day_work_h", "night_work_h", "total_work_h",
+	})
+	hours := func(seconds float64) string {
+		return strconv.FormatFloat(seconds/3600, 'f', 2, 64)
+	}
+	for _, day := range timeTable.GetDays() {
+		_ = cw.Write([]string{
+			timeTable.GetIdentifier(),
+			timeTable.GetDriverName(),
+			day.GetDate().AsTime().In(location).Format(time.DateOnly),
+			strconv.FormatBool(day.GetHoliday()),
+			hours(day.GetDayWorkDurationS()),
+			hours(day.GetNightWorkDurationS()),
+			hours(day.GetDayWorkDurationS() + day.GetNightWorkDurationS()),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func newListDriverStatesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "driver-states [driver-id]",
+		Short:   "List tachograph activity periods of a driver for a time period",
+		GroupID: "drivers",
+		Args:    cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from",
+		time.Now().Add(-24*time.Hour),
+		[]string{time.DateOnly, time.RFC3339},
+		"From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to",
+		time.Now(),
+		[]string{time.DateOnly, time.RFC3339},
+		"To time",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListDriverStatesRequest_builder{
+			DriverId: new(args[0]),
+			FromTime: timestamppb.New(*fromTime),
+			ToTime:   timestamppb.New(*toTime),
+		}.Build()
+		for {
+			response, err := client.ListDriverStates(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, driverState := range response.GetDriverStates() {
+				printJSON(cmd, driverState)
+				validate(cmd, driverState)
+			}
+			if response.GetContinuationToken() == "" {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListDriverViolationsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "driver-violations",
+		Short:   "List driving and rest time violations of drivers",
+		GroupID: "drivers",
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-7*24*time.Hour), []string{time.DateOnly, time.RFC3339}, "From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time",
+	)
+	cardNumbers := cmd.Flags().StringSlice("card-number", nil, "Filter by driver card numbers")
+	countries := cmd.Flags().StringSlice("country", nil, "Filter by country codes")
+	vehicles := cmd.Flags().StringSlice("vehicle", nil, "Filter by vehicles")
+	severities := cmd.Flags().StringSlice("severity", nil, "Filter by severities (e.g. SERIOUS, MOST_SERIOUS)")
+	types := cmd.Flags().StringSlice("type", nil, "Filter by violation types (e.g. WEEKLY_DRIVING_LIMIT_EXCEEDED)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		b := trusttrackv1.ListDriverViolationsRequest_builder{
+			CardNumbers: *cardNumbers,
+			Countries:   *countries,
+			Vehicles:    *vehicles,
+			FromTime:    timestamppb.New(*fromTime),
+			ToTime:      timestamppb.New(*toTime),
+			Limit:       new(int32(1000)),
+		}
+		for _, severity := range *severities {
+			value, ok := trusttrackv1.Violation_Severity_value[strings.ToUpper(severity)]
+			if !ok {
+				return fmt.Errorf("unknown severity: %s", severity)
+			}
+			b.Severities = append(b.Severities, trusttrackv1.Violation_Severity(value))
+		}
+		for _, violationType := range *types {
+			value, ok := trusttrackv1.Violation_Type_value[strings.ToUpper(violationType)]
+			if !ok {
+				return fmt.Errorf("unknown violation type: %s", violationType)
+			}
+			b.Types = append(b.Types, trusttrackv1.Violation_Type(value))
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := b.Build()
+		for {
+			response, err := client.ListDriverViolations(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, violation := range response.GetViolations() {
+				printJSON(cmd, violation)
+				validate(cmd, violation)
+			}
+			if response.GetContinuationToken() == "" {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListTripsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trips [object-id]",
+		Short:   "List trips for an object",
+		GroupID: "trips",
+		Args:    cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-24*time.Hour), []string{time.RFC3339}, "From time (RFC3339 format)",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Time{}, []string{time.RFC3339}, "To time (RFC3339 format, optional)",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		b := trusttrackv1.ListTripsRequest_builder{
+			ObjectId: new(args[0]),
+			FromTime: timestamppb.New(*fromTime),
+			Limit:    new(int32(1000)),
+		}
+		if !toTime.IsZero() {
+			b.ToTime = timestamppb.New(*toTime)
+		}
+		request := b.Build()
+		for trip, err := range client.AllTrips(cmd.Context(), request) {
+			if err != nil {
+				return err
+			}
+			printJSON(cmd, trip)
+			validate(cmd, trip)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListFuelEventsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fuel-events [object-id]",
+		Short:   "List fuel events for an object",
+		GroupID: "fuel-events",
+		Args:    cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-7*24*time.Hour), []string{time.RFC3339}, "From time (RFC3339 format)",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Now(), []string{time.RFC3339}, "To time (RFC3339 format)",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListFuelEventsRequest_builder{
+			ObjectId: new(args[0]),
+			FromTime: timestamppb.New(*fromTime),
+			ToTime:   timestamppb.New(*toTime),
+			Limit:    new(int32(1000)),
+		}.Build()
+		for fuelEvent, err := range client.AllFuelEvents(cmd.Context(), request) {
+			if err != nil {
+				return err
+			}
+			printJSON(cmd, fuelEvent)
+			validate(cmd, fuelEvent)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newEcodrivingCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ecodriving",
+		Short:   "Get ecodriving parameters",
+		GroupID: "ecodriving",
+	}
+	cmd.AddCommand(newListObjectEcodrivingCommand(cfg))
+	cmd.AddCommand(newListDriverEcodrivingCommand(cfg))
+	return cmd
+}
+
+func newListObjectEcodrivingCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "object [object-id]",
+		Short: "Get ecodriving parameters of an object for a time period",
+		Args:  cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from",
+		time.Now().Add(-7*24*time.Hour),
+		[]string{time.DateOnly, time.RFC3339},
+		"From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to",
+		time.Now(),
+		[]string{time.DateOnly, time.RFC3339},
+		"To time",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListObjectEcodriving(cmd.Context(), trusttrackv1.ListObjectEcodrivingRequest_builder{
+			ObjectId: new(args[0]),
+			FromTime: timestamppb.New(*fromTime),
+			ToTime:   timestamppb.New(*toTime),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetEcodriving())
+		validate(cmd, response.GetEcodriving())
+		return nil
+	}
+	return cmd
+}
+
+func newListDriverEcodrivingCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "driver [driver-id]",
+		Short: "Get ecodriving parameters of a driver for a time period",
+		Args:  cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from",
+		time.Now().Add(-7*24*time.Hour),
+		[]string{time.DateOnly, time.RFC3339},
+		"From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to",
+		time.Now(),
+		[]string{time.DateOnly, time.RFC3339},
+		"To time",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ListDriverEcodriving(cmd.Context(), trusttrackv1.ListDriverEcodrivingRequest_builder{
+			DriverId: new(args[0]),
+			FromTime: timestamppb.New(*fromTime),
+			ToTime:   timestamppb.New(*toTime),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetEcodriving())
+		validate(cmd, response.GetEcodriving())
+		return nil
+	}
+	return cmd
+}
+
+func newCountryVisitsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "country-visits",
+		Short:   "List country visits",
+		GroupID: "country-visits",
+	}
+	cmd.AddCommand(newListCountryVisitsCommand(
+		cfg,
+		"object [object-id]",
+		"List country visits of an object for a time period",
+		func(cmd *cobra.Command, client *trusttrack.Client, request *countryVisitsRequest) ([]*trusttrackv1.CountryVisit, string, error) {
+			response, err := client.ListObjectCountryVisits(cmd.Context(), trusttrackv1.ListObjectCountryVisitsRequest_builder{
+				ObjectId:          new(request.id),
+				FromTime:          request.fromTime,
+				ToTime:            request.toTime,
+				ContinuationToken: new(request.continuationToken),
+			}.Build())
+			return response.GetCountryVisits(), response.GetContinuationToken(), err
+		},
+	))
+	cmd.AddCommand(newListCountryVisitsCommand(
+		cfg,
+		"driver [driver-id]",
+		"List country visits of a driver for a time period",
+		func(cmd *cobra.Command, client *trusttrack.Client, request *countryVisitsRequest) ([]*trusttrackv1.CountryVisit, string, error) {
+			response, err := client.ListDriverCountryVisits(cmd.Context(), trusttrackv1.ListDriverCountryVisitsRequest_builder{
+				DriverId:          new(request.id),
+				FromTime:          request.fromTime,
+				ToTime:            request.toTime,
+				ContinuationToken: new(request.continuationToken),
+			}.Build())
+			return response.GetCountryVisits(), response.GetContinuationToken(), err
+		},
+	))
+	return cmd
+}
+
+type countryVisitsRequest struct {
+	id                string
+	fromTime          *timestamppb.Timestamp
+	toTime            *timestamppb.Timestamp
+	continuationToken string
+}
+
+func newListCountryVisitsCommand(
+	cfg *config,
+	use string,
+	short string,
+	list func(*cobra.Command, *trusttrack.Client, *countryVisitsRequest) ([]*trusttrackv1.CountryVisit, string, error),
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from",
+		time.Now().Add(-30*24*time.Hour),
+		[]string{time.DateOnly, time.RFC3339},
+		"From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to",
+		time.Now(),
+		[]string{time.DateOnly, time.RFC3339},
+		"To time",
+	)
+	totals := cmd.Flags().Bool("totals", false, "Print totals per country instead of individual visits")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := &countryVisitsRequest{
+			id:       args[0],
+			fromTime: timestamppb.New(*fromTime),
+			toTime:   timestamppb.New(*toTime),
+		}
+		var countries []string
+		countryTotals := map[string]*countryVisitTotals{}
+		for {
+			countryVisits, continuationToken, err := list(cmd, client, request)
+			if err != nil {
+				return err
+			}
+			for _, countryVisit := range countryVisits {
+				if !*totals {
+					printJSON(cmd, countryVisit)
+					validate(cmd, countryVisit)
+					continue
+				}
+				t, ok := countryTotals[countryVisit.GetCountryCode()]
+				if !ok {
+					t = &countryVisitTotals{}
+					countryTotals[countryVisit.GetCountryCode()] = t
+					countries = append(countries, countryVisit.GetCountryCode())
+				}
+				t.add(countryVisit)
+			}
+			if continuationToken == "" {
+				break
+			}
+			request.continuationToken = continuationToken
+		}
+		if *totals {
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "COUNTRY\tVISITS\tMILEAGE (KM)\tFUEL (L)\tDRIVING\tWORKING\tRESTING")
+			slices.Sort(countries)
+			for _, country := range countries {
+				t := countryTotals[country]
+				_, _ = fmt.Fprintf(
+					w, "%s\t%d\t%.1f\t%.1f\t%v\t%v\t%v\n",
+					country, t.visits, t.mileageKm, t.fuelConsumedL, t.driving, t.working, t.resting,
+				)
+			}
+			return w.Flush()
+		}
+		return nil
+	}
+	return cmd
+}
+
+// countryVisitTotals accumulates the country visits of a single country.
+type countryVisitTotals struct {
+	visits        int
+	mileageKm     float64
+	fuelConsumedL float64
+	driving       time.Duration
+	working       time.Duration
+	resting       time.Duration
+}
+
+func (t *countryVisitTotals) add(countryVisit *trusttrackv1.CountryVisit) {
+	t.visits++
+	t.mileageKm += countryVisit.GetMileageKm()
+	t.fuelConsumedL += countryVisit.GetFuelConsumedL()
+	t.driving += time.Duration(countryVisit.GetDrivingStatusDurationS()) * time.Second
+	t.working += time.Duration(countryVisit.GetWorkingStatusDurationS()) * time.Second
+	t.resting += time.Duration(countryVisit.GetRestingStatusDurationS()) * time.Second
+}
+
+func newListDetectedEventsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "detected-events [object-id]",
+		Short:   "List detected events for an object",
+		GroupID: "detected-events",
+		Args:    cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-7*24*time.Hour), []string{time.DateOnly, time.RFC3339}, "From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time",
+	)
+	userID := cmd.Flags().Int64("user-id", 0, "Filter by user ID")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListDetectedEventsRequest_builder{
+			ObjectId: new(args[0]),
+			UserId:   new(*userID),
+			FromTime: timestamppb.New(*fromTime),
+			ToTime:   timestamppb.New(*toTime),
+			Limit:    new(int32(1000)),
+		}.Build()
+		for {
+			response, err := client.ListDetectedEvents(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, detectedEvent := range response.GetDetectedEvents() {
+				printJSON(cmd, detectedEvent)
+				validate(cmd, detectedEvent)
+			}
+			if response.GetContinuationToken() == "" {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newShareLinksCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "share-links [share-link-id]",
+		Short:   "List share links, or get a single share link",
+		GroupID: "share-links",
+		Args:    cobra.MaximumNArgs(1),
+	}
+	cmd.AddCommand(newCreateShareLinkCommand(cfg))
+	cmd.AddCommand(newUpdateShareLinkCommand(cfg))
+	cmd.AddCommand(newDeleteShareLinkCommand(cfg))
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if len(args) > 0 {
+			response, err := client.GetShareLink(cmd.Context(), trusttrackv1.GetShareLinkRequest_builder{
+				ShareLinkId: new(args[0]),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			printJSON(cmd, response.GetShareLink())
+			validate(cmd, response.GetShareLink())
+			return nil
+		}
+		request := trusttrackv1.ListShareLinksRequest_builder{
+			Limit: new(int32(100)),
+		}.Build()
+		for {
+			response, err := client.ListShareLinks(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, shareLink := range response.GetShareLinks() {
+				printJSON(cmd, shareLink)
+				validate(cmd, shareLink)
+			}
+			if response.GetContinuationToken() == "" || len(response.GetShareLinks()) == 0 {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+// shareLinkValidityFlags are the flags for the validity period of a share link.
+type shareLinkValidityFlags struct {
+	validFrom *time.Time
+	expiresIn *time.Duration
+}
+
+func addShareLinkValidityFlags(cmd *cobra.Command) shareLinkValidityFlags {
+	return shareLinkValidityFlags{
+		validFrom: cmd.Flags().Time(
+			"valid-from", time.Time{}, []string{time.RFC3339}, "Time the link becomes valid (default: now)",
+		),
+		expiresIn: cmd.Flags().Duration(
+			"expires-in", 24*time.Hour, "Time until the link expires, counted from --valid-from or now",
+		),
+	}
+}
+
+// apply sets the validity period of a share link, relative to now when no start time is given.
+func (f shareLinkValidityFlags) apply(shareLink *trusttrackv1.ShareLink) {
+	validFrom := *f.validFrom
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	} else {
+		shareLink.SetValidFromTime(timestamppb.New(validFrom))
+	}
+	shareLink.SetExpireTime(timestamppb.New(validFrom.Add(*f.expiresIn)))
+}
+
+func newCreateShareLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [object-id...]",
+		Short: "Create a share link for objects and print a guess of its URL",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	validity := addShareLinkValidityFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		shareLink := &trusttrackv1.ShareLink{}
+		objects := make([]*trusttrackv1.ShareLink_SharedObject, 0, len(args))
+		for _, objectID := range args {
+			objects = append(objects, trusttrackv1.ShareLink_SharedObject_builder{
+				ObjectId: new(objectID),
+			}.Build())
+		}
+		shareLink.SetObjects(objects)
+		validity.apply(shareLink)
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateShareLink(cmd.Context(), trusttrackv1.CreateShareLinkRequest_builder{
+			ShareLink: shareLink,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		validate(cmd, response.GetShareLink())
+		cmd.Printf(
+			"Created share link %s, expires %s.\n",
+			response.GetShareLink().GetId(),
+			response.GetShareLink().GetExpireTime().AsTime().Local().Format(time.DateTime),
+		)
+		if shareLinkURL, ok := guessShareLinkURL(response.GetShareLink()); ok {
+			cmd.Println("Guessed URL, check it before sharing:")
+			fmt.Fprintln(cmd.OutOrStdout(), shareLinkURL)
+		}
+		return nil
+	}
+	return cmd
+}
+
+// guessShareLinkURL returns the likely URL of a share link, built from its domain and ID.
+// The API doesn't return share link URLs, so ok is false when the domain is not a plain host name.
+func guessShareLinkURL(shareLink *trusttrackv1.ShareLink) (_ string, ok bool) {
+	domain, id := shareLink.GetDomain(), shareLink.GetId()
+	if domain == "" || id == "" || strings.ContainsAny(domain, "/?#@ ") {
+		return "", false
+	}
+	return (&url.URL{Scheme: "https", Host: domain, Path: "/" + id}).String(), true
+}
+
+func newUpdateShareLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [share-link-id]",
+		Short: "Update the validity period of a share link",
+		Args:  cobra.ExactArgs(1),
+	}
+	validity := addShareLinkValidityFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		shareLink := &trusttrackv1.ShareLink{}
+		shareLink.SetId(args[0])
+		validity.apply(shareLink)
+		var paths []string
+		if cmd.Flags().Changed("valid-from") {
+			paths = append(paths, "valid_from_time")
+		}
+		if cmd.Flags().Changed("valid-from") || cmd.Flags().Changed("expires-in") {
+			paths = append(paths, "expire_time")
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("at least one of --valid-from and --expires-in is required")
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.UpdateShareLink(cmd.Context(), trusttrackv1.UpdateShareLinkRequest_builder{
+			ShareLink:  shareLink,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response.GetShareLink())
+		validate(cmd, response.GetShareLink())
+		return nil
+	}
+	return cmd
+}
+
+func newDeleteShareLinkCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [share-link-id]",
+		Short: "Delete a share link",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteShareLink(cmd.Context(), trusttrackv1.DeleteShareLinkRequest_builder{
+			ShareLinkId: new(args[0]),
+		}.Build()); err != nil {
+			return err
+		}
+		cmd.Printf("Deleted share link %s.\n", args[0])
+		return nil
+	}
+	return cmd
+}
+
+func newTachoCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tacho",
+		Short:   "Download tachograph data remotely",
+		GroupID: "tacho",
+	}
+	cmd.AddCommand(newScheduleVehicleTachoDownloadCommand(cfg))
+	cmd.AddCommand(newScheduleDriverCardTachoDownloadCommand(cfg))
+	cmd.AddCommand(newListTachoRequestsCommand(cfg))
+	cmd.AddCommand(newGetTachoRequestCommand(cfg))
+	cmd.AddCommand(newDeleteTachoRequestCommand(cfg))
+	cmd.AddCommand(newDownloadTachoFileCommand(cfg))
+	return cmd
+}
+
+func newScheduleVehicleTachoDownloadCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vehicle-download [object-id]",
+		Short: "Schedule a remote download of vehicle unit data",
+		Args:  cobra.ExactArgs(1),
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-28*24*time.Hour), []string{time.DateOnly, time.RFC3339}, "From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time",
+	)
+	name := cmd.Flags().String("name", "", "Request name (default: generated from the object ID)")
+	options := cmd.Flags().StringSlice(
+		"option", nil, "Additional data to download (e.g. FAULTS_AND_EVENTS, DETAILED_SPEED, SINCE_LAST_DOWNLOAD)",
+	)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		b := trusttrackv1.ScheduleVehicleTachoDownloadRequest_builder{
+			ObjectId:    new(args[0]),
+			RequestName: new(tachoRequestName(*name, args[0])),
+			FromTime:    timestamppb.New(*fromTime),
+			ToTime:      timestamppb.New(*toTime),
+		}
+		for _, option := range *options {
+			value, ok := trusttrackv1.ScheduleVehicleTachoDownloadRequest_Option_value[strings.ToUpper(option)]
+			if !ok {
+				return fmt.Errorf("unknown option: %s", option)
+			}
+			b.Options = append(b.Options, trusttrackv1.ScheduleVehicleTachoDownloadRequest_Option(value))
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ScheduleVehicleTachoDownload(cmd.Context(), b.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response)
+		return nil
+	}
+	return cmd
+}
+
+func newScheduleDriverCardTachoDownloadCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "driver-card-download [object-id]",
+		Short: "Schedule a remote download of a driver card inserted in a vehicle",
+		Args:  cobra.ExactArgs(1),
+	}
+	name := cmd.Flags().String("name", "", "Request name (default: generated from the object ID)")
+	slot := cmd.Flags().String("slot", "first", "Driver card slot (first or second)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		value, ok := trusttrackv1.ScheduleDriverCardTachoDownloadRequest_Slot_value[strings.ToUpper(*slot)+"_SLOT"]
+		if !ok {
+			return fmt.Errorf("unknown slot: %s", *slot)
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		response, err := client.ScheduleDriverCardTachoDownload(cmd.Context(),
+			trusttrackv1.ScheduleDriverCardTachoDownloadRequest_builder{
+				ObjectId:    new(args[0]),
+				RequestName: new(tachoRequestName(*name, args[0])),
+				Slot:        trusttrackv1.ScheduleDriverCardTachoDownloadRequest_Slot(value).Enum(),
+			}.Build())
+		if err != nil {
+			return err
+		}
+		printJSON(cmd, response)
+		return nil
+	}
+	return cmd
+}
+
+// tachoRequestName returns the name of a tachograph download request, generating one when not set.
+func tachoRequestName(name, objectID string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("%s %s", objectID, time.Now().UTC().Format(time.RFC3339))
+}
+
+func newListTachoRequestsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requests",
+		Short: "List remote tachograph download requests",
+		Args:  cobra.NoArgs,
+	}
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-7*24*time.Hour), []string{time.DateOnly, time.RFC3339}, "Created from time",
+	)
+	objectIDs := cmd.Flags().StringSlice("object-id", nil, "Filter by object IDs")
+	statuses := cmd.Flags().StringSlice("status", nil, "Filter by statuses (e.g. PENDING, SUCCEEDED, FAILED)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		b := trusttrackv1.ListTachoRequestsRequest_builder{
+			ObjectIds:      *objectIDs,
+			CreateFromTime: timestamppb.New(*fromTime),
+			Limit:          new(int32(100)),
+		}
+		for _, status := range *statuses {
+			value, ok := trusttrackv1.TachoRequest_Status_value[strings.ToUpper(status)]
+			if !ok {
+				return fmt.Errorf("unknown status: %s", status)
+			}
+			b.Statuses = append(b.Statuses, trusttrackv1.TachoRequest_Status(value))
+		}
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := b.Build()
+		for {
+			response, err := client.ListTachoRequests(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, tachoRequest := range response.GetTachoRequests() {
+				printJSON(cmd, tachoRequest)
+				validate(cmd, tachoRequest)
+			}
+			if response.GetContinuationToken() == "" || len(response.GetTachoRequests()) == 0 {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newGetTachoRequestCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request [request-id]",
+		Short: "Get the status of a remote tachograph download request",
+		Args:  cobra.ExactArgs(1),
+	}
+	wait := cmd.Flags().Bool("wait", false, "Wait until the request succeeds or fails")
+	pollInterval := cmd.Flags().Duration("poll-interval", 10*time.Second, "Interval between status checks when waiting")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var tachoRequest *trusttrackv1.TachoRequest
+		if *wait {
+			tachoRequest, err = client.WaitForTachoRequest(cmd.Context(), args[0], *pollInterval)
+			if tachoRequest == nil {
+				return err
+			}
+		} else {
+			response, err := client.GetTachoRequest(cmd.Context(), trusttrackv1.GetTachoRequestRequest_builder{
+				RequestId: new(args[0]),
+			}.Build())
+			if err != nil {
+				return err
+			}
+			tachoRequest = response.GetTachoRequest()
+		}
+		printJSON(cmd, tachoRequest)
+		validate(cmd, tachoRequest)
+		return err
+	}
+	return cmd
+}
+
+func newDeleteTachoRequestCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [request-id]",
+		Short: "Delete a remote tachograph download request",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DeleteTachoRequest(cmd.Context(), trusttrackv1.DeleteTachoRequestRequest_builder{
+			RequestId: new(args[0]),
+		}.Build()); err != nil {
+			return err
+		}
+		cmd.Printf("Deleted tacho request %s.\n", args[0])
+		return nil
+	}
+	return cmd
+}
+
+func newDownloadTachoFileCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download [request-id]",
+		Short: "Download the file of a completed remote tachograph download request",
+		Args:  cobra.ExactArgs(1),
+	}
+	output := cmd.Flags().StringP("output", "o", "", "Output file (default: the file name of the request)")
+	wait := cmd.Flags().Bool("wait", false, "Wait until the request succeeds before downloading")
+	pollInterval := cmd.Flags().Duration("poll-interval", 10*time.Second, "Interval between status checks when waiting")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		var tachoRequest *trusttrackv1.TachoRequest
+		if *wait {
+			tachoRequest, err = client.WaitForTachoRequest(cmd.Context(), args[0], *pollInterval)
+		} else {
+			var response *trusttrackv1.GetTachoRequestResponse
+			response, err = client.GetTachoRequest(cmd.Context(), trusttrackv1.GetTachoRequestRequest_builder{
+				RequestId: new(args[0]),
+			}.Build())
+			tachoRequest = response.GetTachoRequest()
+		}
+		if err != nil {
+			return err
+		}
+		path := *output
+		if path == "" {
+			path = filepath.Base(tachoRequest.GetFileName())
+			if tachoRequest.GetFileName() == "" {
+				path = args[0] + ".DDD"
+			}
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		n, err := client.DownloadTachoFile(cmd.Context(), args[0], f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+			return err
+		}
+		cmd.Printf("Downloaded %d bytes to %s.\n", n, path)
+		return nil
+	}
+	return cmd
+}
+
+func newListUsersCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "users",
+		Short:   "List users with access to the account",
+		GroupID: "users",
+		Args:    cobra.NoArgs,
+	}
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListUsersRequest_builder{
+			Limit: new(int32(100)),
+		}.Build()
+		for {
+			response, err := client.ListUsers(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, user := range response.GetUsers() {
+				printJSON(cmd, user)
+				validate(cmd, user)
+			}
+			if response.GetContinuationToken() == "" {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListGeozonesCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "geozones",
+		Short:   "List geozones",
+		GroupID: "geozones",
+	}
+	includeGeometry := cmd.Flags().Bool("include-geometry", false, "Include geozone geometry")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListGeozonesRequest_builder{
+			Limit:           new(int32(1000)),
+			IncludeGeometry: new(*includeGeometry),
+		}.Build()
+		for {
+			response, err := client.ListGeozones(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			for _, geozone := range response.GetGeozones() {
+				printJSON(cmd, geozone)
+				validate(cmd, geozone)
+			}
+			if response.GetContinuationToken() == "" {
+				break
+			}
+			request.SetContinuationToken(response.GetContinuationToken())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newListGeozoneVisitsCommand(cfg *config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "geozone-visits",
+		Short:   "List geozone enter and exit events for objects",
+		GroupID: "geozones",
+	}
+	objectIDs := cmd.Flags().StringSlice("object-id", nil, "Filter by object IDs")
+	geozoneIDs := cmd.Flags().StringSlice("geozone-id", nil, "Filter by geozone IDs")
+	fromTime := cmd.Flags().Time(
+		"from", time.Now().Add(-24*time.Hour), []string{time.DateOnly, time.RFC3339}, "From time",
+	)
+	toTime := cmd.Flags().Time(
+		"to", time.Now(), []string{time.DateOnly, time.RFC3339}, "To time",
+	)
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newClient(cmd, cfg)
+		if err != nil {
+			return err
+		}
+		request := trusttrackv1.ListGeozoneVisitsRequest_builder{
+			ObjectIds:  *objectIDs,
+			GeozoneIds: *geozoneIDs,
+			FromTime:   timestamppb.New(*fromTime),
+			ToTime:     timestamppb.New(*toTime),
+			Limit:      new(int32(1000)),
 		}.Build()
 		for {
-			response, err := client.ListFuelEvents(cmd.Context(), request)
+			response, err := client.ListGeozoneVisits(cmd.Context(), request)
 			if err != nil {
 				return err
 			}
-			for _, fuelEvent := range response.GetFuelEvents() {
-				printJSON(cmd, fuelEvent)
-				validate(cmd, fuelEvent)
+			for _, geozoneVisit := range response.GetGeozoneVisits() {
+				printJSON(cmd, geozoneVisit)
+				validate(cmd, geozoneVisit)
 			}
 			if response.GetContinuationToken() == "" {
 				break
@@ -1,6 +1,7 @@
 package trusttrack
 
 import (
+	"fmt"
 	"net/http"
 	"runtime/debug"
 	"time"
@@ -23,6 +24,13 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 	for _, opt := range opts {
 		opt(&client.config)
 	}
+	if client.config.rateLimit != nil {
+		rateLimiter, err := newRateLimiter(*client.config.rateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("trusttrack: rate limit: %w", err)
+		}
+		client.config.rateLimiter = rateLimiter
+	}
 	return &client, nil
 }
 
@@ -33,6 +41,10 @@ type clientConfig struct {
 	baseHTTPClient *http.Client
 	timeout        time.Duration
 	retryCount     int
+	retryPolicy    RetryPolicy
+	rateLimit      *RateLimit
+	rateLimiter    *rateLimiter
+	circuitBreaker *circuitBreaker
 	interceptors   []func(http.RoundTripper) http.RoundTripper
 }
 
@@ -66,10 +78,27 @@ func (cc clientConfig) httpClient() *http.Client {
 			next:         transport,
 		}
 	}
-	// Add retry transport if retry count > 0 (outermost).
-	if cc.retryCount > 0 {
+	// Add rate limit transport if rate limiting is configured.
+	// It is inside the retry transport, so that every attempt is rate limited.
+	if cc.rateLimiter != nil {
+		transport = &rateLimitTransport{
+			limiter: cc.rateLimiter,
+			next:    transport,
+		}
+	}
+	// Add circuit breaker transport if configured.
+	// It is outside the rate limit transport, so that rejected requests don't take tokens.
+	if cc.circuitBreaker != nil {
+		transport = &circuitBreakerTransport{
+			breaker: cc.circuitBreaker,
+			next:    transport,
+		}
+	}
+	// Add retry transport if more than one attempt is allowed (outermost).
+	if policy := cc.retryPolicy.withDefaults(cc.retryCount); policy.MaxAttempts > 1 {
 		transport = &retryTransport{
-			next: transport,
+			policy: policy,
+			next:   transport,
 		}
 	}
 	return &http.Client{
@@ -95,6 +124,31 @@ func WithRetryCount(retryCount int) ClientOption {
 	}
 }
 
+// WithRetryPolicy sets the policy for retrying failed API requests.
+// Unless set in the policy, the max attempts follow [WithRetryCount].
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimit enables client-side rate limiting of API requests.
+// The limit is shared by all requests of the [Client], and all requests are paused
+// when the API responds with a Retry-After header to a rate limited request.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return func(c *clientConfig) {
+		c.rateLimit = &limit
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker shared by all requests of the [Client].
+// While it is open, requests fail fast with a [connect.CodeUnavailable] error wrapping [ErrCircuitOpen].
+func WithCircuitBreaker(config CircuitBreaker) ClientOption {
+	return func(c *clientConfig) {
+		c.circuitBreaker = newCircuitBreaker(config)
+	}
+}
+
 // WithAPIKey sets the API key for API requests.
 func WithAPIKey(apiKey string) ClientOption {
 	return func(c *clientConfig) {
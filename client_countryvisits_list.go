@@ -0,0 +1,134 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListObjectCountryVisits lists the country visits of an object for a specified time period.
+func (c *Client) ListObjectCountryVisits(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectCountryVisitsRequest,
+) (_ *trusttrackv1.ListObjectCountryVisitsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list object country visits: %w", err)
+		}
+	}()
+	responseData, err := c.getCountryVisits(
+		ctx,
+		"/countries/object",
+		request.GetObjectId(),
+		request.GetFromTime(),
+		request.GetToTime(),
+		request.GetLimit(),
+		request.GetContinuationToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalCountryByObjectAPIResponse
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListObjectCountryVisitsResponse{}
+	countryVisits := make([]*trusttrackv1.CountryVisit, 0, len(responseBody.CountryVisits))
+	for _, countryVisit := range responseBody.CountryVisits {
+		countryVisits = append(countryVisits, countryVisitToProto(&countryVisit))
+	}
+	resp.SetCountryVisits(countryVisits)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
+
+// ListDriverCountryVisits lists the country visits of a driver for a specified time period.
+func (c *Client) ListDriverCountryVisits(
+	ctx context.Context,
+	request *trusttrackv1.ListDriverCountryVisitsRequest,
+) (_ *trusttrackv1.ListDriverCountryVisitsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list driver country visits: %w", err)
+		}
+	}()
+	responseData, err := c.getCountryVisits(
+		ctx,
+		"/countries/driver",
+		request.GetDriverId(),
+		request.GetFromTime(),
+		request.GetToTime(),
+		request.GetLimit(),
+		request.GetContinuationToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalCountryByDriverAPIResponse
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListDriverCountryVisitsResponse{}
+	countryVisits := make([]*trusttrackv1.CountryVisit, 0, len(responseBody.CountryVisits))
+	for _, countryVisit := range responseBody.CountryVisits {
+		countryVisits = append(countryVisits, countryVisitToProto((*ttoapi.ExternalCountryVisitObject)(&countryVisit)))
+	}
+	resp.SetCountryVisits(countryVisits)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
+
+func (c *Client) getCountryVisits(
+	ctx context.Context,
+	requestPath string,
+	id string,
+	fromTime, toTime *timestamppb.Timestamp,
+	limit int32,
+	continuationToken string,
+) ([]byte, error) {
+	q := url.Values{}
+	q.Set("version", "1")
+	q.Set("id", id)
+	if fromTime != nil {
+		q.Set("from_datetime", fromTime.AsTime().UTC().Format(time.RFC3339))
+	}
+	if toTime != nil {
+		q.Set("to_datetime", toTime.AsTime().UTC().Format(time.RFC3339))
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(int(limit)))
+	}
+	if continuationToken != "" {
+		q.Set("continuation_token", continuationToken)
+	}
+	fullURL := c.config.baseURL + requestPath
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	return io.ReadAll(httpResponse.Body)
+}
@@ -0,0 +1,86 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListDetectedEvents lists events detected for an object.
+func (c *Client) ListDetectedEvents(
+	ctx context.Context,
+	request *trusttrackv1.ListDetectedEventsRequest,
+) (_ *trusttrackv1.ListDetectedEventsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list detected events: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	if request.GetObjectId() != "" {
+		q.Set("object_id", request.GetObjectId())
+	}
+	if request.GetUserId() != 0 {
+		q.Set("user_id", strconv.FormatInt(request.GetUserId(), 10))
+	}
+	if request.HasFromTime() {
+		q.Set("from_datetime", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	}
+	if request.HasToTime() {
+		q.Set("to_datetime", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	}
+	if request.GetLimit() > 0 {
+		q.Set("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	if request.GetContinuationToken() != "" {
+		q.Set("continuation_token", request.GetContinuationToken())
+	}
+	fullURL := c.config.baseURL + "/detected-events"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.DetectedEventCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListDetectedEventsResponse{}
+	detectedEvents := make([]*trusttrackv1.DetectedEvent, 0, len(responseBody.Events))
+	for _, detectedEvent := range responseBody.Events {
+		output := detectedEventToProto(&detectedEvent)
+		if request.GetObjectId() != "" {
+			// The API doesn't echo the object ID, so set it from the request.
+			output.SetObjectId(request.GetObjectId())
+		}
+		detectedEvents = append(detectedEvents, output)
+	}
+	resp.SetDetectedEvents(detectedEvents)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
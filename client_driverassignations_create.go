@@ -0,0 +1,84 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// CreateDriverAssignation creates a manual driver assignation event.
+func (c *Client) CreateDriverAssignation(
+	ctx context.Context,
+	request *trusttrackv1.CreateDriverAssignationRequest,
+) (_ *trusttrackv1.CreateDriverAssignationResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: create driver assignation: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	driverAssignation := request.GetDriverAssignation()
+	requestBody := ttoapi.RequestDriverAssignation{}
+	if driverAssignation.GetDriverId() != "" {
+		requestBody.DriverID = new(driverAssignation.GetDriverId())
+	}
+	if driverAssignation.GetObjectId() != "" {
+		requestBody.ObjectID = new(driverAssignation.GetObjectId())
+	}
+	if driverAssignation.HasTime() {
+		requestBody.Datetime = new(driverAssignation.GetTime().AsTime().UTC().Truncate(time.Second))
+	}
+	if driverAssignation.HasEventType() {
+		eventType, ok := driverAssignationEventTypeFromProto(driverAssignation.GetEventType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported event type: %v", driverAssignation.GetEventType())
+		}
+		requestBody.EventType = &eventType
+	}
+	requestData, err := json.Marshal(&requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/driver/assignations"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.CreateDriverAssignationResponse{}
+	if len(bytes.TrimSpace(responseData)) == 0 {
+		// The API may respond with 201 Created and no body.
+		resp.SetDriverAssignation(driverAssignation)
+		return resp, nil
+	}
+	var responseBody ttoapi.ResponseDriverAssignationEvent
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp.SetDriverAssignation(driverAssignationToProto(&responseBody))
+	return resp, nil
+}
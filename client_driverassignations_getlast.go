@@ -0,0 +1,60 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// GetLastDriverAssignation gets the last assignation event of a driver or an object.
+func (c *Client) GetLastDriverAssignation(
+	ctx context.Context,
+	request *trusttrackv1.GetLastDriverAssignationRequest,
+) (_ *trusttrackv1.GetLastDriverAssignationResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: get last driver assignation: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	if request.GetDriverId() != "" {
+		q.Set("byDriverId", request.GetDriverId())
+	}
+	if request.GetObjectId() != "" {
+		q.Set("byObjectId", request.GetObjectId())
+	}
+	fullURL := c.config.baseURL + "/driver/assignations/last"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ResponseDriverAssignationEvent
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetLastDriverAssignationResponse{}
+	resp.SetDriverAssignation(driverAssignationToProto(&responseBody))
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// CreateDriver creates a driver.
+func (c *Client) CreateDriver(
+	ctx context.Context,
+	request *trusttrackv1.CreateDriverRequest,
+) (_ *trusttrackv1.CreateDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: create driver: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	requestBody, err := managementDriverFromProto(request.GetDriver())
+	if err != nil {
+		return nil, err
+	}
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/management/driver"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.CreateDriverResponse{}
+	if len(bytes.TrimSpace(responseData)) == 0 {
+		// The API may respond with 201 Created and no body.
+		resp.SetDriver(request.GetDriver())
+		return resp, nil
+	}
+	var responseBody ttoapi.Driver
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp.SetDriver(managementDriverToProto(&responseBody))
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package trusttrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// DeleteDriver deletes a driver.
+func (c *Client) DeleteDriver(
+	ctx context.Context,
+	request *trusttrackv1.DeleteDriverRequest,
+) (_ *trusttrackv1.DeleteDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: delete driver: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/management/driver/%s", url.PathEscape(request.GetDriverId()))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodDelete, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusNoContent {
+		return nil, newResponseError(httpResponse)
+	}
+	return &trusttrackv1.DeleteDriverResponse{}, nil
+}
@@ -0,0 +1,54 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// GetDriverTimeAnalysis gets an analysis of a driver's current driving and rest times.
+func (c *Client) GetDriverTimeAnalysis(
+	ctx context.Context,
+	request *trusttrackv1.GetDriverTimeAnalysisRequest,
+) (_ *trusttrackv1.GetDriverTimeAnalysisResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: get driver time analysis: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/drivers/%s/current-time-analysis", url.PathEscape(request.GetDriverId()))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.CurrentDriverInfo
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetDriverTimeAnalysisResponse{}
+	resp.SetDriverTimeAnalysis(driverTimeAnalysisToProto(request.GetDriverId(), &responseBody))
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// GetDriverTimeTable gets the monthly working time table of a driver.
+func (c *Client) GetDriverTimeTable(
+	ctx context.Context,
+	request *trusttrackv1.GetDriverTimeTableRequest,
+) (_ *trusttrackv1.GetDriverTimeTableResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: get driver time table: %w", err)
+		}
+	}()
+	identifierType, ok := driverIdentifierTypeFromProto(request.GetDriverIdentifier().GetType())
+	if !ok {
+		return nil, fmt.Errorf("unsupported identifier type: %v", request.GetDriverIdentifier().GetType())
+	}
+	q := url.Values{}
+	q.Set("version", "1")
+	q.Set("timezone", request.GetTimeZone())
+	q.Set("time_range_month", strconv.Itoa(int(request.GetMonth())))
+	q.Set("time_range_year", strconv.Itoa(int(request.GetYear())))
+	q.Set("business_hours_start", request.GetBusinessHoursStart())
+	q.Set("business_hours_end", request.GetBusinessHoursEnd())
+	q.Set("status_available_as_working_time", strconv.FormatBool(request.GetAvailableAsWorkingTime()))
+	fullURL := c.config.baseURL + fmt.Sprintf(
+		"/drivertimetable/%s/%s",
+		identifierType,
+		url.PathEscape(request.GetDriverIdentifier().GetIdentifier()),
+	)
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalDriverTimeTable
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetDriverTimeTableResponse{}
+	resp.SetDriverTimeTable(driverTimeTableToProto(&responseBody))
+	return resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -71,3 +72,18 @@ func (c *Client) ListDrivers(
 	}
 	return resp, nil
 }
+
+// AllDrivers returns an iterator over all drivers, fetching pages as needed.
+func (c *Client) AllDrivers(
+	ctx context.Context,
+	request *trusttrackv1.ListDriversRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.Driver, error] {
+	return paginate(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListDriversRequest,
+	) ([]*trusttrackv1.Driver, string, error) {
+		response, err := c.ListDrivers(ctx, request)
+		return response.GetDrivers(), response.GetContinuationToken(), err
+	})
+}
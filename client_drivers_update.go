@@ -0,0 +1,136 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// UpdateDriver updates a driver.
+//
+// The API replaces the whole driver on update, so when an update mask is given
+// the current driver is fetched first and only the masked fields are changed.
+func (c *Client) UpdateDriver(
+	ctx context.Context,
+	request *trusttrackv1.UpdateDriverRequest,
+) (_ *trusttrackv1.UpdateDriverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: update driver: %w", err)
+		}
+	}()
+	driverID := request.GetDriver().GetId()
+	driver := request.GetDriver()
+	if len(request.GetUpdateMask().GetPaths()) > 0 {
+		current, err := c.getManagementDriver(ctx, driverID)
+		if err != nil {
+			return nil, err
+		}
+		if err := applyDriverUpdateMask(current, request.GetDriver(), request.GetUpdateMask()); err != nil {
+			return nil, err
+		}
+		driver = current
+	}
+	requestBody, err := managementDriverFromProto(driver)
+	if err != nil {
+		return nil, err
+	}
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/management/driver/%s", url.PathEscape(driverID))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPut, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.UpdateDriverResponse{}
+	if len(bytes.TrimSpace(responseData)) == 0 {
+		resp.SetDriver(driver)
+		return resp, nil
+	}
+	var responseBody ttoapi.Driver
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp.SetDriver(managementDriverToProto(&responseBody))
+	return resp, nil
+}
+
+// getManagementDriver gets the current state of a driver from the management API.
+func (c *Client) getManagementDriver(ctx context.Context, driverID string) (*trusttrackv1.Driver, error) {
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/management/driver/%s", url.PathEscape(driverID))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.Driver
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	return managementDriverToProto(&responseBody), nil
+}
+
+// applyDriverUpdateMask copies the fields in the mask from update to driver.
+// Fields in the mask that are not set in update are cleared.
+func applyDriverUpdateMask(driver, update *trusttrackv1.Driver, mask *fieldmaskpb.FieldMask) error {
+	update = proto.CloneOf(update)
+	target, source := driver.ProtoReflect(), update.ProtoReflect()
+	for _, path := range mask.GetPaths() {
+		field := target.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if field == nil || path == "id" {
+			return fmt.Errorf("invalid update mask path: %q", path)
+		}
+		if source.Has(field) {
+			target.Set(field, source.Get(field))
+		} else {
+			target.Clear(field)
+		}
+	}
+	return nil
+}
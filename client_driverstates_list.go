@@ -0,0 +1,79 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListDriverStates lists the tachograph activity periods of a driver for a specified time period.
+func (c *Client) ListDriverStates(
+	ctx context.Context,
+	request *trusttrackv1.ListDriverStatesRequest,
+) (_ *trusttrackv1.ListDriverStatesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list driver states: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	if request.HasFromTime() {
+		q.Set("from_datetime", request.GetFromTime().AsTime().UTC().Format(time.RFC3339))
+	}
+	if request.HasToTime() {
+		q.Set("to_datetime", request.GetToTime().AsTime().UTC().Format(time.RFC3339))
+	}
+	if request.GetContinuationToken() != "" {
+		q.Set("continuation_token", request.GetContinuationToken())
+	}
+	if request.GetLimit() > 0 {
+		q.Set("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	fullURL := c.config.baseURL + fmt.Sprintf("/driverstate/%s", url.PathEscape(request.GetDriverId()))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalDriverStateCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	driverID := request.GetDriverId()
+	if responseBody.DriverID != nil {
+		driverID = *responseBody.DriverID
+	}
+	resp := &trusttrackv1.ListDriverStatesResponse{}
+	driverStates := make([]*trusttrackv1.DriverState, 0, len(responseBody.Items))
+	for _, driverState := range responseBody.Items {
+		driverStates = append(driverStates, driverStateToProto(driverID, &driverState))
+	}
+	resp.SetDriverStates(driverStates)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(responseBody.ContinuationToken.Format(time.RFC3339))
+	}
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListDriverViolations lists driving and rest time violations of drivers.
+//
+// The API paginates violations by page number, which is exposed as a continuation token.
+func (c *Client) ListDriverViolations(
+	ctx context.Context,
+	request *trusttrackv1.ListDriverViolationsRequest,
+) (_ *trusttrackv1.ListDriverViolationsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list driver violations: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	requestBody := ttoapi.ExternalDriverViolationParams{
+		CardNumbers: request.GetCardNumbers(),
+		Countries:   request.GetCountries(),
+		Vehicles:    request.GetVehicles(),
+	}
+	if request.HasFromTime() {
+		fromTime := request.GetFromTime().AsTime().UTC().Truncate(time.Second)
+		requestBody.DateTimeFrom = &fromTime
+	}
+	if request.HasToTime() {
+		toTime := request.GetToTime().AsTime().UTC().Truncate(time.Second)
+		requestBody.DateTimeTo = &toTime
+	}
+	for _, severity := range request.GetSeverities() {
+		value, ok := violationSeverityFromProto(severity)
+		if !ok {
+			return nil, fmt.Errorf("unsupported severity filter: %v", severity)
+		}
+		requestBody.Severities = append(requestBody.Severities, value)
+	}
+	for _, violationType := range request.GetTypes() {
+		value, ok := violationTypeFromProto(violationType)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type filter: %v", violationType)
+		}
+		requestBody.Types = append(requestBody.Types, value)
+	}
+	if request.GetLimit() > 0 || request.GetContinuationToken() != "" {
+		var pageDescriptor ttoapi.PageDescriptor
+		if request.GetLimit() > 0 {
+			size := int(request.GetLimit())
+			pageDescriptor.Size = &size
+		}
+		if request.GetContinuationToken() != "" {
+			page, err := strconv.Atoi(request.GetContinuationToken())
+			if err != nil {
+				return nil, fmt.Errorf("invalid continuation token: %w", err)
+			}
+			pageDescriptor.Page = &page
+		}
+		requestBody.PageDescriptor = &pageDescriptor
+	}
+	requestData, err := json.Marshal(&requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/driver-violation"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	markSafeToRetry(httpRequest)
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalDriverViolationCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListDriverViolationsResponse{}
+	violations := make([]*trusttrackv1.Violation, 0, len(responseBody.Items))
+	for _, violation := range responseBody.Items {
+		violations = append(violations, violationToProto(&violation))
+	}
+	resp.SetViolations(violations)
+	// Stop at an empty page, in case the API keeps returning a next page.
+	if responseBody.NextPage != nil && len(responseBody.Items) > 0 {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.NextPage))
+	}
+	return resp, nil
+}
@@ -0,0 +1,95 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListObjectEcodriving lists the ecodriving parameters of an object for a specified time period.
+func (c *Client) ListObjectEcodriving(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectEcodrivingRequest,
+) (_ *trusttrackv1.ListObjectEcodrivingResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list object ecodriving: %w", err)
+		}
+	}()
+	ecodriving, err := c.getEcodriving(ctx, "/ecodriving/object", request.GetObjectId(), request.GetFromTime(), request.GetToTime())
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListObjectEcodrivingResponse{}
+	resp.SetEcodriving(ecodriving)
+	return resp, nil
+}
+
+// ListDriverEcodriving lists the ecodriving parameters of a driver for a specified time period.
+func (c *Client) ListDriverEcodriving(
+	ctx context.Context,
+	request *trusttrackv1.ListDriverEcodrivingRequest,
+) (_ *trusttrackv1.ListDriverEcodrivingResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list driver ecodriving: %w", err)
+		}
+	}()
+	ecodriving, err := c.getEcodriving(ctx, "/ecodriving/driver", request.GetDriverId(), request.GetFromTime(), request.GetToTime())
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListDriverEcodrivingResponse{}
+	resp.SetEcodriving(ecodriving)
+	return resp, nil
+}
+
+func (c *Client) getEcodriving(
+	ctx context.Context,
+	requestPath string,
+	id string,
+	fromTime, toTime *timestamppb.Timestamp,
+) (*trusttrackv1.Ecodriving, error) {
+	q := url.Values{}
+	q.Set("version", "1")
+	q.Set("id", id)
+	if fromTime != nil {
+		q.Set("from_datetime", fromTime.AsTime().UTC().Format(time.RFC3339))
+	}
+	if toTime != nil {
+		q.Set("to_datetime", toTime.AsTime().UTC().Format(time.RFC3339))
+	}
+	fullURL := c.config.baseURL + requestPath
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalEcodrivingSubjectParameters
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	return ecodrivingToProto(&responseBody), nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -75,3 +76,18 @@ func (c *Client) ListFuelEvents(
 	}
 	return resp, nil
 }
+
+// AllFuelEvents returns an iterator over the fuel events of an object, fetching pages as needed.
+func (c *Client) AllFuelEvents(
+	ctx context.Context,
+	request *trusttrackv1.ListFuelEventsRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.FuelEvent, error] {
+	return paginate(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListFuelEventsRequest,
+	) ([]*trusttrackv1.FuelEvent, string, error) {
+		response, err := c.ListFuelEvents(ctx, request)
+		return response.GetFuelEvents(), response.GetContinuationToken(), err
+	})
+}
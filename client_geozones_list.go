@@ -0,0 +1,71 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListGeozones lists all geozones.
+func (c *Client) ListGeozones(
+	ctx context.Context,
+	request *trusttrackv1.ListGeozonesRequest,
+) (_ *trusttrackv1.ListGeozonesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list geozones: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	if request.GetLimit() > 0 {
+		q.Set("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	if request.GetContinuationToken() != "" {
+		q.Set("continuation_token", request.GetContinuationToken())
+	}
+	if request.GetIncludeGeometry() {
+		q.Set("include_geometry", "true")
+	}
+	fullURL := c.config.baseURL + "/geozones"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalGeozoneCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListGeozonesResponse{}
+	geozones := make([]*trusttrackv1.Geozone, 0, len(responseBody.Items))
+	for _, geozone := range responseBody.Items {
+		geozones = append(geozones, geozoneToProto(&geozone))
+	}
+	resp.SetGeozones(geozones)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListGeozoneVisits lists geozone enter and exit events for objects.
+func (c *Client) ListGeozoneVisits(
+	ctx context.Context,
+	request *trusttrackv1.ListGeozoneVisitsRequest,
+) (_ *trusttrackv1.ListGeozoneVisitsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list geozone visits: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	requestBody := ttoapi.ExternalGeozoneVisitParameters{
+		ObjectIds:  request.GetObjectIds(),
+		GeozoneIds: request.GetGeozoneIds(),
+	}
+	if request.HasFromTime() {
+		fromTime := request.GetFromTime().AsTime().UTC().Truncate(time.Second)
+		requestBody.FromDatetime = &fromTime
+	}
+	if request.HasToTime() {
+		toTime := request.GetToTime().AsTime().UTC().Truncate(time.Second)
+		requestBody.ToDatetime = &toTime
+	}
+	if request.GetLimit() > 0 {
+		limit := int(request.GetLimit())
+		requestBody.Limit = &limit
+	}
+	if request.GetContinuationToken() != "" {
+		continuationToken, err := strconv.Atoi(request.GetContinuationToken())
+		if err != nil {
+			return nil, fmt.Errorf("invalid continuation token: %w", err)
+		}
+		requestBody.ContinuationToken = &continuationToken
+	}
+	requestData, err := json.Marshal(&requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/geozones/visits"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	markSafeToRetry(httpRequest)
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalGeozoneVisitCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListGeozoneVisitsResponse{}
+	geozoneVisits := make([]*trusttrackv1.GeozoneVisit, 0, len(responseBody.Items))
+	for _, geozoneVisit := range responseBody.Items {
+		geozoneVisits = append(geozoneVisits, geozoneVisitToProto(&geozoneVisit))
+	}
+	resp.SetGeozoneVisits(geozoneVisits)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
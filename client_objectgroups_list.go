@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -68,3 +69,18 @@ func (c *Client) ListObjectGroups(
 	}
 	return resp, nil
 }
+
+// AllObjectGroups returns an iterator over all object groups, fetching pages as needed.
+func (c *Client) AllObjectGroups(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectGroupsRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.ObjectGroup, error] {
+	return paginate(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListObjectGroupsRequest,
+	) ([]*trusttrackv1.ObjectGroup, string, error) {
+		response, err := c.ListObjectGroups(ctx, request)
+		return response.GetObjectGroups(), response.GetContinuationToken(), err
+	})
+}
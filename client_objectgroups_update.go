@@ -0,0 +1,113 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// UpdateObjectGroup adds objects to and removes objects from an object group.
+//
+// The API replaces all members of the group on update, so the current members
+// are fetched with GetObjectGroup first and the changes are applied on top of them.
+// No update is made when the members would not change.
+func (c *Client) UpdateObjectGroup(
+	ctx context.Context,
+	request *trusttrackv1.UpdateObjectGroupRequest,
+) (_ *trusttrackv1.UpdateObjectGroupResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: update object group: %w", err)
+		}
+	}()
+	removed := make(map[string]bool, len(request.GetRemoveObjectIds()))
+	for _, objectID := range request.GetRemoveObjectIds() {
+		removed[objectID] = true
+	}
+	for _, objectID := range request.GetAddObjectIds() {
+		if removed[objectID] {
+			return nil, fmt.Errorf("object %q is both added and removed", objectID)
+		}
+	}
+	current, err := c.GetObjectGroup(ctx, trusttrackv1.GetObjectGroupRequest_builder{
+		ExternalId: new(request.GetExternalId()),
+	}.Build())
+	if err != nil {
+		return nil, err
+	}
+	objectIDs := updateObjectGroupMembers(
+		current.GetObjectGroup().GetObjectIds(),
+		request.GetAddObjectIds(),
+		request.GetRemoveObjectIds(),
+	)
+	resp := &trusttrackv1.UpdateObjectGroupResponse{}
+	if slices.Equal(objectIDs, current.GetObjectGroup().GetObjectIds()) {
+		resp.SetObjectGroup(current.GetObjectGroup())
+		return resp, nil
+	}
+	requestData, err := json.Marshal(objectIDs)
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/management/object-group/%s", url.PathEscape(request.GetExternalId()))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPut, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(responseData)) == 0 {
+		objectGroup := current.GetObjectGroup()
+		objectGroup.SetObjectIds(objectIDs)
+		resp.SetObjectGroup(objectGroup)
+		return resp, nil
+	}
+	var responseBody ttoapi.ExternalObjectGroup
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp.SetObjectGroup(objectGroupToProto(&responseBody))
+	return resp, nil
+}
+
+// updateObjectGroupMembers returns the members with the removed IDs dropped and the added IDs appended,
+// keeping the order of the existing members and skipping duplicates.
+func updateObjectGroupMembers(members, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, objectID := range remove {
+		removed[objectID] = true
+	}
+	seen := make(map[string]bool, len(members)+len(add))
+	result := make([]string, 0, len(members)+len(add))
+	for _, objectID := range slices.Concat(members, add) {
+		if !removed[objectID] && !seen[objectID] {
+			seen[objectID] = true
+			result = append(result, objectID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// coordinateTimeFormat is the format of coordinate times in paths, with millisecond precision.
+// Only a coordinate recorded at exactly the given time is returned.
+const coordinateTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// GetObjectCoordinate gets the object coordinate recorded at a specific time.
+func (c *Client) GetObjectCoordinate(
+	ctx context.Context,
+	request *trusttrackv1.GetObjectCoordinateRequest,
+) (_ *trusttrackv1.GetObjectCoordinateResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: get object coordinate: %w", err)
+		}
+	}()
+	if !request.HasTime() {
+		return nil, fmt.Errorf("time is required")
+	}
+	q := url.Values{}
+	q.Set("version", "2")
+	if request.GetIncludeGeozones() {
+		q.Set("include_geozones", "true")
+	}
+	if request.GetIncludeTireParameters() {
+		q.Set("include_tire_parameters", "true")
+	}
+	requestPath := fmt.Sprintf(
+		"/objects/%s/coordinates/%s",
+		url.PathEscape(request.GetObjectId()),
+		url.PathEscape(request.GetTime().AsTime().UTC().Format(coordinateTimeFormat)),
+	)
+	fullURL := c.config.baseURL + requestPath
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.Coordinate
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetObjectCoordinateResponse{}
+	resp.SetCoordinate(coordinateToProto(&responseBody))
+	return resp, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -24,8 +24,61 @@ func (c *Client) ListObjectCoordinates(
 			err = fmt.Errorf("trusttrack: list object coordinates: %w", err)
 		}
 	}()
+	var coordinates []*trusttrackv1.Coordinate
+	// The page is collected without waiting on a consumer, so the client timeout applies to the whole request.
+	continuationToken, err := c.scanObjectCoordinates(ctx, c.config.httpClient().Do, request, func(coordinate *trusttrackv1.Coordinate) error {
+		coordinates = append(coordinates, coordinate)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListObjectCoordinatesResponse{}
+	resp.SetCoordinates(coordinates)
+	resp.SetContinuationToken(continuationToken)
+	return resp, nil
+}
+
+// ScanObjectCoordinates lists a page of object coordinates for a specified time period,
+// calling fn for each coordinate as it is decoded from the response.
+//
+// Unlike [Client.ListObjectCoordinates], the page is never held in memory as a whole.
+// The client timeout applies to receiving the response and to each read of it, not to
+// the time spent in fn, so slow processing of coordinates doesn't fail the page.
+// Scanning stops at the first error returned by fn. The continuation token for the
+// next page is returned, or an empty string when there are no more results.
+func (c *Client) ScanObjectCoordinates(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectCoordinatesRequest,
+	fn func(*trusttrackv1.Coordinate) error,
+) (_ string, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: scan object coordinates: %w", err)
+		}
+	}()
+	return c.scanObjectCoordinates(ctx, c.config.doStreaming, request, fn)
+}
+
+// scanObjectCoordinates lists a page of object coordinates, sending the request with do.
+func (c *Client) scanObjectCoordinates(
+	ctx context.Context,
+	do func(*http.Request) (*http.Response, error),
+	request *trusttrackv1.ListObjectCoordinatesRequest,
+	fn func(*trusttrackv1.Coordinate) error,
+) (string, error) {
+	version := request.GetApiVersion()
+	if version == 0 {
+		version = 2
+		if request.GetIncludeNearestGeozone() {
+			version = 3
+		}
+	}
+	if request.GetIncludeNearestGeozone() && version < 3 {
+		return "", fmt.Errorf("nearest geozone requires API version 3, got %d", version)
+	}
 	q := url.Values{}
-	q.Set("version", "2")
+	q.Set("version", strconv.Itoa(int(version)))
 	if request.GetObjectId() != "" {
 		q.Set("objectId", request.GetObjectId())
 	}
@@ -49,39 +102,56 @@ func (c *Client) ListObjectCoordinates(
 	if request.GetIncludeTireParameters() {
 		q.Set("include_tire_parameters", "true")
 	}
+	if request.GetIncludeNearestGeozone() {
+		q.Set("include_nearest_geozone", "true")
+	}
 	requestPath := "/objects/" + request.GetObjectId() + "/coordinates"
 	fullURL := c.config.baseURL + requestPath
 	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	httpRequest.URL.RawQuery = q.Encode()
 	httpRequest.Header.Set("User-Agent", getUserAgent())
 	httpRequest.Header.Set("Accept", "application/json")
-	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	httpResponse, err := do(httpRequest)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	defer func() { _ = httpResponse.Body.Close() }()
 	if httpResponse.StatusCode != http.StatusOK {
-		return nil, newResponseError(httpResponse)
-	}
-	responseData, err := io.ReadAll(httpResponse.Body)
-	if err != nil {
-		return nil, err
+		return "", newResponseError(httpResponse)
 	}
-	var responseBody ttoapi.CoordinateCollection
-	if err := json.Unmarshal(responseData, &responseBody); err != nil {
-		return nil, err
+	continuationToken, err := decodePage(httpResponse.Body, func(coordinate *ttoapi.Coordinate) error {
+		return fn(coordinateToProto(coordinate))
+	})
+	if err != nil || continuationToken == nil {
+		return "", err
 	}
-	resp := &trusttrackv1.ListObjectCoordinatesResponse{}
-	coordinates := make([]*trusttrackv1.Coordinate, 0, len(responseBody.Items))
-	for _, coordinate := range responseBody.Items {
-		coordinates = append(coordinates, coordinateToProto(&coordinate))
+	var continuationTime time.Time
+	if err := json.Unmarshal(continuationToken, &continuationTime); err != nil {
+		return "", fmt.Errorf("invalid continuation token: %w", err)
 	}
-	resp.SetCoordinates(coordinates)
-	if responseBody.ContinuationToken != nil {
-		resp.SetContinuationToken(responseBody.ContinuationToken.Format(time.RFC3339))
-	}
-	return resp, nil
+	return continuationTime.Format(time.RFC3339), nil
+}
+
+// AllObjectCoordinates returns an iterator over the coordinates of an object in a time period, fetching pages as needed.
+func (c *Client) AllObjectCoordinates(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectCoordinatesRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.Coordinate, error] {
+	// Coordinates are yielded as they are decoded, without holding a page in memory.
+	return paginateFunc(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListObjectCoordinatesRequest,
+		emit func(*trusttrackv1.Coordinate) bool,
+	) (string, error) {
+		return c.ScanObjectCoordinates(ctx, request, func(coordinate *trusttrackv1.Coordinate) error {
+			if !emit(coordinate) {
+				return errStopIteration
+			}
+			return nil
+		})
+	})
 }
@@ -0,0 +1,194 @@
+package trusttrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1/trusttrackv1connect"
+)
+
+// maxStreamReconnects is the maximum number of consecutive failed attempts to
+// (re)connect a stream before giving up.
+const maxStreamReconnects = 10
+
+// StreamObjectCoordinates streams real-time coordinates of all objects or a single object.
+//
+// The stream reconnects with backoff when the connection is lost, resuming
+// from the last received coordinate. It ends when the context is canceled,
+// the stream is closed, or the API returns a non-retryable error.
+// Malformed coordinates are skipped and logged with [slog].
+func (c *Client) StreamObjectCoordinates(
+	ctx context.Context,
+	request *trusttrackv1.StreamObjectCoordinatesRequest,
+) (*connect.ServerStreamForClient[trusttrackv1.StreamObjectCoordinatesResponse], error) {
+	handler := connect.NewServerStreamHandlerSimple(
+		trusttrackv1connect.TrustTrackApiStreamObjectCoordinatesProcedure,
+		func(
+			ctx context.Context,
+			request *trusttrackv1.StreamObjectCoordinatesRequest,
+			stream *connect.ServerStream[trusttrackv1.StreamObjectCoordinatesResponse],
+		) error {
+			return c.streamObjectCoordinates(ctx, request, func(coordinate *trusttrackv1.Coordinate) error {
+				resp := &trusttrackv1.StreamObjectCoordinatesResponse{}
+				resp.SetCoordinate(coordinate)
+				return stream.Send(resp)
+			})
+		},
+	)
+	client := connect.NewClient[
+		trusttrackv1.StreamObjectCoordinatesRequest,
+		trusttrackv1.StreamObjectCoordinatesResponse,
+	](
+		&http.Client{Transport: &inProcessTransport{handler: handler}},
+		"http://in-process"+trusttrackv1connect.TrustTrackApiStreamObjectCoordinatesProcedure,
+	)
+	return client.CallServerStream(ctx, connect.NewRequest(request))
+}
+
+// coordinateStreamState tracks the position of a coordinate stream across reconnects.
+type coordinateStreamState struct {
+	// lastEventID is the ID of the last received server-sent event, if any.
+	lastEventID string
+	// lastTimes is the time of the last received coordinate per object.
+	lastTimes map[string]time.Time
+	// resumeTimes is a snapshot of lastTimes taken when reconnecting.
+	// Coordinates at or before these times are replays and are skipped.
+	resumeTimes map[string]time.Time
+}
+
+func (s *coordinateStreamState) isReplay(coordinate *ttoapi.Coordinate) bool {
+	if coordinate.ObjectID == nil || coordinate.Datetime == nil {
+		return false
+	}
+	last, ok := s.resumeTimes[*coordinate.ObjectID]
+	return ok && !coordinate.Datetime.After(last)
+}
+
+func (s *coordinateStreamState) observe(coordinate *ttoapi.Coordinate) {
+	if coordinate.ObjectID == nil || coordinate.Datetime == nil {
+		return
+	}
+	if last, ok := s.lastTimes[*coordinate.ObjectID]; !ok || coordinate.Datetime.After(last) {
+		s.lastTimes[*coordinate.ObjectID] = *coordinate.Datetime
+	}
+}
+
+// streamObjectCoordinates streams coordinates to send, reconnecting on transient failures.
+func (c *Client) streamObjectCoordinates(
+	ctx context.Context,
+	request *trusttrackv1.StreamObjectCoordinatesRequest,
+	send func(*trusttrackv1.Coordinate) error,
+) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: stream object coordinates: %w", err)
+		}
+	}()
+	httpClient := c.config.httpClient()
+	// The stream stays open indefinitely, so the request timeout does not apply.
+	httpClient.Timeout = 0
+	state := coordinateStreamState{lastTimes: map[string]time.Time{}}
+	var failures int
+	for {
+		received, retryable, err := c.streamObjectCoordinatesOnce(ctx, httpClient, request, &state, send)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !retryable {
+			return err
+		}
+		if received {
+			failures = 0
+		}
+		failures++
+		if failures > maxStreamReconnects {
+			return err
+		}
+		if err := sleepWithContext(ctx, expBackoff(failures)); err != nil {
+			return err
+		}
+		state.resumeTimes = maps.Clone(state.lastTimes)
+	}
+}
+
+// streamObjectCoordinatesOnce consumes a single connection of the coordinate stream.
+// It reports whether any coordinates were received, and whether the stream should be reconnected.
+func (c *Client) streamObjectCoordinatesOnce(
+	ctx context.Context,
+	httpClient *http.Client,
+	request *trusttrackv1.StreamObjectCoordinatesRequest,
+	state *coordinateStreamState,
+	send func(*trusttrackv1.Coordinate) error,
+) (received bool, retryable bool, _ error) {
+	q := url.Values{}
+	q.Set("version", "3")
+	if request.GetObjectId() != "" {
+		q.Set("object_id", request.GetObjectId())
+	}
+	if request.GetIncludeGeozones() {
+		q.Set("include_geozones", "true")
+	}
+	if request.GetIncludeTireParameters() {
+		q.Set("include_tire_parameters", "true")
+	}
+	fullURL := c.config.baseURL + "/object-coordinates-stream"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return false, false, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "text/event-stream, application/x-ndjson, application/json")
+	if state.lastEventID != "" {
+		httpRequest.Header.Set("Last-Event-ID", state.lastEventID)
+	}
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return false, true, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		retryable := httpResponse.StatusCode == http.StatusTooManyRequests || httpResponse.StatusCode >= 500
+		return false, retryable, newResponseError(httpResponse)
+	}
+	decoder := newStreamDecoder(httpResponse.Body)
+	for {
+		var coordinate ttoapi.Coordinate
+		err := decoder.Decode(&coordinate)
+		if id := decoder.LastEventID(); id != "" {
+			state.lastEventID = id
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// The server closed the stream, reconnect to keep following it.
+				return received, true, io.ErrUnexpectedEOF
+			}
+			var malformedValue *malformedValueError
+			if errors.As(err, &malformedValue) {
+				// A single malformed coordinate doesn't end the stream.
+				slog.WarnContext(ctx, "skipping malformed coordinate in stream", "error", err)
+				continue
+			}
+			// Syntax errors can't be recovered from within the stream, so they reconnect as well.
+			return received, true, err
+		}
+		if state.isReplay(&coordinate) {
+			continue
+		}
+		state.observe(&coordinate)
+		received = true
+		if err := send(coordinateToProto(&coordinate)); err != nil {
+			return received, false, err
+		}
+	}
+}
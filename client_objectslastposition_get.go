@@ -0,0 +1,54 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// GetObjectLastPosition gets an object with its last position.
+func (c *Client) GetObjectLastPosition(
+	ctx context.Context,
+	request *trusttrackv1.GetObjectLastPositionRequest,
+) (_ *trusttrackv1.GetObjectLastPositionResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: get object last position: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "3")
+	fullURL := c.config.baseURL + "/objects-last-coordinate/" + url.PathEscape(request.GetObjectId())
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalComposedObject
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetObjectLastPositionResponse{}
+	resp.SetObject(objectToProto(&responseBody))
+	return resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -70,3 +71,18 @@ func (c *Client) ListObjectsLastPosition(
 	}
 	return resp, nil
 }
+
+// AllObjectsLastPosition returns an iterator over all objects with their last position, fetching pages as needed.
+func (c *Client) AllObjectsLastPosition(
+	ctx context.Context,
+	request *trusttrackv1.ListObjectsLastPositionRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.Object, error] {
+	return paginate(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListObjectsLastPositionRequest,
+	) ([]*trusttrackv1.Object, string, error) {
+		response, err := c.ListObjectsLastPosition(ctx, request)
+		return response.GetObjects(), response.GetContinuationToken(), err
+	})
+}
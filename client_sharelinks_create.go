@@ -0,0 +1,82 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// CreateShareLink creates a share link.
+//
+// When the API responds without a body, the created share link is fetched from its location.
+func (c *Client) CreateShareLink(
+	ctx context.Context,
+	request *trusttrackv1.CreateShareLinkRequest,
+) (_ *trusttrackv1.CreateShareLinkResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: create share link: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	requestData, err := json.Marshal(shareLinkCreateFromProto(request.GetShareLink()))
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/share-links"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.CreateShareLinkResponse{}
+	if len(bytes.TrimSpace(responseData)) == 0 {
+		// The API may respond with 201 Created and no body, fetch the created share link instead.
+		location, err := httpResponse.Location()
+		if err != nil {
+			return nil, fmt.Errorf("empty response without a share link location: %w", err)
+		}
+		shareLinkID := path.Base(location.Path)
+		if shareLinkID == "." || shareLinkID == "/" {
+			return nil, fmt.Errorf("empty response with invalid share link location: %s", location)
+		}
+		created, err := c.GetShareLink(ctx, trusttrackv1.GetShareLinkRequest_builder{
+			ShareLinkId: new(shareLinkID),
+		}.Build())
+		if err != nil {
+			return nil, err
+		}
+		resp.SetShareLink(created.GetShareLink())
+		return resp, nil
+	}
+	var responseBody ttoapi.ExternalShareLink
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp.SetShareLink(shareLinkToProto(&responseBody))
+	return resp, nil
+}
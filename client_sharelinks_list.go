@@ -0,0 +1,68 @@
+package trusttrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListShareLinks lists share links.
+func (c *Client) ListShareLinks(
+	ctx context.Context,
+	request *trusttrackv1.ListShareLinksRequest,
+) (_ *trusttrackv1.ListShareLinksResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list share links: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	if request.GetLimit() > 0 {
+		q.Set("limit", strconv.Itoa(int(request.GetLimit())))
+	}
+	if request.GetContinuationToken() != "" {
+		q.Set("continuation_token", request.GetContinuationToken())
+	}
+	fullURL := c.config.baseURL + "/share-links"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.ExternalShareLinkCollection
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListShareLinksResponse{}
+	shareLinks := make([]*trusttrackv1.ShareLink, 0, len(responseBody.Items))
+	for _, shareLink := range responseBody.Items {
+		shareLinks = append(shareLinks, shareLinkToProto(&shareLink))
+	}
+	resp.SetShareLinks(shareLinks)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
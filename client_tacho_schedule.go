@@ -0,0 +1,125 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleVehicleTachoDownload schedules a remote download of vehicle unit data.
+func (c *Client) ScheduleVehicleTachoDownload(
+	ctx context.Context,
+	request *trusttrackv1.ScheduleVehicleTachoDownloadRequest,
+) (_ *trusttrackv1.ScheduleVehicleTachoDownloadResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: schedule vehicle tacho download: %w", err)
+		}
+	}()
+	requestBody := ttoapi.VehicleTachoScheduleRequest{
+		ExternalObjectID: request.GetObjectId(),
+		RequestName:      request.GetRequestName(),
+		FromDateTime:     request.GetFromTime().AsTime().UTC().Truncate(time.Second),
+		ToDateTime:       request.GetToTime().AsTime().UTC().Truncate(time.Second),
+	}
+	for _, option := range request.GetOptions() {
+		value, ok := vehicleTachoDownloadOptionFromProto(option)
+		if !ok {
+			return nil, fmt.Errorf("unsupported option: %v", option)
+		}
+		requestBody.Options = append(requestBody.Options, value)
+	}
+	responseBody, err := c.scheduleTachoDownload(ctx, "/tacho/vehicle-download", &requestBody)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ScheduleVehicleTachoDownloadResponse{}
+	if responseBody.ID != nil {
+		resp.SetRequestId(*responseBody.ID)
+	}
+	if responseBody.CreatedAt != nil {
+		resp.SetCreateTime(timestamppb.New(*responseBody.CreatedAt))
+	}
+	return resp, nil
+}
+
+// ScheduleDriverCardTachoDownload schedules a remote download of a driver card.
+func (c *Client) ScheduleDriverCardTachoDownload(
+	ctx context.Context,
+	request *trusttrackv1.ScheduleDriverCardTachoDownloadRequest,
+) (_ *trusttrackv1.ScheduleDriverCardTachoDownloadResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: schedule driver card tacho download: %w", err)
+		}
+	}()
+	slot, ok := driverCardTachoDownloadSlotFromProto(request.GetSlot())
+	if !ok {
+		return nil, fmt.Errorf("unsupported slot: %v", request.GetSlot())
+	}
+	requestBody := ttoapi.DriverCardTachoScheduleRequest{
+		ExternalObjectID: request.GetObjectId(),
+		RequestName:      request.GetRequestName(),
+		Slot:             slot,
+	}
+	responseBody, err := c.scheduleTachoDownload(ctx, "/tacho/driver-card-download", &requestBody)
+	if err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ScheduleDriverCardTachoDownloadResponse{}
+	if responseBody.ID != nil {
+		resp.SetRequestId(*responseBody.ID)
+	}
+	if responseBody.CreatedAt != nil {
+		resp.SetCreateTime(timestamppb.New(*responseBody.CreatedAt))
+	}
+	return resp, nil
+}
+
+func (c *Client) scheduleTachoDownload(
+	ctx context.Context,
+	requestPath string,
+	requestBody any,
+) (*ttoapi.TachoRequestScheduleResponse, error) {
+	q := url.Values{}
+	q.Set("version", "1")
+	requestData, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + requestPath
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.TachoRequestScheduleResponse
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	return &responseBody, nil
+}
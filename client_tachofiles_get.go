@@ -0,0 +1,60 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// GetTachoFile gets the file downloaded by a completed tachograph download request.
+//
+// The whole file is held in memory, use [Client.DownloadTachoFile] to stream it instead.
+func (c *Client) GetTachoFile(
+	ctx context.Context,
+	request *trusttrackv1.GetTachoFileRequest,
+) (_ *trusttrackv1.GetTachoFileResponse, err error) {
+	var data bytes.Buffer
+	if _, err := c.DownloadTachoFile(ctx, request.GetRequestId(), &data); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.GetTachoFileResponse{}
+	resp.SetData(data.Bytes())
+	return resp, nil
+}
+
+// DownloadTachoFile streams the file downloaded by a completed tachograph download request to w.
+// It returns the number of bytes written.
+//
+// The client timeout applies to receiving the response and to each read of the file,
+// not to the whole download, so large files and slow writers don't fail mid-stream.
+func (c *Client) DownloadTachoFile(ctx context.Context, requestID string, w io.Writer) (_ int64, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: download tacho file: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	fullURL := c.config.baseURL + fmt.Sprintf("/tacho/file/%s", url.PathEscape(requestID))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/octet-stream")
+	httpResponse, err := c.config.doStreaming(httpRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK {
+		return 0, newResponseError(httpResponse)
+	}
+	return io.Copy(w, httpResponse.Body)
+}
@@ -0,0 +1,115 @@
+package trusttrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// ListTachoRequests lists remote tachograph download requests.
+func (c *Client) ListTachoRequests(
+	ctx context.Context,
+	request *trusttrackv1.ListTachoRequestsRequest,
+) (_ *trusttrackv1.ListTachoRequestsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("trusttrack: list tacho requests: %w", err)
+		}
+	}()
+	q := url.Values{}
+	q.Set("version", "1")
+	requestBody := ttoapi.TachoFilterRequest{
+		RequestIds:  request.GetRequestIds(),
+		ObjectIds:   request.GetObjectIds(),
+		CardNumbers: request.GetDriverCardNumbers(),
+	}
+	for _, requestType := range request.GetTypes() {
+		value, ok := tachoRequestTypeFromProto(requestType)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type filter: %v", requestType)
+		}
+		requestBody.Types = append(requestBody.Types, value)
+	}
+	for _, status := range request.GetStatuses() {
+		value, ok := tachoRequestStatusFromProto(status)
+		if !ok {
+			return nil, fmt.Errorf("unsupported status filter: %v", status)
+		}
+		requestBody.Statuses = append(requestBody.Statuses, value)
+	}
+	if request.HasCreateFromTime() {
+		requestBody.CreatedAtFrom = new(request.GetCreateFromTime().AsTime().UTC().Truncate(time.Second))
+	}
+	if request.HasCreateToTime() {
+		requestBody.CreatedAtTo = new(request.GetCreateToTime().AsTime().UTC().Truncate(time.Second))
+	}
+	if request.HasUpdateFromTime() {
+		requestBody.UpdatedAtFrom = new(request.GetUpdateFromTime().AsTime().UTC().Truncate(time.Second))
+	}
+	if request.HasUpdateToTime() {
+		requestBody.UpdatedAtTo = new(request.GetUpdateToTime().AsTime().UTC().Truncate(time.Second))
+	}
+	var pageDescriptor ttoapi.PageDescriptor
+	if request.GetLimit() > 0 {
+		pageDescriptor.Size = new(int(request.GetLimit()))
+	}
+	if request.GetContinuationToken() != "" {
+		page, err := strconv.Atoi(request.GetContinuationToken())
+		if err != nil {
+			return nil, fmt.Errorf("invalid continuation token: %w", err)
+		}
+		pageDescriptor.Page = &page
+	}
+	if pageDescriptor.Size != nil || pageDescriptor.Page != nil {
+		requestBody.PageDescriptor = &pageDescriptor
+	}
+	requestData, err := json.Marshal(&requestBody)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := c.config.baseURL + "/tacho/requests"
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fullURL, bytes.NewReader(requestData))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.URL.RawQuery = q.Encode()
+	httpRequest.Header.Set("User-Agent", getUserAgent())
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+	markSafeToRetry(httpRequest)
+	httpResponse, err := c.config.httpClient().Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		return nil, newResponseError(httpResponse)
+	}
+	responseData, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	var responseBody ttoapi.TachoRequestsCollectionResponse
+	if err := json.Unmarshal(responseData, &responseBody); err != nil {
+		return nil, err
+	}
+	resp := &trusttrackv1.ListTachoRequestsResponse{}
+	tachoRequests := make([]*trusttrackv1.TachoRequest, 0, len(responseBody.Items))
+	for _, tachoRequest := range responseBody.Items {
+		tachoRequests = append(tachoRequests, tachoRequestToProto(&tachoRequest))
+	}
+	resp.SetTachoRequests(tachoRequests)
+	if responseBody.ContinuationToken != nil {
+		resp.SetContinuationToken(strconv.Itoa(*responseBody.ContinuationToken))
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package trusttrack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+// defaultTachoPollInterval is the default interval between tachograph download request status checks.
+const defaultTachoPollInterval = 10 * time.Second
+
+// WaitForTachoRequest polls a tachograph download request until it succeeds or fails.
+//
+// The status is checked every pollInterval, or every 10 seconds when pollInterval is zero.
+// Polling stops when the context is canceled. A failed request is returned together with an error.
+func (c *Client) WaitForTachoRequest(
+	ctx context.Context,
+	requestID string,
+	pollInterval time.Duration,
+) (*trusttrackv1.TachoRequest, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultTachoPollInterval
+	}
+	request := trusttrackv1.GetTachoRequestRequest_builder{
+		RequestId: new(requestID),
+	}.Build()
+	for {
+		response, err := c.GetTachoRequest(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		tachoRequest := response.GetTachoRequest()
+		if tachoRequest.GetStatus() == trusttrackv1.TachoRequest_FAILED {
+			return tachoRequest, fmt.Errorf(
+				"trusttrack: wait for tacho request: request %s failed: %v", requestID, tachoRequest.GetError(),
+			)
+		}
+		if isTachoRequestDone(tachoRequest.GetStatus()) {
+			return tachoRequest, nil
+		}
+		if err := sleepWithContext(ctx, pollInterval); err != nil {
+			return nil, fmt.Errorf("trusttrack: wait for tacho request: %w", err)
+		}
+	}
+}
@@ -1,16 +1,26 @@
 package trusttrack
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // newTestClient creates a Client pointing at the given test server with retries disabled.
@@ -52,52 +62,1472 @@ func TestListObjects_Success(t *testing.T) {
 	}
 }
 
+func TestGetObjectLastPosition_Success(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/objects-last-coordinate/o1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("version"); got != "3" {
+			t.Errorf("expected version 3, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "o1",
+			"name": "Truck A",
+			"last_coordinate": {"datetime": "2025-01-01T08:00:00Z", "latitude": 54.68, "longitude": 25.28}
+		}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.GetObjectLastPosition(context.Background(), trusttrackv1.GetObjectLastPositionRequest_builder{
+		ObjectId: new("o1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("GetObjectLastPosition: %v", err)
+	}
+	if got := resp.GetObject().GetName(); got != "Truck A" {
+		t.Errorf("expected name Truck A, got %q", got)
+	}
+	if got := resp.GetObject().GetLastPosition().GetLatitude(); got != 54.68 {
+		t.Errorf("expected latitude 54.68, got %v", got)
+	}
+}
+
 func TestListDrivers_Success(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/drivers" {
+		if r.URL.Path != "/drivers" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("version"); got != "2" {
+			t.Errorf("expected version=2, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{
+				{"id": "10", "first_name": "Driver", "last_name": "One"},
+			},
+		})
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListDrivers(context.Background(), &trusttrackv1.ListDriversRequest{})
+	if err != nil {
+		t.Fatalf("ListDrivers: %v", err)
+	}
+	if got := len(resp.GetDrivers()); got != 1 {
+		t.Errorf("expected 1 driver, got %d", got)
+	}
+}
+
+func TestListObjectGroups_Success(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/object-groups" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{
+				{"id": "100", "name": "Fleet Group"},
+			},
+		})
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListObjectGroups(context.Background(), &trusttrackv1.ListObjectGroupsRequest{})
+	if err != nil {
+		t.Fatalf("ListObjectGroups: %v", err)
+	}
+	if got := len(resp.GetObjectGroups()); got != 1 {
+		t.Errorf("expected 1 object group, got %d", got)
+	}
+}
+
+func TestUpdateObjectGroup_ReadModifyWrite(t *testing.T) {
+	var puts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/object-groups/g1":
+			_, _ = w.Write([]byte(`{"id": "g1", "name": "Fleet", "objects_ids": ["o1", "o2", "o3"]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/management/object-group/g1":
+			puts.Add(1)
+			var objectIDs []string
+			if err := json.NewDecoder(r.Body).Decode(&objectIDs); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if expected := []string{"o1", "o3", "o4"}; !slices.Equal(objectIDs, expected) {
+				t.Errorf("expected members %v, got %v", expected, objectIDs)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "g1", "name": "Fleet", "objects_ids": objectIDs})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.UpdateObjectGroup(context.Background(), trusttrackv1.UpdateObjectGroupRequest_builder{
+		ExternalId:      new("g1"),
+		AddObjectIds:    []string{"o4", "o1"},
+		RemoveObjectIds: []string{"o2"},
+	}.Build())
+	if err != nil {
+		t.Fatalf("UpdateObjectGroup: %v", err)
+	}
+	if got := resp.GetObjectGroup().GetObjectIds(); len(got) != 3 {
+		t.Errorf("expected 3 members, got %v", got)
+	}
+	// Adding an existing member is a no-op and does not update the group.
+	if _, err := client.UpdateObjectGroup(context.Background(), trusttrackv1.UpdateObjectGroupRequest_builder{
+		ExternalId:   new("g1"),
+		AddObjectIds: []string{"o1"},
+	}.Build()); err != nil {
+		t.Fatalf("UpdateObjectGroup: %v", err)
+	}
+	if got := puts.Load(); got != 1 {
+		t.Errorf("expected 1 PUT, got %d", got)
+	}
+}
+
+func TestUpdateShareLink_UpdateMask(t *testing.T) {
+	var putBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/share-links/l1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{
+				"id": "l1",
+				"domain": "share.example.com",
+				"objects": [{"id": "o1", "name": "Truck A"}],
+				"valid_from": "2025-01-01T08:00:00Z",
+				"expires_at": "2025-01-01T12:00:00Z"
+			}`))
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&putBody); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{
+				"id": "l1",
+				"domain": "share.example.com",
+				"valid_from": "2025-01-01T08:00:00Z",
+				"expires_at": "2025-01-01T16:00:00Z"
+			}`))
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.UpdateShareLink(context.Background(), trusttrackv1.UpdateShareLinkRequest_builder{
+		ShareLink: trusttrackv1.ShareLink_builder{
+			Id:         new("l1"),
+			ExpireTime: timestamppb.New(time.Date(2025, 1, 1, 16, 0, 0, 0, time.UTC)),
+		}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+	}.Build())
+	if err != nil {
+		t.Fatalf("UpdateShareLink: %v", err)
+	}
+	if got := putBody["valid_from"]; got != "2025-01-01T08:00:00Z" {
+		t.Errorf("expected valid_from to be kept, got %v", got)
+	}
+	if got := putBody["expires_at"]; got != "2025-01-01T16:00:00Z" {
+		t.Errorf("expected expires_at to be updated, got %v", got)
+	}
+	if got := resp.GetShareLink().GetDomain(); got != "share.example.com" {
+		t.Errorf("expected domain share.example.com, got %q", got)
+	}
+}
+
+func TestCreateShareLink_EmptyResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/share-links":
+			w.Header().Set("Location", "/share-links/l1")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/share-links/l1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"id": "l1",
+				"domain": "share.example.com",
+				"objects": [{"id": "o1", "name": "Truck A"}],
+				"expires_at": "2025-01-01T12:00:00Z"
+			}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.CreateShareLink(context.Background(), trusttrackv1.CreateShareLinkRequest_builder{
+		ShareLink: trusttrackv1.ShareLink_builder{
+			Objects: []*trusttrackv1.ShareLink_SharedObject{
+				trusttrackv1.ShareLink_SharedObject_builder{ObjectId: new("o1")}.Build(),
+			},
+		}.Build(),
+	}.Build())
+	if err != nil {
+		t.Fatalf("CreateShareLink: %v", err)
+	}
+	if got := resp.GetShareLink().GetId(); got != "l1" {
+		t.Errorf("expected ID l1, got %q", got)
+	}
+	if got := len(resp.GetShareLink().GetObjects()); got != 1 {
+		t.Errorf("expected 1 shared object, got %d", got)
+	}
+}
+
+func TestListGeozones_Geometry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/geozones" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("include_geometry"); got != "true" {
+			t.Errorf("expected include_geometry=true, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"continuation_token": 2,
+			"items": []map[string]any{
+				{
+					"id":     "g1",
+					"name":   "Depot",
+					"type":   "POINT",
+					"circle": map[string]any{"latitude": 54.7, "longitude": 25.3, "radius": 150},
+				},
+				{
+					"id":   "g2",
+					"name": "Yard",
+					"type": "POLYGON",
+					"feature": map[string]any{
+						"geometry": map[string]any{
+							"type":        "Polygon",
+							"coordinates": [][][]float64{{{25.0, 54.0}, {25.1, 54.0}, {25.1, 54.1}, {25.0, 54.0}}},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListGeozones(context.Background(), trusttrackv1.ListGeozonesRequest_builder{
+		IncludeGeometry: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("ListGeozones: %v", err)
+	}
+	if got := resp.GetContinuationToken(); got != "2" {
+		t.Errorf("expected continuation token 2, got %q", got)
+	}
+	if got := len(resp.GetGeozones()); got != 2 {
+		t.Fatalf("expected 2 geozones, got %d", got)
+	}
+	circle := resp.GetGeozones()[0].GetCircle()
+	if circle.GetRadiusM() != 150 || circle.GetCenter().GetLatitude() != 54.7 {
+		t.Errorf("unexpected circle: %v", circle)
+	}
+	rings := resp.GetGeozones()[1].GetPolygon().GetRings()
+	if len(rings) != 1 || len(rings[0].GetPoints()) != 4 {
+		t.Fatalf("unexpected polygon rings: %v", rings)
+	}
+	if p := rings[0].GetPoints()[1]; p.GetLatitude() != 54.0 || p.GetLongitude() != 25.1 {
+		t.Errorf("unexpected polygon point: %v", p)
+	}
+}
+
+func TestListGeozoneVisits_RetriesPost(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/geozones/visits" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Idempotency-Key") == "" {
+			t.Error("expected Idempotency-Key header")
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		if got, ok := body["continuation_token"].(float64); !ok || got != 5 {
+			t.Errorf("expected continuation_token=5, got %v", body["continuation_token"])
+		}
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{
+				{
+					"object_id":  "o1",
+					"geozone_id": "g1",
+					"visit_data": []map[string]any{
+						{"datetime": "2025-01-01T08:00:00Z", "direction": "IN"},
+						{"datetime": "2025-01-01T09:00:00Z", "direction": "OUT"},
+					},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+	client, err := NewClient(WithBaseURL(srv.URL), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := client.ListGeozoneVisits(context.Background(), trusttrackv1.ListGeozoneVisitsRequest_builder{
+		ObjectIds:         []string{"o1"},
+		ContinuationToken: new("5"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("ListGeozoneVisits: %v", err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+	events := resp.GetGeozoneVisits()[0].GetEvents()
+	if len(events) != 2 ||
+		events[0].GetDirection() != trusttrackv1.GeozoneVisit_Event_ENTER ||
+		events[1].GetDirection() != trusttrackv1.GeozoneVisit_Event_EXIT {
+		t.Errorf("unexpected events: %v", events)
+	}
+}
+
+func TestListDetectedEvents_UnknownTripType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/detected-events" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("object_id"); got != "o1" {
+			t.Errorf("expected object_id=o1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"events": []map[string]any{
+				{"name": "Harsh braking", "trip_type": "COMMUTE", "duration": 4},
+			},
+		})
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListDetectedEvents(context.Background(), trusttrackv1.ListDetectedEventsRequest_builder{
+		ObjectId: new("o1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("ListDetectedEvents: %v", err)
+	}
+	event := resp.GetDetectedEvents()[0]
+	if event.GetObjectId() != "o1" {
+		t.Errorf("expected object ID o1, got %q", event.GetObjectId())
+	}
+	if event.GetTripType() != trusttrackv1.TripType_TRIP_TYPE_UNKNOWN || event.GetUnknownTripType() != "COMMUTE" {
+		t.Errorf("expected unknown trip type COMMUTE, got %v %q", event.GetTripType(), event.GetUnknownTripType())
+	}
+}
+
+func TestGetDriverTimeTable_Parameters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/drivertimetable/TACHOGRAPH/DRV001" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		for key, expected := range map[string]string{
+			"timezone":                         "Europe/Vilnius",
+			"time_range_month":                 "3",
+			"time_range_year":                  "2024",
+			"business_hours_start":             "06:00",
+			"business_hours_end":               "22:00",
+			"status_available_as_working_time": "true",
+		} {
+			if got := q.Get(key); got != expected {
+				t.Errorf("expected %s=%q, got %q", key, expected, got)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"identifier": "DRV001",
+			"total_working_hours": 9,
+			"days": [{"date": "2024-03-01T00:00:00Z", "work_duration_at_day": 28800, "work_duration_at_night": 3600}]
+		}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.GetDriverTimeTable(context.Background(), trusttrackv1.GetDriverTimeTableRequest_builder{
+		DriverIdentifier: trusttrackv1.DriverIdentifier_builder{
+			Identifier: new("DRV001"),
+			Type:       new(trusttrackv1.DriverIdentifier_TACHOGRAPH),
+		}.Build(),
+		TimeZone:               new("Europe/Vilnius"),
+		Month:                  new(int32(3)),
+		Year:                   new(int32(2024)),
+		BusinessHoursStart:     new("06:00"),
+		BusinessHoursEnd:       new("22:00"),
+		AvailableAsWorkingTime: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("GetDriverTimeTable: %v", err)
+	}
+	timeTable := resp.GetDriverTimeTable()
+	if got := timeTable.GetTotalWorkingH(); got != 9 {
+		t.Errorf("expected 9 total working hours, got %d", got)
+	}
+	if len(timeTable.GetDays()) != 1 {
+		t.Fatalf("expected 1 day, got %d", len(timeTable.GetDays()))
+	}
+	if got := timeTable.GetDays()[0].GetNightWorkDurationS(); got != 3600 {
+		t.Errorf("expected 3600s night work, got %v", got)
+	}
+}
+
+func TestUpdateDriver_UpdateMask(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/management/driver/d1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{
+				"id": "d1",
+				"first_name": "Jonas",
+				"last_name": "Jonaitis",
+				"phone": "+37060000000",
+				"identifiers": [{"identifier": "123", "type": "IBUTTON"}]
+			}`))
+		case http.MethodPut:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body["first_name"] != "Jonas" || body["last_name"] != "Petraitis" {
+				t.Errorf("expected only last_name to change, got %v", body)
+			}
+			if _, ok := body["phone"]; ok {
+				t.Errorf("expected phone to be cleared, got %v", body["phone"])
+			}
+			if identifiers, _ := body["identifiers"].([]any); len(identifiers) != 1 {
+				t.Errorf("expected identifiers to be kept, got %v", body["identifiers"])
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.UpdateDriver(context.Background(), trusttrackv1.UpdateDriverRequest_builder{
+		Driver: trusttrackv1.Driver_builder{
+			Id:        new("d1"),
+			FirstName: new("ignored"),
+			LastName:  new("Petraitis"),
+		}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"last_name", "phone"}},
+	}.Build())
+	if err != nil {
+		t.Fatalf("UpdateDriver: %v", err)
+	}
+	if got := resp.GetDriver().GetLastName(); got != "Petraitis" {
+		t.Errorf("expected last name Petraitis, got %q", got)
+	}
+	_, err = client.UpdateDriver(context.Background(), trusttrackv1.UpdateDriverRequest_builder{
+		Driver:     trusttrackv1.Driver_builder{Id: new("d1")}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}},
+	}.Build())
+	if err == nil {
+		t.Error("expected error for update mask path id")
+	}
+}
+
+func TestListDriverCountryVisits_Pagination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/countries/driver" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("id"); got != "d1" {
+			t.Errorf("expected id=d1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("continuation_token") {
+		case "":
+			_, _ = w.Write([]byte(`{
+				"subject_id": "d1",
+				"continuation_token": 2,
+				"country_visits": [{
+					"country_code": "LT",
+					"exited_to": "PL",
+					"mileage": 120.5,
+					"driving_status_duration": 5400,
+					"start": {"datetime": "2024-01-01T08:00:00Z", "latitude": 54.68, "longitude": 25.28},
+					"object_ids": ["o1"]
+				}]
+			}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"subject_id": "d1", "country_visits": [{"country_code": "PL", "entered_from": "LT"}]}`))
+		default:
+			t.Errorf("unexpected continuation token: %s", r.URL.Query().Get("continuation_token"))
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	request := trusttrackv1.ListDriverCountryVisitsRequest_builder{DriverId: new("d1")}.Build()
+	var countryVisits []*trusttrackv1.CountryVisit
+	for {
+		resp, err := client.ListDriverCountryVisits(context.Background(), request)
+		if err != nil {
+			t.Fatalf("ListDriverCountryVisits: %v", err)
+		}
+		countryVisits = append(countryVisits, resp.GetCountryVisits()...)
+		if resp.GetContinuationToken() == "" {
+			break
+		}
+		request.SetContinuationToken(resp.GetContinuationToken())
+	}
+	if len(countryVisits) != 2 {
+		t.Fatalf("expected 2 country visits, got %d", len(countryVisits))
+	}
+	first := countryVisits[0]
+	if first.GetExitedToCountryCode() != "PL" || first.GetMileageKm() != 120.5 || first.GetDrivingStatusDurationS() != 5400 {
+		t.Errorf("unexpected first country visit: %v", first)
+	}
+	if got := first.GetObjectIds(); !slices.Equal(got, []string{"o1"}) {
+		t.Errorf("expected object IDs [o1], got %v", got)
+	}
+	if got := countryVisits[1].GetEnteredFromCountryCode(); got != "LT" {
+		t.Errorf("expected second visit entered from LT, got %q", got)
+	}
+}
+
+func TestListDriverEcodriving_Units(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ecodriving/driver" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("id"); got != "d1" {
+			t.Errorf("expected id=d1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"subject_id": "d1",
+			"parameters": {
+				"main_parameters": {"total_score": 87, "distance": 412.5, "fuel_consumption_rate": 28.25},
+				"speed_parameters": {"maximum_speed": 92, "overspeeding_percentage": 1.5}
+			}
+		}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListDriverEcodriving(context.Background(), trusttrackv1.ListDriverEcodrivingRequest_builder{
+		DriverId: new("d1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("ListDriverEcodriving: %v", err)
+	}
+	ecodriving := resp.GetEcodriving()
+	if got := ecodriving.GetMain().GetTotalScore(); got != 87 {
+		t.Errorf("expected total score 87, got %d", got)
+	}
+	if got := ecodriving.GetMain().GetDistanceKm(); got != 412.5 {
+		t.Errorf("expected distance 412.5 km, got %v", got)
+	}
+	if got := ecodriving.GetMain().GetFuelConsumptionLPer_100Km(); got != 28.25 {
+		t.Errorf("expected fuel consumption 28.25 l/100km, got %v", got)
+	}
+	if got := ecodriving.GetSpeed().GetMaximumSpeedKmh(); got != 92 {
+		t.Errorf("expected maximum speed 92 km/h, got %v", got)
+	}
+	if ecodriving.HasBraking() {
+		t.Error("expected no braking parameters")
+	}
+}
+
+func TestListDriverStates_Pagination(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/driverstate/d1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{
+				"driver_id": "d1",
+				"continuation_token": "2024-01-01T10:00:00Z",
+				"items": [{
+					"activity": "AVAILABILITY",
+					"plate_number": "ABC123",
+					"start_time": "2024-01-01T09:00:00Z",
+					"duration": 3600,
+					"start_point": {"lat": 54.68, "lon": 25.28}
+				}]
+			}`))
+			return
+		}
+		if got := r.URL.Query().Get("continuation_token"); got != "2024-01-01T10:00:00Z" {
+			t.Errorf("expected continuation token, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"driver_id": "d1", "items": [{"activity": "REST"}]}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	request := trusttrackv1.ListDriverStatesRequest_builder{DriverId: new("d1")}.Build()
+	var activities []trusttrackv1.DriverState_Activity
+	for {
+		resp, err := client.ListDriverStates(context.Background(), request)
+		if err != nil {
+			t.Fatalf("ListDriverStates: %v", err)
+		}
+		for _, driverState := range resp.GetDriverStates() {
+			activities = append(activities, driverState.GetActivity())
+		}
+		if resp.GetContinuationToken() == "" {
+			break
+		}
+		request.SetContinuationToken(resp.GetContinuationToken())
+	}
+	expected := []trusttrackv1.DriverState_Activity{
+		trusttrackv1.DriverState_AVAILABLE,
+		trusttrackv1.DriverState_RESTING,
+	}
+	if !slices.Equal(activities, expected) {
+		t.Errorf("expected activities %v, got %v", expected, activities)
+	}
+}
+
+func TestListDriverViolations_Pagination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/driver-violation" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Severities     []string `json:"severities"`
+			PageDescriptor struct {
+				Page int `json:"page"`
+				Size int `json:"size"`
+			} `json:"page_descriptor"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		if len(body.Severities) != 1 || body.Severities[0] != "MOST_SERIOUS" {
+			t.Errorf("unexpected severities: %v", body.Severities)
+		}
+		if body.PageDescriptor.Page != 1 || body.PageDescriptor.Size != 10 {
+			t.Errorf("unexpected page descriptor: %+v", body.PageDescriptor)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"next_page": 2,
+			"items": []map[string]any{
+				{"severity": "MOST_SERIOUS", "type": "WEEKLY_DRIVING_LIMIT_EXCEEDED"},
+			},
+		})
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.ListDriverViolations(context.Background(), trusttrackv1.ListDriverViolationsRequest_builder{
+		Severities:        []trusttrackv1.Violation_Severity{trusttrackv1.Violation_MOST_SERIOUS},
+		Limit:             new(int32(10)),
+		ContinuationToken: new("1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("ListDriverViolations: %v", err)
+	}
+	if got := resp.GetContinuationToken(); got != "2" {
+		t.Errorf("expected continuation token 2, got %q", got)
+	}
+	violation := resp.GetViolations()[0]
+	if violation.GetSeverity() != trusttrackv1.Violation_MOST_SERIOUS ||
+		violation.GetType() != trusttrackv1.Violation_WEEKLY_DRIVING_LIMIT_EXCEEDED {
+		t.Errorf("unexpected violation: %v", violation)
+	}
+}
+
+func TestCreateDriverAssignation_NotRetriedOnServerError(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/driver/assignations" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if key := r.Header.Get("Idempotency-Key"); key != "" {
+			t.Errorf("expected no Idempotency-Key header, got %q", key)
+		}
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+	client, err := NewClient(WithBaseURL(srv.URL), WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	// The assignation may have been recorded, so it's not sent again.
+	if _, err := client.CreateDriverAssignation(context.Background(), trusttrackv1.CreateDriverAssignationRequest_builder{
+		DriverAssignation: trusttrackv1.DriverAssignation_builder{
+			DriverId:  new("d1"),
+			ObjectId:  new("o1"),
+			EventType: new(trusttrackv1.DriverAssignation_STOP),
+		}.Build(),
+	}.Build()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestGetObjectCoordinate_Time(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/objects/o1/coordinates/2025-01-01T08:00:00.090Z" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("include_tire_parameters"); got != "true" {
+			t.Errorf("expected include_tire_parameters=true, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"object_id": "o1",
+			"datetime": "2025-01-01T08:00:00.090Z",
+			"position": {"latitude": 54.68, "longitude": 25.28}
+		}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	at := time.Date(2025, 1, 1, 10, 0, 0, 90*int(time.Millisecond), time.FixedZone("EET", 2*60*60))
+	resp, err := client.GetObjectCoordinate(context.Background(), trusttrackv1.GetObjectCoordinateRequest_builder{
+		ObjectId:              new("o1"),
+		Time:                  timestamppb.New(at),
+		IncludeTireParameters: new(true),
+	}.Build())
+	if err != nil {
+		t.Fatalf("GetObjectCoordinate: %v", err)
+	}
+	coordinate := resp.GetCoordinate()
+	if got := coordinate.GetVehicleTime().AsTime(); !got.Equal(at) {
+		t.Errorf("expected time %v, got %v", at, got)
+	}
+	if got := coordinate.GetPosition().GetLatitude(); got != 54.68 {
+		t.Errorf("expected latitude 54.68, got %v", got)
+	}
+	if _, err := client.GetObjectCoordinate(context.Background(), trusttrackv1.GetObjectCoordinateRequest_builder{
+		ObjectId: new("o1"),
+	}.Build()); err == nil {
+		t.Error("expected error for missing time, got nil")
+	}
+}
+
+func TestStreamObjectCoordinates_Reconnect(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/object-coordinates-stream" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("version"); got != "3" {
+			t.Errorf("expected version 3, got %q", got)
+		}
+		switch calls.Add(1) {
+		case 1:
+			// Malformed events are skipped.
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte(": keep-alive\n\n" +
+				"id: 1\ndata: {\"object_id\": \"o1\", \"datetime\": \"2025-01-01T10:00:00Z\"}\n\n" +
+				"data: {\"object_id\": \"o1\", \"datetime\": 5}\n\n" +
+				"data: {\"object_id\":\n\n" +
+				"id: 2\ndata: {\"object_id\": \"o1\",\ndata: \"datetime\": \"2025-01-01T10:00:10Z\"}\n\n"))
+		case 2:
+			if got := r.Header.Get("Last-Event-ID"); got != "2" {
+				t.Errorf("expected Last-Event-ID 2, got %q", got)
+			}
+			// The server replays the last coordinate after reconnecting. A value of the wrong
+			// type is skipped, and a syntax error reconnects.
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			_, _ = w.Write([]byte("{\"object_id\": \"o1\", \"datetime\": \"2025-01-01T10:00:10Z\"}\n" +
+				"{\"object_id\": \"o1\", \"datetime\": 5}\n" +
+				"{\"object_id\": }\n"))
+		case 3:
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte("id: 3\ndata: {\"object_id\": \"o1\", \"datetime\": \"2025-01-01T10:00:20Z\"}\n\n"))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			<-r.Context().Done()
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamObjectCoordinates(ctx, trusttrackv1.StreamObjectCoordinatesRequest_builder{
+		ObjectId: new("o1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("StreamObjectCoordinates: %v", err)
+	}
+	defer func() { _ = stream.Close() }()
+	var got []time.Time
+	for len(got) < 3 && stream.Receive() {
+		got = append(got, stream.Msg().GetCoordinate().GetVehicleTime().AsTime())
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	expected := []time.Time{
+		time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 0, 10, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 0, 20, 0, time.UTC),
+	}
+	if !slices.EqualFunc(got, expected, time.Time.Equal) {
+		t.Errorf("expected coordinates at %v, got %v", expected, got)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("expected 3 connections, got %d", got)
+	}
+}
+
+func TestGetDriverTimeAnalysis_Durations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/drivers/d1/current-time-analysis" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"type": "PRIMARY",
+			"state": {"activity": "DRIVING", "duration": 1800},
+			"current_day": {
+				"driving_status": "SINGLE",
+				"driving": {"regular": {"duration": 12600, "duration_limit": 32400}}
+			},
+			"current_week": {"driving": {"duration": 72000, "duration_limit": 201600}},
+			"last_driver_assignation_event": {"driver_id": "d1", "object_id": "o1", "event_type": "START"}
+		}`))
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	resp, err := client.GetDriverTimeAnalysis(context.Background(), trusttrackv1.GetDriverTimeAnalysisRequest_builder{
+		DriverId: new("d1"),
+	}.Build())
+	if err != nil {
+		t.Fatalf("GetDriverTimeAnalysis: %v", err)
+	}
+	analysis := resp.GetDriverTimeAnalysis()
+	if got := analysis.GetState().GetActivity(); got != trusttrackv1.DriverTimeAnalysis_State_DRIVING {
+		t.Errorf("expected activity DRIVING, got %v", got)
+	}
+	if got := analysis.GetState().GetDuration().AsDuration(); got != 30*time.Minute {
+		t.Errorf("expected state duration 30m, got %v", got)
+	}
+	regular := analysis.GetCurrentDay().GetRegularDriving()
+	if got := regular.GetLimit().AsDuration() - regular.GetDuration().AsDuration(); got != 5*time.Hour+30*time.Minute {
+		t.Errorf("expected 5h30m remaining daily driving, got %v", got)
+	}
+	if got := analysis.GetCurrentWeek().GetDriving().GetLimit().AsDuration(); got != 56*time.Hour {
+		t.Errorf("expected 56h weekly limit, got %v", got)
+	}
+	if got := analysis.GetLastDriverAssignation().GetEventType(); got != trusttrackv1.DriverAssignation_START {
+		t.Errorf("expected last assignation START, got %v", got)
+	}
+}
+
+func TestWaitForTachoRequest_Download(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tacho/request/r1":
+			status := "PENDING"
+			if polls.Add(1) > 1 {
+				status = "SUCCEEDED"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "r1", "type": "VEHICLE", "status": "` + status + `", "file_name": "r1.DDD"}`))
+		case "/tacho/file/r1":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte("tachograph data"))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	tachoRequest, err := client.WaitForTachoRequest(context.Background(), "r1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForTachoRequest: %v", err)
+	}
+	if got := tachoRequest.GetStatus(); got != trusttrackv1.TachoRequest_SUCCEEDED {
+		t.Errorf("expected status SUCCEEDED, got %v", got)
+	}
+	if got := polls.Load(); got != 2 {
+		t.Errorf("expected 2 polls, got %d", got)
+	}
+	var buf bytes.Buffer
+	n, err := client.DownloadTachoFile(context.Background(), "r1", &buf)
+	if err != nil {
+		t.Fatalf("DownloadTachoFile: %v", err)
+	}
+	if n != int64(buf.Len()) || buf.String() != "tachograph data" {
+		t.Errorf("unexpected file contents: %d bytes %q", n, buf.String())
+	}
+}
+
+func TestDownloadTachoFile_SlowWriter(t *testing.T) {
+	data := bytes.Repeat([]byte("tachograph data\n"), 64*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(data)
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithTimeout(50*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	// Writing the file takes longer than the client timeout.
+	w := &slowWriter{delay: 5 * time.Millisecond}
+	n, err := client.DownloadTachoFile(context.Background(), "r1", w)
+	if err != nil {
+		t.Fatalf("DownloadTachoFile: error after %d bytes: %v", n, err)
+	}
+	if !bytes.Equal(w.buf.Bytes(), data) {
+		t.Errorf("expected %d bytes, got %d", len(data), w.buf.Len())
+	}
+}
+
+// slowWriter is an [io.Writer] that sleeps before each write.
+type slowWriter struct {
+	buf   bytes.Buffer
+	delay time.Duration
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(w.delay)
+	return w.buf.Write(p)
+}
+
+func TestListUsers_Success(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users" {
 			t.Errorf("unexpected path: %s", r.URL.Path)
 		}
-		if got := r.URL.Query().Get("version"); got != "2" {
-			t.Errorf("expected version=2, got %q", got)
+		if got := r.URL.Query().Get("version"); got != "1" {
+			t.Errorf("expected version 1, got %q", got)
 		}
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(map[string]any{
-			"items": []map[string]any{
-				{"id": "10", "first_name": "Driver", "last_name": "One"},
-			},
-		})
+		_, _ = w.Write([]byte(`{
+			"continuation_token": 42,
+			"items": [{"id": "u1", "email": "jane@example.com", "full_name": "Jane Doe", "phone": "+37060000000"}]
+		}`))
 	}))
 	defer srv.Close()
 	client := newTestClient(t, srv)
-	resp, err := client.ListDrivers(context.Background(), &trusttrackv1.ListDriversRequest{})
+	resp, err := client.ListUsers(context.Background(), &trusttrackv1.ListUsersRequest{})
 	if err != nil {
-		t.Fatalf("ListDrivers: %v", err)
+		t.Fatalf("ListUsers: %v", err)
 	}
-	if got := len(resp.GetDrivers()); got != 1 {
-		t.Errorf("expected 1 driver, got %d", got)
+	if got := resp.GetContinuationToken(); got != "42" {
+		t.Errorf("expected continuation token 42, got %q", got)
+	}
+	if len(resp.GetUsers()) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(resp.GetUsers()))
+	}
+	user := resp.GetUsers()[0]
+	if got := user.GetEmail(); got != "jane@example.com" {
+		t.Errorf("expected email jane@example.com, got %q", got)
+	}
+	fields := user.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"email", "full_name", "phone"} {
+		options := fields.ByName(name).Options().(*descriptorpb.FieldOptions)
+		if !options.GetDebugRedact() {
+			t.Errorf("expected field %s to be redacted", name)
+		}
 	}
 }
 
-func TestListObjectGroups_Success(t *testing.T) {
+func TestAllDrivers_Pagination(t *testing.T) {
+	var limits []string
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/object-groups" {
-			t.Errorf("unexpected path: %s", r.URL.Path)
+		limits = append(limits, r.URL.Query().Get("limit"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("continuation_token") {
+		case "":
+			_, _ = w.Write([]byte(`{"continuation_token": 1, "items": [{"id": "d1"}, {"id": "d2"}]}`))
+		default:
+			// A misbehaving server that keeps returning the same continuation token.
+			_, _ = w.Write([]byte(`{"continuation_token": 1, "items": [{"id": "d3"}]}`))
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(t, srv)
+	request := &trusttrackv1.ListDriversRequest{}
+	var ids []string
+	var iterErr error
+	for driver, err := range client.AllDrivers(context.Background(), request, WithPageSize(2)) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		ids = append(ids, driver.GetId())
+	}
+	if !errors.Is(iterErr, ErrRepeatedContinuationToken) {
+		t.Errorf("expected ErrRepeatedContinuationToken, got %v", iterErr)
+	}
+	if !slices.Equal(ids, []string{"d1", "d2", "d3"}) {
+		t.Errorf("unexpected drivers: %v", ids)
+	}
+	if request.GetContinuationToken() != "" || request.GetLimit() != 0 {
+		t.Errorf("expected request to be unmodified, got %v", request)
+	}
+	limits = nil
+	ids = nil
+	for driver, err := range client.AllDrivers(context.Background(), request, WithMaxItems(1)) {
+		if err != nil {
+			t.Fatalf("AllDrivers: %v", err)
 		}
+		ids = append(ids, driver.GetId())
+	}
+	if !slices.Equal(ids, []string{"d1"}) {
+		t.Errorf("expected only the first driver, got %v", ids)
+	}
+	if !slices.Equal(limits, []string{"1"}) {
+		t.Errorf("expected a single request with limit 1, got %v", limits)
+	}
+}
+
+func TestRetryPolicy_Attempts(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	var retries []RetryAttempt
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(2),
+		WithRetryPolicy(RetryPolicy{
+			BaseDelay: time.Millisecond,
+			MaxDelay:  time.Millisecond,
+			OnRetry: func(attempt RetryAttempt) {
+				retries = append(retries, attempt)
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	_, err = client.GetObject(context.Background(), trusttrackv1.GetObjectRequest_builder{
+		ObjectId: new("o1"),
+	}.Build())
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected CodeUnavailable, got %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 attempts with a retry count of 2, got %d", got)
+	}
+	if len(retries) != 2 || retries[0].Attempt != 1 || retries[0].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected retries: %+v", retries)
+	}
+	// No retry is made when the deadline expires before the next attempt.
+	requests.Store(0)
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client, err = NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 5}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = client.GetObject(ctx, trusttrackv1.GetObjectRequest_builder{
+		ObjectId: new("o1"),
+	}.Build())
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected CodeUnavailable, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single attempt, got %d", got)
+	}
+}
+
+func TestRetryPolicy_RetryAfterOnlyForRetryableStatusCodes(t *testing.T) {
+	var requests atomic.Int32
+	statusCode := http.StatusBadRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(statusCode)
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := client.GetObject(context.Background(), trusttrackv1.GetObjectRequest_builder{
+		ObjectId: new("o1"),
+	}.Build()); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected CodeInvalidArgument, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single attempt for a 400 with Retry-After, got %d", got)
+	}
+	// Server errors of non-idempotent requests are not retried, even with Retry-After.
+	requests.Store(0)
+	statusCode = http.StatusServiceUnavailable
+	if _, err := client.CreateDriverAssignation(context.Background(), trusttrackv1.CreateDriverAssignationRequest_builder{
+		DriverAssignation: trusttrackv1.DriverAssignation_builder{
+			DriverId:  new("d1"),
+			ObjectId:  new("o1"),
+			EventType: new(trusttrackv1.DriverAssignation_START),
+		}.Build(),
+	}.Build()); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected CodeUnavailable, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single attempt for a POST with a 503 and Retry-After, got %d", got)
+	}
+}
+
+func TestRateLimit_PausesAfterRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithRateLimit(RateLimit{
+			RequestsPerSecond: 100,
+			Burst:             10,
+			Endpoints: map[string]EndpointRateLimit{
+				"GET /objects/{id}": {RequestsPerSecond: 1},
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	request := trusttrackv1.GetObjectRequest_builder{ObjectId: new("o1")}.Build()
+	if _, err := client.GetObject(context.Background(), request); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("expected CodeResourceExhausted, got %v", err)
+	}
+	state := client.RateLimitState()
+	if state.RateLimitedCount != 1 {
+		t.Errorf("expected 1 rate limited response, got %d", state.RateLimitedCount)
+	}
+	if until := time.Until(state.PausedUntil); until < 50*time.Second || until > time.Minute {
+		t.Errorf("expected a pause of about 60s, got %v", until)
+	}
+	if got := state.EndpointTokens["GET /objects/{id}"]; got >= 1 {
+		t.Errorf("expected the endpoint token to be taken, got %v", got)
+	}
+	// The pause applies to every request of the client.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.ListUsers(ctx, &trusttrackv1.ListUsersRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single request to reach the server, got %d", got)
+	}
+}
+
+func TestRateLimit_ReturnsTokensOfCanceledRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(map[string]any{
-			"items": []map[string]any{
-				{"id": "100", "name": "Fleet Group"},
+		_, _ = w.Write([]byte(`{"id": "o1"}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithRateLimit(RateLimit{RequestsPerSecond: 1, Burst: 1}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	request := trusttrackv1.GetObjectRequest_builder{ObjectId: new("o1")}.Build()
+	if _, err := client.GetObject(context.Background(), request); err != nil {
+		t.Fatalf("GetObject: %v", err)
+	}
+	for range 10 {
+		// The deadline is sooner than the wait, so the request fails without waiting.
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		if _, err := client.GetObject(ctx, request); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		cancel()
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("expected the request to fail immediately, took %v", elapsed)
+		}
+	}
+	for range 10 {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(time.Millisecond, cancel)
+		if _, err := client.GetObject(ctx, request); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	}
+	if got := client.RateLimitState().Tokens; got < -0.1 {
+		t.Errorf("expected the tokens of canceled requests to be returned, got %v", got)
+	}
+}
+
+func TestRateLimit_InvalidEndpointPattern(t *testing.T) {
+	for _, endpoints := range []map[string]EndpointRateLimit{
+		{"GET objects/{id": {RequestsPerSecond: 1}},
+		{
+			"GET /objects/{id}":       {RequestsPerSecond: 1},
+			"GET /objects/{objectId}": {RequestsPerSecond: 2},
+		},
+	} {
+		client, err := NewClient(WithRateLimit(RateLimit{Endpoints: endpoints}))
+		if err == nil || client != nil {
+			t.Errorf("expected error for endpoints %v, got %v", endpoints, err)
+		}
+	}
+}
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "o1"}`))
+	}))
+	defer srv.Close()
+	var transitions []string
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithCircuitBreaker(CircuitBreaker{
+			MinRequests: 2,
+			OpenTimeout: 10 * time.Millisecond,
+			OnStateChange: func(from, to CircuitState) {
+				transitions = append(transitions, from.String()+"->"+to.String())
 			},
-		})
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	request := trusttrackv1.GetObjectRequest_builder{ObjectId: new("o1")}.Build()
+	for range 2 {
+		if _, err := client.GetObject(context.Background(), request); connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Fatalf("expected CodeUnavailable, got %v", err)
+		}
+	}
+	if got := client.CircuitState(); got != CircuitOpen {
+		t.Fatalf("expected open circuit, got %v", got)
+	}
+	_, err = client.GetObject(context.Background(), request)
+	if !errors.Is(err, ErrCircuitOpen) || connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected ErrCircuitOpen with CodeUnavailable, got %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected the open circuit to fail fast, got %d requests", got)
+	}
+	healthy.Store(true)
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.GetObject(context.Background(), request); err != nil {
+		t.Fatalf("expected the probe to succeed, got %v", err)
+	}
+	if got := client.CircuitState(); got != CircuitClosed {
+		t.Errorf("expected closed circuit, got %v", got)
+	}
+	if !slices.Equal(transitions, []string{"closed->open", "open->half-open", "half-open->closed"}) {
+		t.Errorf("unexpected state changes: %v", transitions)
+	}
+}
+
+func TestCircuitBreaker_SlidingWindow(t *testing.T) {
+	breaker := newCircuitBreaker(CircuitBreaker{MinRequests: 4, Window: time.Minute})
+	start := time.Now()
+	// Failures older than the window are forgotten.
+	for range 3 {
+		breaker.record(start, false, true)
+	}
+	breaker.record(start.Add(2*time.Minute), false, true)
+	if got := breaker.currentState(); got != CircuitClosed {
+		t.Fatalf("expected closed circuit, got %v", got)
+	}
+	// A burst of failures across the end of a window still opens the breaker.
+	start = start.Add(3 * time.Minute)
+	for range 3 {
+		breaker.record(start, false, false)
+	}
+	breaker.record(start.Add(55*time.Second), false, true)
+	breaker.record(start.Add(55*time.Second), false, true)
+	breaker.record(start.Add(65*time.Second), false, true)
+	if got := breaker.currentState(); got != CircuitClosed {
+		t.Fatalf("expected closed circuit, got %v", got)
+	}
+	breaker.record(start.Add(65*time.Second), false, true)
+	if got := breaker.currentState(); got != CircuitOpen {
+		t.Errorf("expected open circuit, got %v", got)
+	}
+}
+
+func TestCircuitBreaker_OpensOnTimeouts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(300 * time.Millisecond):
+		}
 	}))
 	defer srv.Close()
-	client := newTestClient(t, srv)
-	resp, err := client.ListObjectGroups(context.Background(), &trusttrackv1.ListObjectGroupsRequest{})
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithTimeout(50*time.Millisecond),
+		WithCircuitBreaker(CircuitBreaker{MinRequests: 2}),
+	)
 	if err != nil {
-		t.Fatalf("ListObjectGroups: %v", err)
+		t.Fatalf("NewClient: %v", err)
 	}
-	if got := len(resp.GetObjectGroups()); got != 1 {
-		t.Errorf("expected 1 object group, got %d", got)
+	request := trusttrackv1.GetObjectRequest_builder{ObjectId: new("o1")}.Build()
+	for range 2 {
+		if _, err := client.GetObject(context.Background(), request); err == nil {
+			t.Fatal("expected timeout error, got nil")
+		}
+	}
+	if got := client.CircuitState(); got != CircuitOpen {
+		t.Errorf("expected timeouts to open the circuit, got %v", got)
+	}
+	// Requests canceled by the caller are not failures.
+	client, err = NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithCircuitBreaker(CircuitBreaker{MinRequests: 2}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	for range 2 {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		if _, err := client.GetObject(ctx, request); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	}
+	if got := client.CircuitState(); got != CircuitClosed {
+		t.Errorf("expected canceled requests to keep the circuit closed, got %v", got)
+	}
+}
+
+func TestScanObjectCoordinates_SlowConsumer(t *testing.T) {
+	testData, err := os.ReadFile(filepath.Join("testdata", "coordinates-history-v2", "response.json"))
+	if err != nil {
+		t.Fatalf("Failed to read test data: %v", err)
+	}
+	var page struct {
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(testData, &page); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+	for len(page.Items) < 200 {
+		page.Items = append(page.Items, page.Items...)
+	}
+	pageData, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("Failed to marshal page: %v", err)
+	}
+	var stall, trickle atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if trickle.Load() {
+			// Each chunk arrives within the timeout, but the whole response doesn't.
+			for chunk := range slices.Chunk(pageData, len(pageData)/10+1) {
+				_, _ = w.Write(chunk)
+				w.(http.Flusher).Flush()
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(20 * time.Millisecond):
+				}
+			}
+			return
+		}
+		if stall.Load() {
+			_, _ = w.Write(pageData[:len(pageData)/2])
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		_, _ = w.Write(pageData)
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("test-key"),
+		WithRetryCount(0),
+		WithTimeout(50*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	request := trusttrackv1.ListObjectCoordinatesRequest_builder{ObjectId: new("o1")}.Build()
+	// Processing the page takes longer than the client timeout.
+	var count int
+	if _, err := client.ScanObjectCoordinates(context.Background(), request, func(*trusttrackv1.Coordinate) error {
+		count++
+		time.Sleep(time.Millisecond)
+		return nil
+	}); err != nil {
+		t.Fatalf("ScanObjectCoordinates: error after %d coordinates: %v", count, err)
+	}
+	if count != len(page.Items) {
+		t.Errorf("expected %d coordinates, got %d", len(page.Items), count)
+	}
+	// A stalled response still times out.
+	stall.Store(true)
+	if _, err := client.ScanObjectCoordinates(context.Background(), request, func(*trusttrackv1.Coordinate) error {
+		return nil
+	}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	// The client timeout applies to the whole of a slowly received page when listing,
+	// but not when scanning.
+	stall.Store(false)
+	trickle.Store(true)
+	if _, err := client.ListObjectCoordinates(context.Background(), request); err == nil {
+		t.Error("ListObjectCoordinates: expected timeout error, got nil")
+	}
+	if _, err := client.ScanObjectCoordinates(context.Background(), request, func(*trusttrackv1.Coordinate) error {
+		return nil
+	}); err != nil {
+		t.Errorf("ScanObjectCoordinates: %v", err)
 	}
 }
 
@@ -140,6 +1570,72 @@ func TestErrorCodeMapping(t *testing.T) {
 	}
 }
 
+func TestAPIError_Details(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "0")
+		if requests.Load() == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{
+			"message": "Validation failed",
+			"errors": [{"field": "limit", "message": "must be less than or equal to 1000"}]
+		}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(
+		WithBaseURL(srv.URL),
+		WithAPIKey("secret-key"),
+		WithRetryCount(1),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	_, err = client.ListDrivers(context.Background(), trusttrackv1.ListDriversRequest_builder{
+		Limit: new(int32(5000)),
+	}.Build())
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected CodeInvalidArgument, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Validation failed" {
+		t.Errorf("unexpected status and message: %d %q", apiErr.StatusCode, apiErr.Message)
+	}
+	if !slices.Equal(apiErr.FieldErrors, []FieldError{{Field: "limit", Message: "must be less than or equal to 1000"}}) {
+		t.Errorf("unexpected field errors: %v", apiErr.FieldErrors)
+	}
+	if apiErr.Method != http.MethodGet || strings.Contains(apiErr.Path, "secret-key") ||
+		!strings.HasPrefix(apiErr.Path, "/drivers?") || !strings.Contains(apiErr.Path, "api_key=REDACTED") {
+		t.Errorf("unexpected request: %s %s", apiErr.Method, apiErr.Path)
+	}
+	if apiErr.Attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", apiErr.Attempts)
+	}
+	if apiErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected retry after 30s, got %v", apiErr.RetryAfter)
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || len(connectErr.Details()) != 1 {
+		t.Fatalf("expected a connect error detail, got %v", err)
+	}
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("detail value: %v", err)
+	}
+	errorDetails, ok := detail.(*trusttrackv1.ErrorDetails)
+	if !ok || errorDetails.GetHttpStatus() != http.StatusBadRequest || errorDetails.GetPath() != apiErr.Path {
+		t.Errorf("unexpected error details: %v", detail)
+	}
+}
+
 func TestInterceptor(t *testing.T) {
 	var intercepted atomic.Bool
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
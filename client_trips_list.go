@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -72,3 +73,18 @@ func (c *Client) ListTrips(
 	}
 	return resp, nil
 }
+
+// AllTrips returns an iterator over the trips of an object, fetching pages as needed.
+func (c *Client) AllTrips(
+	ctx context.Context,
+	request *trusttrackv1.ListTripsRequest,
+	opts ...PageOption,
+) iter.Seq2[*trusttrackv1.Trip, error] {
+	return paginate(ctx, request, opts, func(
+		ctx context.Context,
+		request *trusttrackv1.ListTripsRequest,
+	) ([]*trusttrackv1.Trip, string, error) {
+		response, err := c.ListTrips(ctx, request)
+		return response.GetTrips(), response.GetContinuationToken(), err
+	})
+}
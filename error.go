@@ -1,26 +1,148 @@
 package trusttrack
 
 import (
-	"errors"
+	"cmp"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// APIError is an error response from the TrustTrack API.
+//
+// Errors returned by the [Client] for error responses are [*connect.Error] values
+// wrapping an APIError, which can be retrieved with [errors.As]. The APIError is
+// also attached as a [trusttrackv1.ErrorDetails] connect error detail.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message returned by the API.
+	// It is the raw response body when the body is not a JSON error.
+	Message string
+	// FieldErrors are the errors for fields of the request returned by the API.
+	FieldErrors []FieldError
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path and query of the request, with the API key redacted.
+	Path string
+	// Attempts is the number of attempts made for the request, including retries.
+	Attempts int
+	// RetryAfter is the delay requested by the Retry-After header of the response, if any.
+	RetryAfter time.Duration
+}
+
+// FieldError is an error for a field of a request.
+type FieldError struct {
+	// Field is the field of the request.
+	Field string
+	// Message is the error message for the field.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("http %d", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if len(e.FieldErrors) > 0 {
+		fieldErrors := make([]string, 0, len(e.FieldErrors))
+		for _, fieldError := range e.FieldErrors {
+			fieldErrors = append(fieldErrors, fieldError.Field+": "+fieldError.Message)
+		}
+		msg += " (" + strings.Join(fieldErrors, ", ") + ")"
+	}
+	return msg
+}
+
+func (e *APIError) toProto() *trusttrackv1.ErrorDetails {
+	var output trusttrackv1.ErrorDetails
+	output.SetHttpStatus(int32(e.StatusCode))
+	output.SetMessage(e.Message)
+	output.SetMethod(e.Method)
+	output.SetPath(e.Path)
+	output.SetAttempts(int32(e.Attempts))
+	if e.RetryAfter > 0 {
+		output.SetRetryAfter(durationpb.New(e.RetryAfter))
+	}
+	fieldErrors := make([]*trusttrackv1.ErrorDetails_FieldError, 0, len(e.FieldErrors))
+	for _, fieldError := range e.FieldErrors {
+		fieldErrors = append(fieldErrors, trusttrackv1.ErrorDetails_FieldError_builder{
+			Field:   new(fieldError.Field),
+			Message: new(fieldError.Message),
+		}.Build())
+	}
+	output.SetFieldErrors(fieldErrors)
+	return &output
+}
+
+// errorBody is an error response body from the TrustTrack API.
+type errorBody struct {
+	Message          string `json:"message"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Errors           []struct {
+		Field    string `json:"field"`
+		Property string `json:"property"`
+		Message  string `json:"message"`
+	} `json:"errors"`
+}
+
 func newResponseError(httpResponse *http.Response) error {
 	body, err := io.ReadAll(httpResponse.Body)
 	if err != nil {
 		body = fmt.Appendf(nil, "failed to read response body: %s", err)
 	}
-	var msg string
-	if len(body) > 0 {
-		msg = fmt.Sprintf("http %d: %s", httpResponse.StatusCode, body)
-	} else {
-		msg = fmt.Sprintf("http %d", httpResponse.StatusCode)
+	apiErr := APIError{
+		StatusCode: httpResponse.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		Attempts:   1,
+	}
+	var errBody errorBody
+	if json.Unmarshal(body, &errBody) == nil {
+		if message := cmp.Or(errBody.Message, errBody.ErrorDescription, errBody.Error); message != "" ||
+			len(errBody.Errors) > 0 {
+			apiErr.Message = message
+		}
+		for _, e := range errBody.Errors {
+			apiErr.FieldErrors = append(apiErr.FieldErrors, FieldError{
+				Field:   cmp.Or(e.Field, e.Property),
+				Message: e.Message,
+			})
+		}
+	}
+	if request := httpResponse.Request; request != nil {
+		apiErr.Method = request.Method
+		apiErr.Path = redactedRequestURI(request.URL)
+		if attempts, ok := request.Context().Value(attemptsKey{}).(int); ok {
+			apiErr.Attempts = attempts
+		}
+	}
+	if retryAfter, ok := parseRetryAfter(httpResponse); ok && retryAfter > 0 {
+		apiErr.RetryAfter = retryAfter
+	}
+	connectErr := connect.NewError(httpStatusToConnectCode(httpResponse.StatusCode), &apiErr)
+	if detail, err := connect.NewErrorDetail(apiErr.toProto()); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// redactedRequestURI returns the path and query of the URL, with the API key redacted.
+func redactedRequestURI(u *url.URL) string {
+	redacted := *u
+	if query := redacted.Query(); query.Has("api_key") {
+		query.Set("api_key", "REDACTED")
+		redacted.RawQuery = query.Encode()
 	}
-	return connect.NewError(httpStatusToConnectCode(httpResponse.StatusCode), errors.New(msg))
+	return redacted.RequestURI()
 }
 
 func httpStatusToConnectCode(statusCode int) connect.Code {
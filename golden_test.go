@@ -1,23 +1,36 @@
 package trusttrack
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
 	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 var update = flag.Bool("update", false, "update golden files")
 
 func TestCoordinateToProtoGolden(t *testing.T) {
-	testDataDir := filepath.Join("testdata", "coordinates-history-v2")
+	for _, dir := range []string{"coordinates-history-v2", "coordinates-history-v3"} {
+		t.Run(dir, func(t *testing.T) {
+			testCoordinateToProtoGolden(t, filepath.Join("testdata", dir))
+		})
+	}
+}
+
+func testCoordinateToProtoGolden(t *testing.T, testDataDir string) {
 	// Discover all JSON files (except golden files) in the directory
 	var testFiles []string
 	err := filepath.Walk(testDataDir, func(path string, info os.FileInfo, err error) error {
@@ -39,7 +52,7 @@ func TestCoordinateToProtoGolden(t *testing.T) {
 		t.Fatalf("Failed to walk test data directory: %v", err)
 	}
 	if len(testFiles) == 0 {
-		t.Fatal("No test files found in coordinates-history-v2 directory")
+		t.Fatalf("No test files found in %s directory", testDataDir)
 	}
 	// Process each test file
 	for _, testFilePath := range testFiles {
@@ -118,3 +131,136 @@ func TestCoordinateToProtoGolden(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodePage_Coordinates(t *testing.T) {
+	testData, err := os.ReadFile(filepath.Join("testdata", "coordinates-history-v2", "response.json"))
+	if err != nil {
+		t.Fatalf("Failed to read test data: %v", err)
+	}
+	var collection ttoapi.CoordinateCollection
+	if err := json.Unmarshal(testData, &collection); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+	var decoded []*trusttrackv1.Coordinate
+	continuationToken, err := decodePage(bytes.NewReader(testData), func(coordinate *ttoapi.Coordinate) error {
+		decoded = append(decoded, coordinateToProto(coordinate))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("decodePage: %v", err)
+	}
+	if len(decoded) != len(collection.Items) {
+		t.Fatalf("expected %d coordinates, got %d", len(collection.Items), len(decoded))
+	}
+	for i, coordinate := range collection.Items {
+		if want := coordinateToProto(&coordinate); !proto.Equal(want, decoded[i]) {
+			t.Errorf("coordinate %d differs from unmarshaled coordinate:\n%v", i, cmp.Diff(want, decoded[i], protocmp.Transform()))
+		}
+	}
+	var token time.Time
+	if err := json.Unmarshal(continuationToken, &token); err != nil {
+		t.Fatalf("Failed to parse continuation token: %v", err)
+	}
+	if collection.ContinuationToken == nil || !token.Equal(*collection.ContinuationToken) {
+		t.Errorf("expected continuation token %v, got %v", collection.ContinuationToken, token)
+	}
+}
+
+// BenchmarkDecodeCoordinatePage compares decoding a full page of 1000 coordinates
+// by unmarshaling the whole response with decoding it one coordinate at a time.
+//
+// B/op and allocs/op count all allocations, most of which are garbage in both cases.
+// The retained-B/op metric is the peak live heap while decoding: unmarshaling keeps
+// the response body, the decoded page and all coordinates alive at once, while
+// streaming keeps only the coordinate being processed.
+func BenchmarkDecodeCoordinatePage(b *testing.B) {
+	testData, err := os.ReadFile(filepath.Join("testdata", "coordinates-history-v2", "response.json"))
+	if err != nil {
+		b.Fatalf("Failed to read test data: %v", err)
+	}
+	var page struct {
+		Items             []json.RawMessage `json:"items"`
+		ContinuationToken json.RawMessage   `json:"continuation_token"`
+	}
+	if err := json.Unmarshal(testData, &page); err != nil {
+		b.Fatalf("Failed to parse test data: %v", err)
+	}
+	items := make([]json.RawMessage, 0, 1000)
+	for len(items) < 1000 {
+		items = append(items, page.Items...)
+	}
+	page.Items = items[:1000]
+	pageData, err := json.Marshal(page)
+	if err != nil {
+		b.Fatalf("Failed to marshal page: %v", err)
+	}
+	unmarshal := func(sample func()) error {
+		responseData, err := io.ReadAll(bytes.NewReader(pageData))
+		if err != nil {
+			return err
+		}
+		var collection ttoapi.CoordinateCollection
+		if err := json.Unmarshal(responseData, &collection); err != nil {
+			return err
+		}
+		coordinates := make([]*trusttrackv1.Coordinate, 0, len(collection.Items))
+		for _, coordinate := range collection.Items {
+			coordinates = append(coordinates, coordinateToProto(&coordinate))
+		}
+		sample()
+		runtime.KeepAlive(responseData)
+		runtime.KeepAlive(coordinates)
+		return nil
+	}
+	streaming := func(sample func()) error {
+		var count int
+		_, err := decodePage(bytes.NewReader(pageData), func(coordinate *ttoapi.Coordinate) error {
+			protoCoordinate := coordinateToProto(coordinate)
+			if count++; count%100 == 0 {
+				sample()
+			}
+			runtime.KeepAlive(protoCoordinate)
+			return nil
+		})
+		return err
+	}
+	for _, bm := range []struct {
+		name   string
+		decode func(sample func()) error
+	}{
+		{name: "unmarshal", decode: unmarshal},
+		{name: "streaming", decode: streaming},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			retained, err := retainedHeap(bm.decode)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			b.SetBytes(int64(len(pageData)))
+			for b.Loop() {
+				if err := bm.decode(func() {}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(retained), "retained-B/op")
+		})
+	}
+}
+
+// retainedHeap returns the peak live heap growth in bytes at the points where decode calls sample.
+func retainedHeap(decode func(sample func()) error) (uint64, error) {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	base := stats.HeapAlloc
+	var peak uint64
+	err := decode(func() {
+		runtime.GC()
+		runtime.ReadMemStats(&stats)
+		if stats.HeapAlloc > base {
+			peak = max(peak, stats.HeapAlloc-base)
+		}
+	})
+	return peak, err
+}
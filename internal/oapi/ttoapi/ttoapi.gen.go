@@ -4,6 +4,7 @@
 package ttoapi
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -3708,8 +3709,10 @@ type FluxCoordinate struct {
 
 // GeoJSONObject defines model for GeoJsonObject.
 type GeoJSONObject struct {
-	Bbox []float64 `json:"bbox,omitempty"`
-	Crs  *Crs      `json:"crs,omitempty"`
+	Bbox        []float64       `json:"bbox,omitempty"`
+	Coordinates json.RawMessage `json:"coordinates,omitempty"`
+	Crs         *Crs            `json:"crs,omitempty"`
+	Type        *string         `json:"type,omitempty"`
 }
 
 // Infringements defines model for Infringements.
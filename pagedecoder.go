@@ -0,0 +1,83 @@
+package trusttrack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// decodePage decodes a paginated response body of the form
+// {"items": [...], "continuation_token": ...} from r, one item at a time.
+//
+// Each item is decoded into a new value and passed to yield, so that only one item
+// is held in memory at a time. The raw continuation token is returned, or nil when not set.
+// Decoding stops at the first error returned by yield.
+func decodePage[T any](r io.Reader, yield func(*T) error) (continuationToken json.RawMessage, _ error) {
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token {
+		case "items":
+			if err := decodePageItems(decoder, yield); err != nil {
+				return nil, err
+			}
+		case "continuation_token":
+			if err := decoder.Decode(&continuationToken); err != nil {
+				return nil, err
+			}
+			if string(continuationToken) == "null" {
+				continuationToken = nil
+			}
+		default:
+			// Skip unknown fields.
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+	return continuationToken, nil
+}
+
+func decodePageItems[T any](decoder *json.Decoder, yield func(*T) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected items array, got %v", token)
+	}
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+		if err := yield(&item); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
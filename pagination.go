@@ -0,0 +1,146 @@
+package trusttrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrRepeatedContinuationToken is returned by list iterators when the API returns
+// a continuation token that was already used, which would otherwise paginate forever.
+var ErrRepeatedContinuationToken = errors.New("repeated continuation token")
+
+// maxPageSize is the largest page size accepted by the list endpoints.
+const maxPageSize = 1000
+
+// PageOption configures a list iterator.
+type PageOption func(*pageConfig)
+
+// pageConfig is the config for a list iterator.
+type pageConfig struct {
+	maxItems int
+	pageSize int32
+}
+
+// WithMaxItems limits the total number of items returned by a list iterator.
+// Zero means no limit.
+func WithMaxItems(maxItems int) PageOption {
+	return func(c *pageConfig) {
+		c.maxItems = maxItems
+	}
+}
+
+// WithPageSize sets the number of items requested per page by a list iterator.
+// Zero means the limit of the request, or the API default when not set.
+func WithPageSize(pageSize int32) PageOption {
+	return func(c *pageConfig) {
+		c.pageSize = pageSize
+	}
+}
+
+// pageRequest is a list request paginated with a continuation token.
+type pageRequest interface {
+	proto.Message
+	GetLimit() int32
+	SetLimit(int32)
+	GetContinuationToken() string
+	SetContinuationToken(string)
+}
+
+// errStopIteration is returned by page callbacks to stop decoding a page
+// when the consumer of an iterator stops early.
+var errStopIteration = errors.New("stop iteration")
+
+// paginate returns an iterator over the items of all pages returned by list.
+//
+// The request is cloned, so the caller's request is not modified. Iteration stops
+// after the last page, after the max items, on the first error, or when the same
+// continuation token is returned twice.
+func paginate[R pageRequest, T any](
+	ctx context.Context,
+	request R,
+	opts []PageOption,
+	list func(context.Context, R) ([]T, string, error),
+) iter.Seq2[T, error] {
+	return paginateFunc(ctx, request, opts, func(ctx context.Context, request R, emit func(T) bool) (string, error) {
+		items, token, err := list(ctx, request)
+		if err != nil {
+			return "", err
+		}
+		for _, item := range items {
+			if !emit(item) {
+				break
+			}
+		}
+		return token, nil
+	})
+}
+
+// paginateFunc is like [paginate], but list emits the items of each page one at a time.
+// When emit returns false, list should stop and return, and its result is ignored.
+func paginateFunc[R pageRequest, T any](
+	ctx context.Context,
+	request R,
+	opts []PageOption,
+	list func(ctx context.Context, request R, emit func(T) bool) (string, error),
+) iter.Seq2[T, error] {
+	var config pageConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		request := proto.CloneOf(request)
+		if config.pageSize > 0 {
+			request.SetLimit(config.pageSize)
+		}
+		seenTokens := map[string]struct{}{}
+		if token := request.GetContinuationToken(); token != "" {
+			seenTokens[token] = struct{}{}
+		}
+		var count int
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if remaining := config.maxItems - count; config.maxItems > 0 && remaining <= maxPageSize &&
+				(request.GetLimit() == 0 || int(request.GetLimit()) > remaining) {
+				// Don't fetch more items than will be returned.
+				request.SetLimit(int32(remaining))
+			}
+			var stopped bool
+			token, err := list(ctx, request, func(item T) bool {
+				if !yield(item, nil) {
+					stopped = true
+					return false
+				}
+				count++
+				if config.maxItems > 0 && count >= config.maxItems {
+					stopped = true
+					return false
+				}
+				return true
+			})
+			if stopped {
+				return
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if token == "" {
+				return
+			}
+			if _, ok := seenTokens[token]; ok {
+				yield(zero, fmt.Errorf("trusttrack: %w: %s", ErrRepeatedContinuationToken, token))
+				return
+			}
+			seenTokens[token] = struct{}{}
+			request.SetContinuationToken(token)
+		}
+	}
+}
@@ -22,19 +22,20 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// A coordinate with telemetry data from object coordinates history API v2.
+// A coordinate with telemetry data from object coordinates history API v2 and v3.
 type Coordinate struct {
-	state                       protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ObjectId         *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
-	xxx_hidden_VehicleTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=vehicle_time,json=vehicleTime"`
-	xxx_hidden_IgnitionState    IgnitionState          `protobuf:"varint,3,opt,name=ignition_state,json=ignitionState,enum=wayplatform.connect.trusttrack.v1.IgnitionState"`
-	xxx_hidden_TripType         TripType               `protobuf:"varint,4,opt,name=trip_type,json=tripType,enum=wayplatform.connect.trusttrack.v1.TripType"`
-	xxx_hidden_Position         *Position              `protobuf:"bytes,5,opt,name=position"`
-	xxx_hidden_GeozoneIds       []string               `protobuf:"bytes,6,rep,name=geozone_ids,json=geozoneIds"`
-	xxx_hidden_CalculatedInputs *CalculatedInputs      `protobuf:"bytes,7,opt,name=calculated_inputs,json=calculatedInputs"`
-	xxx_hidden_DeviceInputs     *DeviceInputs          `protobuf:"bytes,8,opt,name=device_inputs,json=deviceInputs"`
-	xxx_hidden_Tires            map[string]*TireData   `protobuf:"bytes,9,rep,name=tires" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	xxx_hidden_Other            *OtherInputs           `protobuf:"bytes,10,opt,name=other"`
+	state                       protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId         *string                    `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_VehicleTime      *timestamppb.Timestamp     `protobuf:"bytes,2,opt,name=vehicle_time,json=vehicleTime"`
+	xxx_hidden_IgnitionState    IgnitionState              `protobuf:"varint,3,opt,name=ignition_state,json=ignitionState,enum=wayplatform.connect.trusttrack.v1.IgnitionState"`
+	xxx_hidden_TripType         TripType                   `protobuf:"varint,4,opt,name=trip_type,json=tripType,enum=wayplatform.connect.trusttrack.v1.TripType"`
+	xxx_hidden_Position         *Position                  `protobuf:"bytes,5,opt,name=position"`
+	xxx_hidden_GeozoneIds       []string                   `protobuf:"bytes,6,rep,name=geozone_ids,json=geozoneIds"`
+	xxx_hidden_CalculatedInputs *CalculatedInputs          `protobuf:"bytes,7,opt,name=calculated_inputs,json=calculatedInputs"`
+	xxx_hidden_DeviceInputs     *DeviceInputs              `protobuf:"bytes,8,opt,name=device_inputs,json=deviceInputs"`
+	xxx_hidden_Tires            map[string]*TireData       `protobuf:"bytes,9,rep,name=tires" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Other            *OtherInputs               `protobuf:"bytes,10,opt,name=other"`
+	xxx_hidden_NearestGeozone   *Coordinate_NearestGeozone `protobuf:"bytes,11,opt,name=nearest_geozone,json=nearestGeozone"`
 	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
 	XXX_presence                [1]uint32
 	unknownFields               protoimpl.UnknownFields
@@ -143,9 +144,16 @@ func (x *Coordinate) GetOther() *OtherInputs {
 	return nil
 }
 
+func (x *Coordinate) GetNearestGeozone() *Coordinate_NearestGeozone {
+	if x != nil {
+		return x.xxx_hidden_NearestGeozone
+	}
+	return nil
+}
+
 func (x *Coordinate) SetObjectId(v string) {
 	x.xxx_hidden_ObjectId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 10)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
 }
 
 func (x *Coordinate) SetVehicleTime(v *timestamppb.Timestamp) {
@@ -154,12 +162,12 @@ func (x *Coordinate) SetVehicleTime(v *timestamppb.Timestamp) {
 
 func (x *Coordinate) SetIgnitionState(v IgnitionState) {
 	x.xxx_hidden_IgnitionState = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
 }
 
 func (x *Coordinate) SetTripType(v TripType) {
 	x.xxx_hidden_TripType = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 10)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
 }
 
 func (x *Coordinate) SetPosition(v *Position) {
@@ -186,6 +194,10 @@ func (x *Coordinate) SetOther(v *OtherInputs) {
 	x.xxx_hidden_Other = v
 }
 
+func (x *Coordinate) SetNearestGeozone(v *Coordinate_NearestGeozone) {
+	x.xxx_hidden_NearestGeozone = v
+}
+
 func (x *Coordinate) HasObjectId() bool {
 	if x == nil {
 		return false
@@ -242,6 +254,13 @@ func (x *Coordinate) HasOther() bool {
 	return x.xxx_hidden_Other != nil
 }
 
+func (x *Coordinate) HasNearestGeozone() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NearestGeozone != nil
+}
+
 func (x *Coordinate) ClearObjectId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_ObjectId = nil
@@ -277,6 +296,10 @@ func (x *Coordinate) ClearOther() {
 	x.xxx_hidden_Other = nil
 }
 
+func (x *Coordinate) ClearNearestGeozone() {
+	x.xxx_hidden_NearestGeozone = nil
+}
+
 type Coordinate_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -300,6 +323,9 @@ type Coordinate_builder struct {
 	Tires map[string]*TireData
 	// Container for other system parameters.
 	Other *OtherInputs
+	// The geozone nearest to the coordinate.
+	// Only available from object coordinates history API v3, when requested.
+	NearestGeozone *Coordinate_NearestGeozone
 }
 
 func (b0 Coordinate_builder) Build() *Coordinate {
@@ -307,16 +333,16 @@ func (b0 Coordinate_builder) Build() *Coordinate {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.ObjectId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 10)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
 		x.xxx_hidden_ObjectId = b.ObjectId
 	}
 	x.xxx_hidden_VehicleTime = b.VehicleTime
 	if b.IgnitionState != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
 		x.xxx_hidden_IgnitionState = *b.IgnitionState
 	}
 	if b.TripType != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 10)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
 		x.xxx_hidden_TripType = *b.TripType
 	}
 	x.xxx_hidden_Position = b.Position
@@ -325,6 +351,118 @@ func (b0 Coordinate_builder) Build() *Coordinate {
 	x.xxx_hidden_DeviceInputs = b.DeviceInputs
 	x.xxx_hidden_Tires = b.Tires
 	x.xxx_hidden_Other = b.Other
+	x.xxx_hidden_NearestGeozone = b.NearestGeozone
+	return m0
+}
+
+// The geozone nearest to a coordinate.
+type Coordinate_NearestGeozone struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GeozoneId   *string                `protobuf:"bytes,1,opt,name=geozone_id,json=geozoneId"`
+	xxx_hidden_DistanceM   float64                `protobuf:"fixed64,2,opt,name=distance_m,json=distanceM"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Coordinate_NearestGeozone) Reset() {
+	*x = Coordinate_NearestGeozone{}
+	mi := &file_wayplatform_connect_trusttrack_v1_coordinate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinate_NearestGeozone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinate_NearestGeozone) ProtoMessage() {}
+
+func (x *Coordinate_NearestGeozone) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_coordinate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Coordinate_NearestGeozone) GetGeozoneId() string {
+	if x != nil {
+		if x.xxx_hidden_GeozoneId != nil {
+			return *x.xxx_hidden_GeozoneId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Coordinate_NearestGeozone) GetDistanceM() float64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceM
+	}
+	return 0
+}
+
+func (x *Coordinate_NearestGeozone) SetGeozoneId(v string) {
+	x.xxx_hidden_GeozoneId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *Coordinate_NearestGeozone) SetDistanceM(v float64) {
+	x.xxx_hidden_DistanceM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *Coordinate_NearestGeozone) HasGeozoneId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Coordinate_NearestGeozone) HasDistanceM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Coordinate_NearestGeozone) ClearGeozoneId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GeozoneId = nil
+}
+
+func (x *Coordinate_NearestGeozone) ClearDistanceM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DistanceM = 0
+}
+
+type Coordinate_NearestGeozone_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the geozone.
+	GeozoneId *string
+	// The distance from the coordinate to the geozone in meters.
+	DistanceM *float64
+}
+
+func (b0 Coordinate_NearestGeozone_builder) Build() *Coordinate_NearestGeozone {
+	m0 := &Coordinate_NearestGeozone{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GeozoneId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GeozoneId = b.GeozoneId
+	}
+	if b.DistanceM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_DistanceM = *b.DistanceM
+	}
 	return m0
 }
 
@@ -332,7 +470,7 @@ var File_wayplatform_connect_trusttrack_v1_coordinate_proto protoreflect.FileDes
 
 const file_wayplatform_connect_trusttrack_v1_coordinate_proto_rawDesc = "" +
 	"\n" +
-	"2wayplatform/connect/trusttrack/v1/coordinate.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9wayplatform/connect/trusttrack/v1/calculated_inputs.proto\x1a5wayplatform/connect/trusttrack/v1/device_inputs.proto\x1a6wayplatform/connect/trusttrack/v1/ignition_state.proto\x1a4wayplatform/connect/trusttrack/v1/other_inputs.proto\x1a0wayplatform/connect/trusttrack/v1/position.proto\x1a1wayplatform/connect/trusttrack/v1/tire_data.proto\x1a1wayplatform/connect/trusttrack/v1/trip_type.proto\"\xdf\x06\n" +
+	"2wayplatform/connect/trusttrack/v1/coordinate.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9wayplatform/connect/trusttrack/v1/calculated_inputs.proto\x1a5wayplatform/connect/trusttrack/v1/device_inputs.proto\x1a6wayplatform/connect/trusttrack/v1/ignition_state.proto\x1a4wayplatform/connect/trusttrack/v1/other_inputs.proto\x1a0wayplatform/connect/trusttrack/v1/position.proto\x1a1wayplatform/connect/trusttrack/v1/tire_data.proto\x1a1wayplatform/connect/trusttrack/v1/trip_type.proto\"\xa6\b\n" +
 	"\n" +
 	"Coordinate\x12(\n" +
 	"\tobject_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\bobjectId\x12J\n" +
@@ -348,41 +486,49 @@ const file_wayplatform_connect_trusttrack_v1_coordinate_proto_rawDesc = "" +
 	"\rdevice_inputs\x18\b \x01(\v2/.wayplatform.connect.trusttrack.v1.DeviceInputsR\fdeviceInputs\x12N\n" +
 	"\x05tires\x18\t \x03(\v28.wayplatform.connect.trusttrack.v1.Coordinate.TiresEntryR\x05tires\x12D\n" +
 	"\x05other\x18\n" +
-	" \x01(\v2..wayplatform.connect.trusttrack.v1.OtherInputsR\x05other\x1ae\n" +
+	" \x01(\v2..wayplatform.connect.trusttrack.v1.OtherInputsR\x05other\x12e\n" +
+	"\x0fnearest_geozone\x18\v \x01(\v2<.wayplatform.connect.trusttrack.v1.Coordinate.NearestGeozoneR\x0enearestGeozone\x1ae\n" +
 	"\n" +
 	"TiresEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12A\n" +
-	"\x05value\x18\x02 \x01(\v2+.wayplatform.connect.trusttrack.v1.TireDataR\x05value:\x028\x01B\xc2\x02\n" +
+	"\x05value\x18\x02 \x01(\v2+.wayplatform.connect.trusttrack.v1.TireDataR\x05value:\x028\x01\x1a^\n" +
+	"\x0eNearestGeozone\x12\x1d\n" +
+	"\n" +
+	"geozone_id\x18\x01 \x01(\tR\tgeozoneId\x12-\n" +
+	"\n" +
+	"distance_m\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tdistanceMB\xc2\x02\n" +
 	"%com.wayplatform.connect.trusttrack.v1B\x0fCoordinateProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
 
-var file_wayplatform_connect_trusttrack_v1_coordinate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_coordinate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_wayplatform_connect_trusttrack_v1_coordinate_proto_goTypes = []any{
-	(*Coordinate)(nil),            // 0: wayplatform.connect.trusttrack.v1.Coordinate
-	nil,                           // 1: wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(IgnitionState)(0),            // 3: wayplatform.connect.trusttrack.v1.IgnitionState
-	(TripType)(0),                 // 4: wayplatform.connect.trusttrack.v1.TripType
-	(*Position)(nil),              // 5: wayplatform.connect.trusttrack.v1.Position
-	(*CalculatedInputs)(nil),      // 6: wayplatform.connect.trusttrack.v1.CalculatedInputs
-	(*DeviceInputs)(nil),          // 7: wayplatform.connect.trusttrack.v1.DeviceInputs
-	(*OtherInputs)(nil),           // 8: wayplatform.connect.trusttrack.v1.OtherInputs
-	(*TireData)(nil),              // 9: wayplatform.connect.trusttrack.v1.TireData
+	(*Coordinate)(nil),                // 0: wayplatform.connect.trusttrack.v1.Coordinate
+	nil,                               // 1: wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry
+	(*Coordinate_NearestGeozone)(nil), // 2: wayplatform.connect.trusttrack.v1.Coordinate.NearestGeozone
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+	(IgnitionState)(0),                // 4: wayplatform.connect.trusttrack.v1.IgnitionState
+	(TripType)(0),                     // 5: wayplatform.connect.trusttrack.v1.TripType
+	(*Position)(nil),                  // 6: wayplatform.connect.trusttrack.v1.Position
+	(*CalculatedInputs)(nil),          // 7: wayplatform.connect.trusttrack.v1.CalculatedInputs
+	(*DeviceInputs)(nil),              // 8: wayplatform.connect.trusttrack.v1.DeviceInputs
+	(*OtherInputs)(nil),               // 9: wayplatform.connect.trusttrack.v1.OtherInputs
+	(*TireData)(nil),                  // 10: wayplatform.connect.trusttrack.v1.TireData
 }
 var file_wayplatform_connect_trusttrack_v1_coordinate_proto_depIdxs = []int32{
-	2, // 0: wayplatform.connect.trusttrack.v1.Coordinate.vehicle_time:type_name -> google.protobuf.Timestamp
-	3, // 1: wayplatform.connect.trusttrack.v1.Coordinate.ignition_state:type_name -> wayplatform.connect.trusttrack.v1.IgnitionState
-	4, // 2: wayplatform.connect.trusttrack.v1.Coordinate.trip_type:type_name -> wayplatform.connect.trusttrack.v1.TripType
-	5, // 3: wayplatform.connect.trusttrack.v1.Coordinate.position:type_name -> wayplatform.connect.trusttrack.v1.Position
-	6, // 4: wayplatform.connect.trusttrack.v1.Coordinate.calculated_inputs:type_name -> wayplatform.connect.trusttrack.v1.CalculatedInputs
-	7, // 5: wayplatform.connect.trusttrack.v1.Coordinate.device_inputs:type_name -> wayplatform.connect.trusttrack.v1.DeviceInputs
-	1, // 6: wayplatform.connect.trusttrack.v1.Coordinate.tires:type_name -> wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry
-	8, // 7: wayplatform.connect.trusttrack.v1.Coordinate.other:type_name -> wayplatform.connect.trusttrack.v1.OtherInputs
-	9, // 8: wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry.value:type_name -> wayplatform.connect.trusttrack.v1.TireData
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	3,  // 0: wayplatform.connect.trusttrack.v1.Coordinate.vehicle_time:type_name -> google.protobuf.Timestamp
+	4,  // 1: wayplatform.connect.trusttrack.v1.Coordinate.ignition_state:type_name -> wayplatform.connect.trusttrack.v1.IgnitionState
+	5,  // 2: wayplatform.connect.trusttrack.v1.Coordinate.trip_type:type_name -> wayplatform.connect.trusttrack.v1.TripType
+	6,  // 3: wayplatform.connect.trusttrack.v1.Coordinate.position:type_name -> wayplatform.connect.trusttrack.v1.Position
+	7,  // 4: wayplatform.connect.trusttrack.v1.Coordinate.calculated_inputs:type_name -> wayplatform.connect.trusttrack.v1.CalculatedInputs
+	8,  // 5: wayplatform.connect.trusttrack.v1.Coordinate.device_inputs:type_name -> wayplatform.connect.trusttrack.v1.DeviceInputs
+	1,  // 6: wayplatform.connect.trusttrack.v1.Coordinate.tires:type_name -> wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry
+	9,  // 7: wayplatform.connect.trusttrack.v1.Coordinate.other:type_name -> wayplatform.connect.trusttrack.v1.OtherInputs
+	2,  // 8: wayplatform.connect.trusttrack.v1.Coordinate.nearest_geozone:type_name -> wayplatform.connect.trusttrack.v1.Coordinate.NearestGeozone
+	10, // 9: wayplatform.connect.trusttrack.v1.Coordinate.TiresEntry.value:type_name -> wayplatform.connect.trusttrack.v1.TireData
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_connect_trusttrack_v1_coordinate_proto_init() }
@@ -403,7 +549,7 @@ func file_wayplatform_connect_trusttrack_v1_coordinate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_coordinate_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_coordinate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
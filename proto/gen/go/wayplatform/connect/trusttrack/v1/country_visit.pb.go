@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/country_visit.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A visit of an object or a driver to a country.
+type CountryVisit struct {
+	state                                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CountryCode                      *string                `protobuf:"bytes,1,opt,name=country_code,json=countryCode"`
+	xxx_hidden_EnteredFromCountryCode           *string                `protobuf:"bytes,2,opt,name=entered_from_country_code,json=enteredFromCountryCode"`
+	xxx_hidden_ExitedToCountryCode              *string                `protobuf:"bytes,3,opt,name=exited_to_country_code,json=exitedToCountryCode"`
+	xxx_hidden_Start                            *CountryVisit_Boundary `protobuf:"bytes,4,opt,name=start"`
+	xxx_hidden_End                              *CountryVisit_Boundary `protobuf:"bytes,5,opt,name=end"`
+	xxx_hidden_MileageKm                        float64                `protobuf:"fixed64,6,opt,name=mileage_km,json=mileageKm"`
+	xxx_hidden_VirtualMileageKm                 float64                `protobuf:"fixed64,7,opt,name=virtual_mileage_km,json=virtualMileageKm"`
+	xxx_hidden_FuelConsumedL                    float64                `protobuf:"fixed64,8,opt,name=fuel_consumed_l,json=fuelConsumedL"`
+	xxx_hidden_AverageFuelConsumptionLPer_100Km float64                `protobuf:"fixed64,9,opt,name=average_fuel_consumption_l_per_100km,json=averageFuelConsumptionLPer100km"`
+	xxx_hidden_WorkingDurationS                 float64                `protobuf:"fixed64,10,opt,name=working_duration_s,json=workingDurationS"`
+	xxx_hidden_IdlingDurationS                  float64                `protobuf:"fixed64,11,opt,name=idling_duration_s,json=idlingDurationS"`
+	xxx_hidden_StopDurationS                    float64                `protobuf:"fixed64,12,opt,name=stop_duration_s,json=stopDurationS"`
+	xxx_hidden_DrivingStatusDurationS           float64                `protobuf:"fixed64,13,opt,name=driving_status_duration_s,json=drivingStatusDurationS"`
+	xxx_hidden_WorkingStatusDurationS           float64                `protobuf:"fixed64,14,opt,name=working_status_duration_s,json=workingStatusDurationS"`
+	xxx_hidden_RestingStatusDurationS           float64                `protobuf:"fixed64,15,opt,name=resting_status_duration_s,json=restingStatusDurationS"`
+	xxx_hidden_AvailableStatusDurationS         float64                `protobuf:"fixed64,16,opt,name=available_status_duration_s,json=availableStatusDurationS"`
+	xxx_hidden_ObjectIds                        []string               `protobuf:"bytes,17,rep,name=object_ids,json=objectIds"`
+	xxx_hidden_FirstDriverIds                   []string               `protobuf:"bytes,18,rep,name=first_driver_ids,json=firstDriverIds"`
+	xxx_hidden_SecondDriverIds                  []string               `protobuf:"bytes,19,rep,name=second_driver_ids,json=secondDriverIds"`
+	XXX_raceDetectHookData                      protoimpl.RaceDetectHookData
+	XXX_presence                                [1]uint32
+	unknownFields                               protoimpl.UnknownFields
+	sizeCache                                   protoimpl.SizeCache
+}
+
+func (x *CountryVisit) Reset() {
+	*x = CountryVisit{}
+	mi := &file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryVisit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryVisit) ProtoMessage() {}
+
+func (x *CountryVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CountryVisit) GetCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_CountryCode != nil {
+			return *x.xxx_hidden_CountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CountryVisit) GetEnteredFromCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_EnteredFromCountryCode != nil {
+			return *x.xxx_hidden_EnteredFromCountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CountryVisit) GetExitedToCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_ExitedToCountryCode != nil {
+			return *x.xxx_hidden_ExitedToCountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CountryVisit) GetStart() *CountryVisit_Boundary {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *CountryVisit) GetEnd() *CountryVisit_Boundary {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *CountryVisit) GetMileageKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_MileageKm
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetVirtualMileageKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_VirtualMileageKm
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetFuelConsumedL() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumedL
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetAverageFuelConsumptionLPer_100Km() float64 {
+	if x != nil {
+		return x.xxx_hidden_AverageFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetWorkingDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_WorkingDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetIdlingDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_IdlingDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetStopDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_StopDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetDrivingStatusDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_DrivingStatusDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetWorkingStatusDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_WorkingStatusDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetRestingStatusDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_RestingStatusDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetAvailableStatusDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_AvailableStatusDurationS
+	}
+	return 0
+}
+
+func (x *CountryVisit) GetObjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ObjectIds
+	}
+	return nil
+}
+
+func (x *CountryVisit) GetFirstDriverIds() []string {
+	if x != nil {
+		return x.xxx_hidden_FirstDriverIds
+	}
+	return nil
+}
+
+func (x *CountryVisit) GetSecondDriverIds() []string {
+	if x != nil {
+		return x.xxx_hidden_SecondDriverIds
+	}
+	return nil
+}
+
+func (x *CountryVisit) SetCountryCode(v string) {
+	x.xxx_hidden_CountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 19)
+}
+
+func (x *CountryVisit) SetEnteredFromCountryCode(v string) {
+	x.xxx_hidden_EnteredFromCountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 19)
+}
+
+func (x *CountryVisit) SetExitedToCountryCode(v string) {
+	x.xxx_hidden_ExitedToCountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 19)
+}
+
+func (x *CountryVisit) SetStart(v *CountryVisit_Boundary) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *CountryVisit) SetEnd(v *CountryVisit_Boundary) {
+	x.xxx_hidden_End = v
+}
+
+func (x *CountryVisit) SetMileageKm(v float64) {
+	x.xxx_hidden_MileageKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 19)
+}
+
+func (x *CountryVisit) SetVirtualMileageKm(v float64) {
+	x.xxx_hidden_VirtualMileageKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 19)
+}
+
+func (x *CountryVisit) SetFuelConsumedL(v float64) {
+	x.xxx_hidden_FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 19)
+}
+
+func (x *CountryVisit) SetAverageFuelConsumptionLPer_100Km(v float64) {
+	x.xxx_hidden_AverageFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 19)
+}
+
+func (x *CountryVisit) SetWorkingDurationS(v float64) {
+	x.xxx_hidden_WorkingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 19)
+}
+
+func (x *CountryVisit) SetIdlingDurationS(v float64) {
+	x.xxx_hidden_IdlingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 19)
+}
+
+func (x *CountryVisit) SetStopDurationS(v float64) {
+	x.xxx_hidden_StopDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 19)
+}
+
+func (x *CountryVisit) SetDrivingStatusDurationS(v float64) {
+	x.xxx_hidden_DrivingStatusDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 19)
+}
+
+func (x *CountryVisit) SetWorkingStatusDurationS(v float64) {
+	x.xxx_hidden_WorkingStatusDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 19)
+}
+
+func (x *CountryVisit) SetRestingStatusDurationS(v float64) {
+	x.xxx_hidden_RestingStatusDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 19)
+}
+
+func (x *CountryVisit) SetAvailableStatusDurationS(v float64) {
+	x.xxx_hidden_AvailableStatusDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 19)
+}
+
+func (x *CountryVisit) SetObjectIds(v []string) {
+	x.xxx_hidden_ObjectIds = v
+}
+
+func (x *CountryVisit) SetFirstDriverIds(v []string) {
+	x.xxx_hidden_FirstDriverIds = v
+}
+
+func (x *CountryVisit) SetSecondDriverIds(v []string) {
+	x.xxx_hidden_SecondDriverIds = v
+}
+
+func (x *CountryVisit) HasCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CountryVisit) HasEnteredFromCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CountryVisit) HasExitedToCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CountryVisit) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *CountryVisit) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *CountryVisit) HasMileageKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CountryVisit) HasVirtualMileageKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *CountryVisit) HasFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *CountryVisit) HasAverageFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *CountryVisit) HasWorkingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *CountryVisit) HasIdlingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *CountryVisit) HasStopDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *CountryVisit) HasDrivingStatusDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *CountryVisit) HasWorkingStatusDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *CountryVisit) HasRestingStatusDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *CountryVisit) HasAvailableStatusDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *CountryVisit) ClearCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CountryCode = nil
+}
+
+func (x *CountryVisit) ClearEnteredFromCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EnteredFromCountryCode = nil
+}
+
+func (x *CountryVisit) ClearExitedToCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ExitedToCountryCode = nil
+}
+
+func (x *CountryVisit) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *CountryVisit) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+func (x *CountryVisit) ClearMileageKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MileageKm = 0
+}
+
+func (x *CountryVisit) ClearVirtualMileageKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_VirtualMileageKm = 0
+}
+
+func (x *CountryVisit) ClearFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_FuelConsumedL = 0
+}
+
+func (x *CountryVisit) ClearAverageFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_AverageFuelConsumptionLPer_100Km = 0
+}
+
+func (x *CountryVisit) ClearWorkingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_WorkingDurationS = 0
+}
+
+func (x *CountryVisit) ClearIdlingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_IdlingDurationS = 0
+}
+
+func (x *CountryVisit) ClearStopDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_StopDurationS = 0
+}
+
+func (x *CountryVisit) ClearDrivingStatusDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_DrivingStatusDurationS = 0
+}
+
+func (x *CountryVisit) ClearWorkingStatusDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_WorkingStatusDurationS = 0
+}
+
+func (x *CountryVisit) ClearRestingStatusDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_RestingStatusDurationS = 0
+}
+
+func (x *CountryVisit) ClearAvailableStatusDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_AvailableStatusDurationS = 0
+}
+
+type CountryVisit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The code of the visited country.
+	CountryCode *string
+	// The code of the country the visit was entered from.
+	EnteredFromCountryCode *string
+	// The code of the country the visit was exited to.
+	ExitedToCountryCode *string
+	// The start of the visit.
+	Start *CountryVisit_Boundary
+	// The end of the visit.
+	End *CountryVisit_Boundary
+	// The mileage driven in the country (units: km).
+	MileageKm *float64
+	// The mileage driven in the country, calculated from GPS (units: km).
+	VirtualMileageKm *float64
+	// The fuel consumed in the country (units: l).
+	FuelConsumedL *float64
+	// The average fuel consumption in the country (units: l/100km).
+	AverageFuelConsumptionLPer_100Km *float64
+	// The working time of the object in the country in seconds.
+	WorkingDurationS *float64
+	// The idling time of the object in the country in seconds.
+	IdlingDurationS *float64
+	// The stop time of the object in the country in seconds.
+	StopDurationS *float64
+	// The time with the tachograph driving status in the country in seconds.
+	DrivingStatusDurationS *float64
+	// The time with the tachograph working status in the country in seconds.
+	WorkingStatusDurationS *float64
+	// The time with the tachograph resting status in the country in seconds.
+	RestingStatusDurationS *float64
+	// The time with the tachograph available status in the country in seconds.
+	AvailableStatusDurationS *float64
+	// The IDs of the objects involved in the visit.
+	ObjectIds []string
+	// The IDs of the first drivers during the visit.
+	FirstDriverIds []string
+	// The IDs of the second drivers during the visit.
+	SecondDriverIds []string
+}
+
+func (b0 CountryVisit_builder) Build() *CountryVisit {
+	m0 := &CountryVisit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 19)
+		x.xxx_hidden_CountryCode = b.CountryCode
+	}
+	if b.EnteredFromCountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 19)
+		x.xxx_hidden_EnteredFromCountryCode = b.EnteredFromCountryCode
+	}
+	if b.ExitedToCountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 19)
+		x.xxx_hidden_ExitedToCountryCode = b.ExitedToCountryCode
+	}
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_End = b.End
+	if b.MileageKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 19)
+		x.xxx_hidden_MileageKm = *b.MileageKm
+	}
+	if b.VirtualMileageKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 19)
+		x.xxx_hidden_VirtualMileageKm = *b.VirtualMileageKm
+	}
+	if b.FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 19)
+		x.xxx_hidden_FuelConsumedL = *b.FuelConsumedL
+	}
+	if b.AverageFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 19)
+		x.xxx_hidden_AverageFuelConsumptionLPer_100Km = *b.AverageFuelConsumptionLPer_100Km
+	}
+	if b.WorkingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 19)
+		x.xxx_hidden_WorkingDurationS = *b.WorkingDurationS
+	}
+	if b.IdlingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 19)
+		x.xxx_hidden_IdlingDurationS = *b.IdlingDurationS
+	}
+	if b.StopDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 19)
+		x.xxx_hidden_StopDurationS = *b.StopDurationS
+	}
+	if b.DrivingStatusDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 19)
+		x.xxx_hidden_DrivingStatusDurationS = *b.DrivingStatusDurationS
+	}
+	if b.WorkingStatusDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 19)
+		x.xxx_hidden_WorkingStatusDurationS = *b.WorkingStatusDurationS
+	}
+	if b.RestingStatusDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 19)
+		x.xxx_hidden_RestingStatusDurationS = *b.RestingStatusDurationS
+	}
+	if b.AvailableStatusDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 19)
+		x.xxx_hidden_AvailableStatusDurationS = *b.AvailableStatusDurationS
+	}
+	x.xxx_hidden_ObjectIds = b.ObjectIds
+	x.xxx_hidden_FirstDriverIds = b.FirstDriverIds
+	x.xxx_hidden_SecondDriverIds = b.SecondDriverIds
+	return m0
+}
+
+// The start or end of a country visit.
+type CountryVisit_Boundary struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_Latitude    float64                `protobuf:"fixed64,2,opt,name=latitude"`
+	xxx_hidden_Longitude   float64                `protobuf:"fixed64,3,opt,name=longitude"`
+	xxx_hidden_Address     *Address               `protobuf:"bytes,4,opt,name=address"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CountryVisit_Boundary) Reset() {
+	*x = CountryVisit_Boundary{}
+	mi := &file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryVisit_Boundary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryVisit_Boundary) ProtoMessage() {}
+
+func (x *CountryVisit_Boundary) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CountryVisit_Boundary) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *CountryVisit_Boundary) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *CountryVisit_Boundary) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *CountryVisit_Boundary) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *CountryVisit_Boundary) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *CountryVisit_Boundary) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *CountryVisit_Boundary) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CountryVisit_Boundary) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *CountryVisit_Boundary) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *CountryVisit_Boundary) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CountryVisit_Boundary) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CountryVisit_Boundary) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *CountryVisit_Boundary) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *CountryVisit_Boundary) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *CountryVisit_Boundary) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Longitude = 0
+}
+
+func (x *CountryVisit_Boundary) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+type CountryVisit_Boundary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The datetime of the boundary.
+	Time *timestamppb.Timestamp
+	// The latitude coordinate.
+	Latitude *float64
+	// The longitude coordinate.
+	Longitude *float64
+	// The address at the boundary.
+	Address *Address
+}
+
+func (b0 CountryVisit_Boundary_builder) Build() *CountryVisit_Boundary {
+	m0 := &CountryVisit_Boundary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	x.xxx_hidden_Address = b.Address
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_country_visit_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_country_visit_proto_rawDesc = "" +
+	"\n" +
+	"5wayplatform/connect/trusttrack/v1/country_visit.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a/wayplatform/connect/trusttrack/v1/address.proto\"\x99\v\n" +
+	"\fCountryVisit\x12)\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vcountryCode\x129\n" +
+	"\x19entered_from_country_code\x18\x02 \x01(\tR\x16enteredFromCountryCode\x123\n" +
+	"\x16exited_to_country_code\x18\x03 \x01(\tR\x13exitedToCountryCode\x12N\n" +
+	"\x05start\x18\x04 \x01(\v28.wayplatform.connect.trusttrack.v1.CountryVisit.BoundaryR\x05start\x12J\n" +
+	"\x03end\x18\x05 \x01(\v28.wayplatform.connect.trusttrack.v1.CountryVisit.BoundaryR\x03end\x12-\n" +
+	"\n" +
+	"mileage_km\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tmileageKm\x12<\n" +
+	"\x12virtual_mileage_km\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x10virtualMileageKm\x126\n" +
+	"\x0ffuel_consumed_l\x18\b \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rfuelConsumedL\x12]\n" +
+	"$average_fuel_consumption_l_per_100km\x18\t \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x1faverageFuelConsumptionLPer100km\x12<\n" +
+	"\x12working_duration_s\x18\n" +
+	" \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x10workingDurationS\x12:\n" +
+	"\x11idling_duration_s\x18\v \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x0fidlingDurationS\x126\n" +
+	"\x0fstop_duration_s\x18\f \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rstopDurationS\x12I\n" +
+	"\x19driving_status_duration_s\x18\r \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x16drivingStatusDurationS\x12I\n" +
+	"\x19working_status_duration_s\x18\x0e \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x16workingStatusDurationS\x12I\n" +
+	"\x19resting_status_duration_s\x18\x0f \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x16restingStatusDurationS\x12M\n" +
+	"\x1bavailable_status_duration_s\x18\x10 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x18availableStatusDurationS\x12\x1d\n" +
+	"\n" +
+	"object_ids\x18\x11 \x03(\tR\tobjectIds\x12(\n" +
+	"\x10first_driver_ids\x18\x12 \x03(\tR\x0efirstDriverIds\x12*\n" +
+	"\x11second_driver_ids\x18\x13 \x03(\tR\x0fsecondDriverIds\x1a\xf6\x01\n" +
+	"\bBoundary\x128\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\x04time\x123\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x12D\n" +
+	"\aaddress\x18\x04 \x01(\v2*.wayplatform.connect.trusttrack.v1.AddressR\aaddressB\xc4\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x11CountryVisitProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_country_visit_proto_goTypes = []any{
+	(*CountryVisit)(nil),          // 0: wayplatform.connect.trusttrack.v1.CountryVisit
+	(*CountryVisit_Boundary)(nil), // 1: wayplatform.connect.trusttrack.v1.CountryVisit.Boundary
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Address)(nil),               // 3: wayplatform.connect.trusttrack.v1.Address
+}
+var file_wayplatform_connect_trusttrack_v1_country_visit_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.trusttrack.v1.CountryVisit.start:type_name -> wayplatform.connect.trusttrack.v1.CountryVisit.Boundary
+	1, // 1: wayplatform.connect.trusttrack.v1.CountryVisit.end:type_name -> wayplatform.connect.trusttrack.v1.CountryVisit.Boundary
+	2, // 2: wayplatform.connect.trusttrack.v1.CountryVisit.Boundary.time:type_name -> google.protobuf.Timestamp
+	3, // 3: wayplatform.connect.trusttrack.v1.CountryVisit.Boundary.address:type_name -> wayplatform.connect.trusttrack.v1.Address
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_country_visit_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_country_visit_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_country_visit_proto != nil {
+		return
+	}
+	file_wayplatform_connect_trusttrack_v1_address_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_country_visit_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_country_visit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_country_visit_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_country_visit_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_country_visit_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_country_visit_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_country_visit_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_country_visit_proto_depIdxs = nil
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/detected_event.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An event detected by TrustTrack for an object.
+type DetectedEvent struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId        *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_Name            *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Description     *string                `protobuf:"bytes,3,opt,name=description"`
+	xxx_hidden_DriverId        *string                `protobuf:"bytes,4,opt,name=driver_id,json=driverId"`
+	xxx_hidden_TripType        TripType               `protobuf:"varint,5,opt,name=trip_type,json=tripType,enum=wayplatform.connect.trusttrack.v1.TripType"`
+	xxx_hidden_UnknownTripType *string                `protobuf:"bytes,6,opt,name=unknown_trip_type,json=unknownTripType"`
+	xxx_hidden_DurationS       float64                `protobuf:"fixed64,7,opt,name=duration_s,json=durationS"`
+	xxx_hidden_Start           *DetectedEvent_Metrics `protobuf:"bytes,8,opt,name=start"`
+	xxx_hidden_End             *DetectedEvent_Metrics `protobuf:"bytes,9,opt,name=end"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DetectedEvent) Reset() {
+	*x = DetectedEvent{}
+	mi := &file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedEvent) ProtoMessage() {}
+
+func (x *DetectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DetectedEvent) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetTripType() TripType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_TripType
+		}
+	}
+	return TripType_TRIP_TYPE_UNSPECIFIED
+}
+
+func (x *DetectedEvent) GetUnknownTripType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownTripType != nil {
+			return *x.xxx_hidden_UnknownTripType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *DetectedEvent) GetStart() *DetectedEvent_Metrics {
+	if x != nil {
+		return x.xxx_hidden_Start
+	}
+	return nil
+}
+
+func (x *DetectedEvent) GetEnd() *DetectedEvent_Metrics {
+	if x != nil {
+		return x.xxx_hidden_End
+	}
+	return nil
+}
+
+func (x *DetectedEvent) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *DetectedEvent) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *DetectedEvent) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *DetectedEvent) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *DetectedEvent) SetTripType(v TripType) {
+	x.xxx_hidden_TripType = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *DetectedEvent) SetUnknownTripType(v string) {
+	x.xxx_hidden_UnknownTripType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *DetectedEvent) SetDurationS(v float64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *DetectedEvent) SetStart(v *DetectedEvent_Metrics) {
+	x.xxx_hidden_Start = v
+}
+
+func (x *DetectedEvent) SetEnd(v *DetectedEvent_Metrics) {
+	x.xxx_hidden_End = v
+}
+
+func (x *DetectedEvent) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DetectedEvent) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DetectedEvent) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DetectedEvent) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DetectedEvent) HasTripType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DetectedEvent) HasUnknownTripType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *DetectedEvent) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *DetectedEvent) HasStart() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Start != nil
+}
+
+func (x *DetectedEvent) HasEnd() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_End != nil
+}
+
+func (x *DetectedEvent) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *DetectedEvent) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *DetectedEvent) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *DetectedEvent) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *DetectedEvent) ClearTripType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TripType = TripType_TRIP_TYPE_UNSPECIFIED
+}
+
+func (x *DetectedEvent) ClearUnknownTripType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnknownTripType = nil
+}
+
+func (x *DetectedEvent) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *DetectedEvent) ClearStart() {
+	x.xxx_hidden_Start = nil
+}
+
+func (x *DetectedEvent) ClearEnd() {
+	x.xxx_hidden_End = nil
+}
+
+type DetectedEvent_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object the event was detected for.
+	ObjectId *string
+	// The name of the event.
+	Name *string
+	// The description of the event.
+	Description *string
+	// The ID of the driver associated with this event.
+	DriverId *string
+	// The trip type during the event.
+	TripType *TripType
+	// The unknown trip type of the event.
+	// This field is used when the trip_type is TRIP_TYPE_UNKNOWN.
+	UnknownTripType *string
+	// The duration of the event in seconds.
+	DurationS *float64
+	// Event start metrics.
+	Start *DetectedEvent_Metrics
+	// Event end metrics.
+	End *DetectedEvent_Metrics
+}
+
+func (b0 DetectedEvent_builder) Build() *DetectedEvent {
+	m0 := &DetectedEvent{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	if b.TripType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_TripType = *b.TripType
+	}
+	if b.UnknownTripType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_UnknownTripType = b.UnknownTripType
+	}
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	x.xxx_hidden_Start = b.Start
+	x.xxx_hidden_End = b.End
+	return m0
+}
+
+// Event metrics for start or end of an event.
+type DetectedEvent_Metrics struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_Latitude    float64                `protobuf:"fixed64,2,opt,name=latitude"`
+	xxx_hidden_Longitude   float64                `protobuf:"fixed64,3,opt,name=longitude"`
+	xxx_hidden_MileageKm   float64                `protobuf:"fixed64,4,opt,name=mileage_km,json=mileageKm"`
+	xxx_hidden_SpeedKmh    float64                `protobuf:"fixed64,5,opt,name=speed_kmh,json=speedKmh"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DetectedEvent_Metrics) Reset() {
+	*x = DetectedEvent_Metrics{}
+	mi := &file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectedEvent_Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedEvent_Metrics) ProtoMessage() {}
+
+func (x *DetectedEvent_Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DetectedEvent_Metrics) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *DetectedEvent_Metrics) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *DetectedEvent_Metrics) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *DetectedEvent_Metrics) GetMileageKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_MileageKm
+	}
+	return 0
+}
+
+func (x *DetectedEvent_Metrics) GetSpeedKmh() float64 {
+	if x != nil {
+		return x.xxx_hidden_SpeedKmh
+	}
+	return 0
+}
+
+func (x *DetectedEvent_Metrics) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *DetectedEvent_Metrics) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DetectedEvent_Metrics) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DetectedEvent_Metrics) SetMileageKm(v float64) {
+	x.xxx_hidden_MileageKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DetectedEvent_Metrics) SetSpeedKmh(v float64) {
+	x.xxx_hidden_SpeedKmh = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DetectedEvent_Metrics) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *DetectedEvent_Metrics) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DetectedEvent_Metrics) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DetectedEvent_Metrics) HasMileageKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DetectedEvent_Metrics) HasSpeedKmh() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DetectedEvent_Metrics) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *DetectedEvent_Metrics) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *DetectedEvent_Metrics) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Longitude = 0
+}
+
+func (x *DetectedEvent_Metrics) ClearMileageKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MileageKm = 0
+}
+
+func (x *DetectedEvent_Metrics) ClearSpeedKmh() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_SpeedKmh = 0
+}
+
+type DetectedEvent_Metrics_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The datetime of the event metric.
+	Time *timestamppb.Timestamp
+	// The latitude coordinate.
+	Latitude *float64
+	// The longitude coordinate.
+	Longitude *float64
+	// The mileage of the object (units: km)
+	MileageKm *float64
+	// The speed of the object, only set at the start of an event (units: km/h)
+	SpeedKmh *float64
+}
+
+func (b0 DetectedEvent_Metrics_builder) Build() *DetectedEvent_Metrics {
+	m0 := &DetectedEvent_Metrics{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	if b.MileageKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_MileageKm = *b.MileageKm
+	}
+	if b.SpeedKmh != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_SpeedKmh = *b.SpeedKmh
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_detected_event_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_detected_event_proto_rawDesc = "" +
+	"\n" +
+	"6wayplatform/connect/trusttrack/v1/detected_event.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a1wayplatform/connect/trusttrack/v1/trip_type.proto\"\x8e\b\n" +
+	"\rDetectedEvent\x12(\n" +
+	"\tobject_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\bobjectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tdriver_id\x18\x04 \x01(\tR\bdriverId\x12T\n" +
+	"\ttrip_type\x18\x05 \x01(\x0e2+.wayplatform.connect.trusttrack.v1.TripTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\btripType\x12*\n" +
+	"\x11unknown_trip_type\x18\x06 \x01(\tR\x0funknownTripType\x12-\n" +
+	"\n" +
+	"duration_s\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tdurationS\x12N\n" +
+	"\x05start\x18\b \x01(\v28.wayplatform.connect.trusttrack.v1.DetectedEvent.MetricsR\x05start\x12J\n" +
+	"\x03end\x18\t \x01(\v28.wayplatform.connect.trusttrack.v1.DetectedEvent.MetricsR\x03end\x1a\xbe\x03\n" +
+	"\aMetrics\x12;\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\x04time\x123\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x12-\n" +
+	"\n" +
+	"mileage_km\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tmileageKm\x124\n" +
+	"\tspeed_kmh\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00y@)\x00\x00\x00\x00\x00\x00\x00\x00R\bspeedKmh:\xa4\x01\xbaH\xa0\x01\x1a\x9d\x01\n" +
+	"\vnull_island\x12'position must not be null island (0, 0)\x1ae!has(this.latitude) || !has(this.longitude) ? true : !(this.latitude == 0.0 && this.longitude == 0.0):r\xbaHo\x1am\n" +
+	"\x19unknown_trip_type.warning\x122unknown_trip_type indicates an unhandled trip type\x1a\x1c!has(this.unknown_trip_type)B\xc5\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x12DetectedEventProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_detected_event_proto_goTypes = []any{
+	(*DetectedEvent)(nil),         // 0: wayplatform.connect.trusttrack.v1.DetectedEvent
+	(*DetectedEvent_Metrics)(nil), // 1: wayplatform.connect.trusttrack.v1.DetectedEvent.Metrics
+	(TripType)(0),                 // 2: wayplatform.connect.trusttrack.v1.TripType
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_detected_event_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.trusttrack.v1.DetectedEvent.trip_type:type_name -> wayplatform.connect.trusttrack.v1.TripType
+	1, // 1: wayplatform.connect.trusttrack.v1.DetectedEvent.start:type_name -> wayplatform.connect.trusttrack.v1.DetectedEvent.Metrics
+	1, // 2: wayplatform.connect.trusttrack.v1.DetectedEvent.end:type_name -> wayplatform.connect.trusttrack.v1.DetectedEvent.Metrics
+	3, // 3: wayplatform.connect.trusttrack.v1.DetectedEvent.Metrics.time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_detected_event_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_detected_event_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_detected_event_proto != nil {
+		return
+	}
+	file_wayplatform_connect_trusttrack_v1_trip_type_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_detected_event_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_detected_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_detected_event_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_detected_event_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_detected_event_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_detected_event_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_detected_event_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_detected_event_proto_depIdxs = nil
+}
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/driver_assignation.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a driver assignation event type.
+type DriverAssignation_EventType int32
+
+const (
+	DriverAssignation_EVENT_TYPE_UNSPECIFIED   DriverAssignation_EventType = 0
+	DriverAssignation_EVENT_TYPE_UNKNOWN       DriverAssignation_EventType = 1
+	DriverAssignation_EVENT_TYPE_NOT_AVAILABLE DriverAssignation_EventType = 2
+	// The driver starts driving the object.
+	DriverAssignation_START DriverAssignation_EventType = 3
+	// The driver stops driving the object.
+	DriverAssignation_STOP DriverAssignation_EventType = 4
+)
+
+// Enum value maps for DriverAssignation_EventType.
+var (
+	DriverAssignation_EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_UNKNOWN",
+		2: "EVENT_TYPE_NOT_AVAILABLE",
+		3: "START",
+		4: "STOP",
+	}
+	DriverAssignation_EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":   0,
+		"EVENT_TYPE_UNKNOWN":       1,
+		"EVENT_TYPE_NOT_AVAILABLE": 2,
+		"START":                    3,
+		"STOP":                     4,
+	}
+)
+
+func (x DriverAssignation_EventType) Enum() *DriverAssignation_EventType {
+	p := new(DriverAssignation_EventType)
+	*p = x
+	return p
+}
+
+func (x DriverAssignation_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverAssignation_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverAssignation_EventType) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_enumTypes[0]
+}
+
+func (x DriverAssignation_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// An assignation of a driver to an object, or the end of one.
+type DriverAssignation struct {
+	state                       protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_DriverId         *string                     `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_ObjectId         *string                     `protobuf:"bytes,2,opt,name=object_id,json=objectId"`
+	xxx_hidden_Time             *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=time"`
+	xxx_hidden_EventType        DriverAssignation_EventType `protobuf:"varint,4,opt,name=event_type,json=eventType,enum=wayplatform.connect.trusttrack.v1.DriverAssignation_EventType"`
+	xxx_hidden_UnknownEventType *string                     `protobuf:"bytes,5,opt,name=unknown_event_type,json=unknownEventType"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *DriverAssignation) Reset() {
+	*x = DriverAssignation{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverAssignation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverAssignation) ProtoMessage() {}
+
+func (x *DriverAssignation) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverAssignation) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverAssignation) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverAssignation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *DriverAssignation) GetEventType() DriverAssignation_EventType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_EventType
+		}
+	}
+	return DriverAssignation_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *DriverAssignation) GetUnknownEventType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownEventType != nil {
+			return *x.xxx_hidden_UnknownEventType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverAssignation) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DriverAssignation) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DriverAssignation) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *DriverAssignation) SetEventType(v DriverAssignation_EventType) {
+	x.xxx_hidden_EventType = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DriverAssignation) SetUnknownEventType(v string) {
+	x.xxx_hidden_UnknownEventType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DriverAssignation) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverAssignation) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverAssignation) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *DriverAssignation) HasEventType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverAssignation) HasUnknownEventType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverAssignation) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *DriverAssignation) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *DriverAssignation) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *DriverAssignation) ClearEventType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_EventType = DriverAssignation_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *DriverAssignation) ClearUnknownEventType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnknownEventType = nil
+}
+
+type DriverAssignation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the assigned driver.
+	DriverId *string
+	// The ID of the object the driver is assigned to.
+	ObjectId *string
+	// The datetime of the assignation event.
+	Time *timestamppb.Timestamp
+	// The type of assignation event.
+	EventType *DriverAssignation_EventType
+	// The unknown event type.
+	// This field is used when the event_type is EVENT_TYPE_UNKNOWN.
+	UnknownEventType *string
+}
+
+func (b0 DriverAssignation_builder) Build() *DriverAssignation {
+	m0 := &DriverAssignation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_Time = b.Time
+	if b.EventType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_EventType = *b.EventType
+	}
+	if b.UnknownEventType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_UnknownEventType = b.UnknownEventType
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_driver_assignation_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_rawDesc = "" +
+	"\n" +
+	":wayplatform/connect/trusttrack/v1/driver_assignation.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x03\n" +
+	"\x11DriverAssignation\x12#\n" +
+	"\tdriver_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bdriverId\x12(\n" +
+	"\tobject_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\bobjectId\x12;\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\x04time\x12l\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2>.wayplatform.connect.trusttrack.v1.DriverAssignation.EventTypeB\r\xbaH\n" +
+	"\xc8\x01\x01\x82\x01\x04\x10\x01 \x00R\teventType\x12,\n" +
+	"\x12unknown_event_type\x18\x05 \x01(\tR\x10unknownEventType\"r\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EVENT_TYPE_UNKNOWN\x10\x01\x12\x1c\n" +
+	"\x18EVENT_TYPE_NOT_AVAILABLE\x10\x02\x12\t\n" +
+	"\x05START\x10\x03\x12\b\n" +
+	"\x04STOP\x10\x04B\xc9\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x16DriverAssignationProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_goTypes = []any{
+	(DriverAssignation_EventType)(0), // 0: wayplatform.connect.trusttrack.v1.DriverAssignation.EventType
+	(*DriverAssignation)(nil),        // 1: wayplatform.connect.trusttrack.v1.DriverAssignation
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.trusttrack.v1.DriverAssignation.time:type_name -> google.protobuf.Timestamp
+	0, // 1: wayplatform.connect.trusttrack.v1.DriverAssignation.event_type:type_name -> wayplatform.connect.trusttrack.v1.DriverAssignation.EventType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_driver_assignation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_driver_assignation_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_depIdxs = nil
+}
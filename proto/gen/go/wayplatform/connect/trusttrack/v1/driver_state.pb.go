@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/driver_state.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a tachograph driver activity.
+type DriverState_Activity int32
+
+const (
+	DriverState_ACTIVITY_UNSPECIFIED   DriverState_Activity = 0
+	DriverState_ACTIVITY_UNKNOWN       DriverState_Activity = 1
+	DriverState_ACTIVITY_NOT_AVAILABLE DriverState_Activity = 2
+	DriverState_DRIVING                DriverState_Activity = 3
+	DriverState_WORKING                DriverState_Activity = 4
+	DriverState_AVAILABLE              DriverState_Activity = 5
+	DriverState_RESTING                DriverState_Activity = 6
+)
+
+// Enum value maps for DriverState_Activity.
+var (
+	DriverState_Activity_name = map[int32]string{
+		0: "ACTIVITY_UNSPECIFIED",
+		1: "ACTIVITY_UNKNOWN",
+		2: "ACTIVITY_NOT_AVAILABLE",
+		3: "DRIVING",
+		4: "WORKING",
+		5: "AVAILABLE",
+		6: "RESTING",
+	}
+	DriverState_Activity_value = map[string]int32{
+		"ACTIVITY_UNSPECIFIED":   0,
+		"ACTIVITY_UNKNOWN":       1,
+		"ACTIVITY_NOT_AVAILABLE": 2,
+		"DRIVING":                3,
+		"WORKING":                4,
+		"AVAILABLE":              5,
+		"RESTING":                6,
+	}
+)
+
+func (x DriverState_Activity) Enum() *DriverState_Activity {
+	p := new(DriverState_Activity)
+	*p = x
+	return p
+}
+
+func (x DriverState_Activity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverState_Activity) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_driver_state_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverState_Activity) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_driver_state_proto_enumTypes[0]
+}
+
+func (x DriverState_Activity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// A tachograph activity period of a driver.
+type DriverState struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId        *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_ObjectId        *string                `protobuf:"bytes,2,opt,name=object_id,json=objectId"`
+	xxx_hidden_PlateNumber     *string                `protobuf:"bytes,3,opt,name=plate_number,json=plateNumber"`
+	xxx_hidden_Activity        DriverState_Activity   `protobuf:"varint,4,opt,name=activity,enum=wayplatform.connect.trusttrack.v1.DriverState_Activity"`
+	xxx_hidden_UnknownActivity *string                `protobuf:"bytes,5,opt,name=unknown_activity,json=unknownActivity"`
+	xxx_hidden_StartTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime"`
+	xxx_hidden_EndTime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime"`
+	xxx_hidden_DurationS       float64                `protobuf:"fixed64,8,opt,name=duration_s,json=durationS"`
+	xxx_hidden_StartPosition   *Position              `protobuf:"bytes,9,opt,name=start_position,json=startPosition"`
+	xxx_hidden_EndPosition     *Position              `protobuf:"bytes,10,opt,name=end_position,json=endPosition"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DriverState) Reset() {
+	*x = DriverState{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverState) ProtoMessage() {}
+
+func (x *DriverState) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverState) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverState) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverState) GetPlateNumber() string {
+	if x != nil {
+		if x.xxx_hidden_PlateNumber != nil {
+			return *x.xxx_hidden_PlateNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverState) GetActivity() DriverState_Activity {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Activity
+		}
+	}
+	return DriverState_ACTIVITY_UNSPECIFIED
+}
+
+func (x *DriverState) GetUnknownActivity() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownActivity != nil {
+			return *x.xxx_hidden_UnknownActivity
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverState) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartTime
+	}
+	return nil
+}
+
+func (x *DriverState) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_EndTime
+	}
+	return nil
+}
+
+func (x *DriverState) GetDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_DurationS
+	}
+	return 0
+}
+
+func (x *DriverState) GetStartPosition() *Position {
+	if x != nil {
+		return x.xxx_hidden_StartPosition
+	}
+	return nil
+}
+
+func (x *DriverState) GetEndPosition() *Position {
+	if x != nil {
+		return x.xxx_hidden_EndPosition
+	}
+	return nil
+}
+
+func (x *DriverState) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 10)
+}
+
+func (x *DriverState) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 10)
+}
+
+func (x *DriverState) SetPlateNumber(v string) {
+	x.xxx_hidden_PlateNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
+}
+
+func (x *DriverState) SetActivity(v DriverState_Activity) {
+	x.xxx_hidden_Activity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 10)
+}
+
+func (x *DriverState) SetUnknownActivity(v string) {
+	x.xxx_hidden_UnknownActivity = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
+}
+
+func (x *DriverState) SetStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartTime = v
+}
+
+func (x *DriverState) SetEndTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_EndTime = v
+}
+
+func (x *DriverState) SetDurationS(v float64) {
+	x.xxx_hidden_DurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+}
+
+func (x *DriverState) SetStartPosition(v *Position) {
+	x.xxx_hidden_StartPosition = v
+}
+
+func (x *DriverState) SetEndPosition(v *Position) {
+	x.xxx_hidden_EndPosition = v
+}
+
+func (x *DriverState) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverState) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverState) HasPlateNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverState) HasActivity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverState) HasUnknownActivity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverState) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartTime != nil
+}
+
+func (x *DriverState) HasEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndTime != nil
+}
+
+func (x *DriverState) HasDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *DriverState) HasStartPosition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartPosition != nil
+}
+
+func (x *DriverState) HasEndPosition() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_EndPosition != nil
+}
+
+func (x *DriverState) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *DriverState) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *DriverState) ClearPlateNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PlateNumber = nil
+}
+
+func (x *DriverState) ClearActivity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Activity = DriverState_ACTIVITY_UNSPECIFIED
+}
+
+func (x *DriverState) ClearUnknownActivity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnknownActivity = nil
+}
+
+func (x *DriverState) ClearStartTime() {
+	x.xxx_hidden_StartTime = nil
+}
+
+func (x *DriverState) ClearEndTime() {
+	x.xxx_hidden_EndTime = nil
+}
+
+func (x *DriverState) ClearDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_DurationS = 0
+}
+
+func (x *DriverState) ClearStartPosition() {
+	x.xxx_hidden_StartPosition = nil
+}
+
+func (x *DriverState) ClearEndPosition() {
+	x.xxx_hidden_EndPosition = nil
+}
+
+type DriverState_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver.
+	DriverId *string
+	// The ID of the object the driver was assigned to.
+	ObjectId *string
+	// The plate number of the object the driver was assigned to.
+	PlateNumber *string
+	// The activity of the driver.
+	Activity *DriverState_Activity
+	// The unknown activity of the driver.
+	// This field is used when the activity is ACTIVITY_UNKNOWN.
+	UnknownActivity *string
+	// The start time of the activity.
+	StartTime *timestamppb.Timestamp
+	// The end time of the activity.
+	EndTime *timestamppb.Timestamp
+	// The duration of the activity in seconds.
+	DurationS *float64
+	// The position at the start of the activity.
+	StartPosition *Position
+	// The position at the end of the activity.
+	EndPosition *Position
+}
+
+func (b0 DriverState_builder) Build() *DriverState {
+	m0 := &DriverState{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 10)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 10)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.PlateNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
+		x.xxx_hidden_PlateNumber = b.PlateNumber
+	}
+	if b.Activity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 10)
+		x.xxx_hidden_Activity = *b.Activity
+	}
+	if b.UnknownActivity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 10)
+		x.xxx_hidden_UnknownActivity = b.UnknownActivity
+	}
+	x.xxx_hidden_StartTime = b.StartTime
+	x.xxx_hidden_EndTime = b.EndTime
+	if b.DurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
+		x.xxx_hidden_DurationS = *b.DurationS
+	}
+	x.xxx_hidden_StartPosition = b.StartPosition
+	x.xxx_hidden_EndPosition = b.EndPosition
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_driver_state_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_driver_state_proto_rawDesc = "" +
+	"\n" +
+	"4wayplatform/connect/trusttrack/v1/driver_state.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a0wayplatform/connect/trusttrack/v1/position.proto\"\xd9\x06\n" +
+	"\vDriverState\x12#\n" +
+	"\tdriver_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bdriverId\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\tR\bobjectId\x12!\n" +
+	"\fplate_number\x18\x03 \x01(\tR\vplateNumber\x12_\n" +
+	"\bactivity\x18\x04 \x01(\x0e27.wayplatform.connect.trusttrack.v1.DriverState.ActivityB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bactivity\x12)\n" +
+	"\x10unknown_activity\x18\x05 \x01(\tR\x0funknownActivity\x12F\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\tstartTime\x12?\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\aendTime\x12-\n" +
+	"\n" +
+	"duration_s\x18\b \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tdurationS\x12R\n" +
+	"\x0estart_position\x18\t \x01(\v2+.wayplatform.connect.trusttrack.v1.PositionR\rstartPosition\x12N\n" +
+	"\fend_position\x18\n" +
+	" \x01(\v2+.wayplatform.connect.trusttrack.v1.PositionR\vendPosition\"\x8c\x01\n" +
+	"\bActivity\x12\x18\n" +
+	"\x14ACTIVITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ACTIVITY_UNKNOWN\x10\x01\x12\x1a\n" +
+	"\x16ACTIVITY_NOT_AVAILABLE\x10\x02\x12\v\n" +
+	"\aDRIVING\x10\x03\x12\v\n" +
+	"\aWORKING\x10\x04\x12\r\n" +
+	"\tAVAILABLE\x10\x05\x12\v\n" +
+	"\aRESTING\x10\x06:n\xbaHk\x1ai\n" +
+	"\x18unknown_activity.warning\x120unknown_activity indicates an unhandled activity\x1a\x1b!has(this.unknown_activity)B\xc3\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x10DriverStateProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_driver_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_driver_state_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_driver_state_proto_goTypes = []any{
+	(DriverState_Activity)(0),     // 0: wayplatform.connect.trusttrack.v1.DriverState.Activity
+	(*DriverState)(nil),           // 1: wayplatform.connect.trusttrack.v1.DriverState
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Position)(nil),              // 3: wayplatform.connect.trusttrack.v1.Position
+}
+var file_wayplatform_connect_trusttrack_v1_driver_state_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.trusttrack.v1.DriverState.activity:type_name -> wayplatform.connect.trusttrack.v1.DriverState.Activity
+	2, // 1: wayplatform.connect.trusttrack.v1.DriverState.start_time:type_name -> google.protobuf.Timestamp
+	2, // 2: wayplatform.connect.trusttrack.v1.DriverState.end_time:type_name -> google.protobuf.Timestamp
+	3, // 3: wayplatform.connect.trusttrack.v1.DriverState.start_position:type_name -> wayplatform.connect.trusttrack.v1.Position
+	3, // 4: wayplatform.connect.trusttrack.v1.DriverState.end_position:type_name -> wayplatform.connect.trusttrack.v1.Position
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_driver_state_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_driver_state_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_driver_state_proto != nil {
+		return
+	}
+	file_wayplatform_connect_trusttrack_v1_position_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_driver_state_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_driver_state_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_driver_state_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_driver_state_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_driver_state_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_driver_state_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_driver_state_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_driver_state_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_driver_state_proto_depIdxs = nil
+}
@@ -0,0 +1,1780 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/driver_time_analysis.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents the role of a driver in the vehicle.
+type DriverTimeAnalysis_Role int32
+
+const (
+	DriverTimeAnalysis_ROLE_UNSPECIFIED   DriverTimeAnalysis_Role = 0
+	DriverTimeAnalysis_ROLE_UNKNOWN       DriverTimeAnalysis_Role = 1
+	DriverTimeAnalysis_ROLE_NOT_AVAILABLE DriverTimeAnalysis_Role = 2
+	DriverTimeAnalysis_PRIMARY            DriverTimeAnalysis_Role = 3
+	DriverTimeAnalysis_SECONDARY          DriverTimeAnalysis_Role = 4
+)
+
+// Enum value maps for DriverTimeAnalysis_Role.
+var (
+	DriverTimeAnalysis_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_UNKNOWN",
+		2: "ROLE_NOT_AVAILABLE",
+		3: "PRIMARY",
+		4: "SECONDARY",
+	}
+	DriverTimeAnalysis_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED":   0,
+		"ROLE_UNKNOWN":       1,
+		"ROLE_NOT_AVAILABLE": 2,
+		"PRIMARY":            3,
+		"SECONDARY":          4,
+	}
+)
+
+func (x DriverTimeAnalysis_Role) Enum() *DriverTimeAnalysis_Role {
+	p := new(DriverTimeAnalysis_Role)
+	*p = x
+	return p
+}
+
+func (x DriverTimeAnalysis_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverTimeAnalysis_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverTimeAnalysis_Role) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[0]
+}
+
+func (x DriverTimeAnalysis_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents a driver activity.
+type DriverTimeAnalysis_State_Activity int32
+
+const (
+	DriverTimeAnalysis_State_ACTIVITY_UNSPECIFIED   DriverTimeAnalysis_State_Activity = 0
+	DriverTimeAnalysis_State_ACTIVITY_UNKNOWN       DriverTimeAnalysis_State_Activity = 1
+	DriverTimeAnalysis_State_ACTIVITY_NOT_AVAILABLE DriverTimeAnalysis_State_Activity = 2
+	DriverTimeAnalysis_State_RESTING                DriverTimeAnalysis_State_Activity = 3
+	DriverTimeAnalysis_State_AVAILABLE              DriverTimeAnalysis_State_Activity = 4
+	DriverTimeAnalysis_State_WORKING                DriverTimeAnalysis_State_Activity = 5
+	DriverTimeAnalysis_State_DRIVING                DriverTimeAnalysis_State_Activity = 6
+	DriverTimeAnalysis_State_ERROR                  DriverTimeAnalysis_State_Activity = 7
+	DriverTimeAnalysis_State_UNAVAILABLE            DriverTimeAnalysis_State_Activity = 8
+)
+
+// Enum value maps for DriverTimeAnalysis_State_Activity.
+var (
+	DriverTimeAnalysis_State_Activity_name = map[int32]string{
+		0: "ACTIVITY_UNSPECIFIED",
+		1: "ACTIVITY_UNKNOWN",
+		2: "ACTIVITY_NOT_AVAILABLE",
+		3: "RESTING",
+		4: "AVAILABLE",
+		5: "WORKING",
+		6: "DRIVING",
+		7: "ERROR",
+		8: "UNAVAILABLE",
+	}
+	DriverTimeAnalysis_State_Activity_value = map[string]int32{
+		"ACTIVITY_UNSPECIFIED":   0,
+		"ACTIVITY_UNKNOWN":       1,
+		"ACTIVITY_NOT_AVAILABLE": 2,
+		"RESTING":                3,
+		"AVAILABLE":              4,
+		"WORKING":                5,
+		"DRIVING":                6,
+		"ERROR":                  7,
+		"UNAVAILABLE":            8,
+	}
+)
+
+func (x DriverTimeAnalysis_State_Activity) Enum() *DriverTimeAnalysis_State_Activity {
+	p := new(DriverTimeAnalysis_State_Activity)
+	*p = x
+	return p
+}
+
+func (x DriverTimeAnalysis_State_Activity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverTimeAnalysis_State_Activity) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[1].Descriptor()
+}
+
+func (DriverTimeAnalysis_State_Activity) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[1]
+}
+
+func (x DriverTimeAnalysis_State_Activity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents whether a driver is driving alone or in a crew.
+type DriverTimeAnalysis_CurrentDay_DrivingStatus int32
+
+const (
+	DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNSPECIFIED   DriverTimeAnalysis_CurrentDay_DrivingStatus = 0
+	DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNKNOWN       DriverTimeAnalysis_CurrentDay_DrivingStatus = 1
+	DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_NOT_AVAILABLE DriverTimeAnalysis_CurrentDay_DrivingStatus = 2
+	DriverTimeAnalysis_CurrentDay_SINGLE                       DriverTimeAnalysis_CurrentDay_DrivingStatus = 3
+	DriverTimeAnalysis_CurrentDay_CREW                         DriverTimeAnalysis_CurrentDay_DrivingStatus = 4
+)
+
+// Enum value maps for DriverTimeAnalysis_CurrentDay_DrivingStatus.
+var (
+	DriverTimeAnalysis_CurrentDay_DrivingStatus_name = map[int32]string{
+		0: "DRIVING_STATUS_UNSPECIFIED",
+		1: "DRIVING_STATUS_UNKNOWN",
+		2: "DRIVING_STATUS_NOT_AVAILABLE",
+		3: "SINGLE",
+		4: "CREW",
+	}
+	DriverTimeAnalysis_CurrentDay_DrivingStatus_value = map[string]int32{
+		"DRIVING_STATUS_UNSPECIFIED":   0,
+		"DRIVING_STATUS_UNKNOWN":       1,
+		"DRIVING_STATUS_NOT_AVAILABLE": 2,
+		"SINGLE":                       3,
+		"CREW":                         4,
+	}
+)
+
+func (x DriverTimeAnalysis_CurrentDay_DrivingStatus) Enum() *DriverTimeAnalysis_CurrentDay_DrivingStatus {
+	p := new(DriverTimeAnalysis_CurrentDay_DrivingStatus)
+	*p = x
+	return p
+}
+
+func (x DriverTimeAnalysis_CurrentDay_DrivingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverTimeAnalysis_CurrentDay_DrivingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[2].Descriptor()
+}
+
+func (DriverTimeAnalysis_CurrentDay_DrivingStatus) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes[2]
+}
+
+func (x DriverTimeAnalysis_CurrentDay_DrivingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// An analysis of a driver's current driving and rest times, based on tachograph data.
+type DriverTimeAnalysis struct {
+	state                            protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_DriverId              *string                           `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_CalculatedUntil       *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=calculated_until,json=calculatedUntil"`
+	xxx_hidden_Role                  DriverTimeAnalysis_Role           `protobuf:"varint,3,opt,name=role,enum=wayplatform.connect.trusttrack.v1.DriverTimeAnalysis_Role"`
+	xxx_hidden_UnknownRole           *string                           `protobuf:"bytes,4,opt,name=unknown_role,json=unknownRole"`
+	xxx_hidden_State                 *DriverTimeAnalysis_State         `protobuf:"bytes,5,opt,name=state"`
+	xxx_hidden_CurrentDay            *DriverTimeAnalysis_CurrentDay    `protobuf:"bytes,6,opt,name=current_day,json=currentDay"`
+	xxx_hidden_CurrentWeek           *DriverTimeAnalysis_CurrentWeek   `protobuf:"bytes,7,opt,name=current_week,json=currentWeek"`
+	xxx_hidden_PreviousWeek          *DriverTimeAnalysis_PreviousWeek  `protobuf:"bytes,8,opt,name=previous_week,json=previousWeek"`
+	xxx_hidden_Infringements         *DriverTimeAnalysis_Infringements `protobuf:"bytes,9,opt,name=infringements"`
+	xxx_hidden_DataGapDuration       *durationpb.Duration              `protobuf:"bytes,10,opt,name=data_gap_duration,json=dataGapDuration"`
+	xxx_hidden_LastDriverAssignation *DriverAssignation                `protobuf:"bytes,11,opt,name=last_driver_assignation,json=lastDriverAssignation"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis) Reset() {
+	*x = DriverTimeAnalysis{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeAnalysis) GetCalculatedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CalculatedUntil
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetRole() DriverTimeAnalysis_Role {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Role
+		}
+	}
+	return DriverTimeAnalysis_ROLE_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis) GetUnknownRole() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownRole != nil {
+			return *x.xxx_hidden_UnknownRole
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeAnalysis) GetState() *DriverTimeAnalysis_State {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetCurrentDay() *DriverTimeAnalysis_CurrentDay {
+	if x != nil {
+		return x.xxx_hidden_CurrentDay
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetCurrentWeek() *DriverTimeAnalysis_CurrentWeek {
+	if x != nil {
+		return x.xxx_hidden_CurrentWeek
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetPreviousWeek() *DriverTimeAnalysis_PreviousWeek {
+	if x != nil {
+		return x.xxx_hidden_PreviousWeek
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetInfringements() *DriverTimeAnalysis_Infringements {
+	if x != nil {
+		return x.xxx_hidden_Infringements
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetDataGapDuration() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_DataGapDuration
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) GetLastDriverAssignation() *DriverAssignation {
+	if x != nil {
+		return x.xxx_hidden_LastDriverAssignation
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+}
+
+func (x *DriverTimeAnalysis) SetCalculatedUntil(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CalculatedUntil = v
+}
+
+func (x *DriverTimeAnalysis) SetRole(v DriverTimeAnalysis_Role) {
+	x.xxx_hidden_Role = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+}
+
+func (x *DriverTimeAnalysis) SetUnknownRole(v string) {
+	x.xxx_hidden_UnknownRole = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+}
+
+func (x *DriverTimeAnalysis) SetState(v *DriverTimeAnalysis_State) {
+	x.xxx_hidden_State = v
+}
+
+func (x *DriverTimeAnalysis) SetCurrentDay(v *DriverTimeAnalysis_CurrentDay) {
+	x.xxx_hidden_CurrentDay = v
+}
+
+func (x *DriverTimeAnalysis) SetCurrentWeek(v *DriverTimeAnalysis_CurrentWeek) {
+	x.xxx_hidden_CurrentWeek = v
+}
+
+func (x *DriverTimeAnalysis) SetPreviousWeek(v *DriverTimeAnalysis_PreviousWeek) {
+	x.xxx_hidden_PreviousWeek = v
+}
+
+func (x *DriverTimeAnalysis) SetInfringements(v *DriverTimeAnalysis_Infringements) {
+	x.xxx_hidden_Infringements = v
+}
+
+func (x *DriverTimeAnalysis) SetDataGapDuration(v *durationpb.Duration) {
+	x.xxx_hidden_DataGapDuration = v
+}
+
+func (x *DriverTimeAnalysis) SetLastDriverAssignation(v *DriverAssignation) {
+	x.xxx_hidden_LastDriverAssignation = v
+}
+
+func (x *DriverTimeAnalysis) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverTimeAnalysis) HasCalculatedUntil() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CalculatedUntil != nil
+}
+
+func (x *DriverTimeAnalysis) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverTimeAnalysis) HasUnknownRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverTimeAnalysis) HasState() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_State != nil
+}
+
+func (x *DriverTimeAnalysis) HasCurrentDay() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentDay != nil
+}
+
+func (x *DriverTimeAnalysis) HasCurrentWeek() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CurrentWeek != nil
+}
+
+func (x *DriverTimeAnalysis) HasPreviousWeek() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PreviousWeek != nil
+}
+
+func (x *DriverTimeAnalysis) HasInfringements() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Infringements != nil
+}
+
+func (x *DriverTimeAnalysis) HasDataGapDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DataGapDuration != nil
+}
+
+func (x *DriverTimeAnalysis) HasLastDriverAssignation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastDriverAssignation != nil
+}
+
+func (x *DriverTimeAnalysis) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *DriverTimeAnalysis) ClearCalculatedUntil() {
+	x.xxx_hidden_CalculatedUntil = nil
+}
+
+func (x *DriverTimeAnalysis) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Role = DriverTimeAnalysis_ROLE_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis) ClearUnknownRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_UnknownRole = nil
+}
+
+func (x *DriverTimeAnalysis) ClearState() {
+	x.xxx_hidden_State = nil
+}
+
+func (x *DriverTimeAnalysis) ClearCurrentDay() {
+	x.xxx_hidden_CurrentDay = nil
+}
+
+func (x *DriverTimeAnalysis) ClearCurrentWeek() {
+	x.xxx_hidden_CurrentWeek = nil
+}
+
+func (x *DriverTimeAnalysis) ClearPreviousWeek() {
+	x.xxx_hidden_PreviousWeek = nil
+}
+
+func (x *DriverTimeAnalysis) ClearInfringements() {
+	x.xxx_hidden_Infringements = nil
+}
+
+func (x *DriverTimeAnalysis) ClearDataGapDuration() {
+	x.xxx_hidden_DataGapDuration = nil
+}
+
+func (x *DriverTimeAnalysis) ClearLastDriverAssignation() {
+	x.xxx_hidden_LastDriverAssignation = nil
+}
+
+type DriverTimeAnalysis_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver.
+	DriverId *string
+	// The datetime up to which the analysis was calculated.
+	CalculatedUntil *timestamppb.Timestamp
+	// The role of the driver in the vehicle.
+	Role *DriverTimeAnalysis_Role
+	// The unknown role of the driver.
+	// This field is used when the role is ROLE_UNKNOWN.
+	UnknownRole *string
+	// The current activity state of the driver.
+	State *DriverTimeAnalysis_State
+	// Driving and rest times of the current day.
+	CurrentDay *DriverTimeAnalysis_CurrentDay
+	// Driving and rest times of the current week.
+	CurrentWeek *DriverTimeAnalysis_CurrentWeek
+	// Driving and rest times of the previous week.
+	PreviousWeek *DriverTimeAnalysis_PreviousWeek
+	// Driving time limit infringements.
+	Infringements *DriverTimeAnalysis_Infringements
+	// The duration of missing tachograph data.
+	DataGapDuration *durationpb.Duration
+	// The last assignation event of the driver.
+	LastDriverAssignation *DriverAssignation
+}
+
+func (b0 DriverTimeAnalysis_builder) Build() *DriverTimeAnalysis {
+	m0 := &DriverTimeAnalysis{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	x.xxx_hidden_CalculatedUntil = b.CalculatedUntil
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		x.xxx_hidden_Role = *b.Role
+	}
+	if b.UnknownRole != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		x.xxx_hidden_UnknownRole = b.UnknownRole
+	}
+	x.xxx_hidden_State = b.State
+	x.xxx_hidden_CurrentDay = b.CurrentDay
+	x.xxx_hidden_CurrentWeek = b.CurrentWeek
+	x.xxx_hidden_PreviousWeek = b.PreviousWeek
+	x.xxx_hidden_Infringements = b.Infringements
+	x.xxx_hidden_DataGapDuration = b.DataGapDuration
+	x.xxx_hidden_LastDriverAssignation = b.LastDriverAssignation
+	return m0
+}
+
+// The current activity state of a driver.
+type DriverTimeAnalysis_State struct {
+	state                      protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Activity        DriverTimeAnalysis_State_Activity `protobuf:"varint,1,opt,name=activity,enum=wayplatform.connect.trusttrack.v1.DriverTimeAnalysis_State_Activity"`
+	xxx_hidden_UnknownActivity *string                           `protobuf:"bytes,2,opt,name=unknown_activity,json=unknownActivity"`
+	xxx_hidden_StartTime       *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=start_time,json=startTime"`
+	xxx_hidden_Duration        *durationpb.Duration              `protobuf:"bytes,4,opt,name=duration"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_State) Reset() {
+	*x = DriverTimeAnalysis_State{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_State) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_State) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_State) GetActivity() DriverTimeAnalysis_State_Activity {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Activity
+		}
+	}
+	return DriverTimeAnalysis_State_ACTIVITY_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis_State) GetUnknownActivity() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownActivity != nil {
+			return *x.xxx_hidden_UnknownActivity
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeAnalysis_State) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StartTime
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_State) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Duration
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_State) SetActivity(v DriverTimeAnalysis_State_Activity) {
+	x.xxx_hidden_Activity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *DriverTimeAnalysis_State) SetUnknownActivity(v string) {
+	x.xxx_hidden_UnknownActivity = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DriverTimeAnalysis_State) SetStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartTime = v
+}
+
+func (x *DriverTimeAnalysis_State) SetDuration(v *durationpb.Duration) {
+	x.xxx_hidden_Duration = v
+}
+
+func (x *DriverTimeAnalysis_State) HasActivity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverTimeAnalysis_State) HasUnknownActivity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverTimeAnalysis_State) HasStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StartTime != nil
+}
+
+func (x *DriverTimeAnalysis_State) HasDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Duration != nil
+}
+
+func (x *DriverTimeAnalysis_State) ClearActivity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Activity = DriverTimeAnalysis_State_ACTIVITY_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis_State) ClearUnknownActivity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnknownActivity = nil
+}
+
+func (x *DriverTimeAnalysis_State) ClearStartTime() {
+	x.xxx_hidden_StartTime = nil
+}
+
+func (x *DriverTimeAnalysis_State) ClearDuration() {
+	x.xxx_hidden_Duration = nil
+}
+
+type DriverTimeAnalysis_State_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The current activity.
+	Activity *DriverTimeAnalysis_State_Activity
+	// The unknown activity.
+	// This field is used when the activity is ACTIVITY_UNKNOWN.
+	UnknownActivity *string
+	// The start time of the current activity.
+	StartTime *timestamppb.Timestamp
+	// The duration of the current activity.
+	Duration *durationpb.Duration
+}
+
+func (b0 DriverTimeAnalysis_State_builder) Build() *DriverTimeAnalysis_State {
+	m0 := &DriverTimeAnalysis_State{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Activity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Activity = *b.Activity
+	}
+	if b.UnknownActivity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_UnknownActivity = b.UnknownActivity
+	}
+	x.xxx_hidden_StartTime = b.StartTime
+	x.xxx_hidden_Duration = b.Duration
+	return m0
+}
+
+// Driving and rest times of the current day.
+type DriverTimeAnalysis_CurrentDay struct {
+	state                           protoimpl.MessageState                      `protogen:"opaque.v1"`
+	xxx_hidden_DrivingStatus        DriverTimeAnalysis_CurrentDay_DrivingStatus `protobuf:"varint,1,opt,name=driving_status,json=drivingStatus,enum=wayplatform.connect.trusttrack.v1.DriverTimeAnalysis_CurrentDay_DrivingStatus"`
+	xxx_hidden_UnknownDrivingStatus *string                                     `protobuf:"bytes,2,opt,name=unknown_driving_status,json=unknownDrivingStatus"`
+	xxx_hidden_RegularDriving       *DriverTimeAnalysis_LimitedDuration         `protobuf:"bytes,3,opt,name=regular_driving,json=regularDriving"`
+	xxx_hidden_ExtendedDriving      *DriverTimeAnalysis_LimitedDuration         `protobuf:"bytes,4,opt,name=extended_driving,json=extendedDriving"`
+	xxx_hidden_Working              *durationpb.Duration                        `protobuf:"bytes,5,opt,name=working"`
+	xxx_hidden_NextRestTime         *timestamppb.Timestamp                      `protobuf:"bytes,6,opt,name=next_rest_time,json=nextRestTime"`
+	xxx_hidden_RestInProgress       bool                                        `protobuf:"varint,7,opt,name=rest_in_progress,json=restInProgress"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) Reset() {
+	*x = DriverTimeAnalysis_CurrentDay{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_CurrentDay) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_CurrentDay) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetDrivingStatus() DriverTimeAnalysis_CurrentDay_DrivingStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_DrivingStatus
+		}
+	}
+	return DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetUnknownDrivingStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownDrivingStatus != nil {
+			return *x.xxx_hidden_UnknownDrivingStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetRegularDriving() *DriverTimeAnalysis_LimitedDuration {
+	if x != nil {
+		return x.xxx_hidden_RegularDriving
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetExtendedDriving() *DriverTimeAnalysis_LimitedDuration {
+	if x != nil {
+		return x.xxx_hidden_ExtendedDriving
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetWorking() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Working
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetNextRestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_NextRestTime
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) GetRestInProgress() bool {
+	if x != nil {
+		return x.xxx_hidden_RestInProgress
+	}
+	return false
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetDrivingStatus(v DriverTimeAnalysis_CurrentDay_DrivingStatus) {
+	x.xxx_hidden_DrivingStatus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetUnknownDrivingStatus(v string) {
+	x.xxx_hidden_UnknownDrivingStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetRegularDriving(v *DriverTimeAnalysis_LimitedDuration) {
+	x.xxx_hidden_RegularDriving = v
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetExtendedDriving(v *DriverTimeAnalysis_LimitedDuration) {
+	x.xxx_hidden_ExtendedDriving = v
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetWorking(v *durationpb.Duration) {
+	x.xxx_hidden_Working = v
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetNextRestTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_NextRestTime = v
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) SetRestInProgress(v bool) {
+	x.xxx_hidden_RestInProgress = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasDrivingStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasUnknownDrivingStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasRegularDriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RegularDriving != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasExtendedDriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExtendedDriving != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasWorking() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Working != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasNextRestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NextRestTime != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) HasRestInProgress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearDrivingStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DrivingStatus = DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNSPECIFIED
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearUnknownDrivingStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnknownDrivingStatus = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearRegularDriving() {
+	x.xxx_hidden_RegularDriving = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearExtendedDriving() {
+	x.xxx_hidden_ExtendedDriving = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearWorking() {
+	x.xxx_hidden_Working = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearNextRestTime() {
+	x.xxx_hidden_NextRestTime = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentDay) ClearRestInProgress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_RestInProgress = false
+}
+
+type DriverTimeAnalysis_CurrentDay_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Whether the driver is driving alone or in a crew.
+	DrivingStatus *DriverTimeAnalysis_CurrentDay_DrivingStatus
+	// The unknown driving status.
+	// This field is used when the driving_status is DRIVING_STATUS_UNKNOWN.
+	UnknownDrivingStatus *string
+	// The regular daily driving time and its limit.
+	RegularDriving *DriverTimeAnalysis_LimitedDuration
+	// The extended daily driving time and its limit.
+	ExtendedDriving *DriverTimeAnalysis_LimitedDuration
+	// The working time of the current day.
+	Working *durationpb.Duration
+	// The time of the next required daily rest.
+	NextRestTime *timestamppb.Timestamp
+	// Whether a daily rest is in progress.
+	RestInProgress *bool
+}
+
+func (b0 DriverTimeAnalysis_CurrentDay_builder) Build() *DriverTimeAnalysis_CurrentDay {
+	m0 := &DriverTimeAnalysis_CurrentDay{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DrivingStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_DrivingStatus = *b.DrivingStatus
+	}
+	if b.UnknownDrivingStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_UnknownDrivingStatus = b.UnknownDrivingStatus
+	}
+	x.xxx_hidden_RegularDriving = b.RegularDriving
+	x.xxx_hidden_ExtendedDriving = b.ExtendedDriving
+	x.xxx_hidden_Working = b.Working
+	x.xxx_hidden_NextRestTime = b.NextRestTime
+	if b.RestInProgress != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_RestInProgress = *b.RestInProgress
+	}
+	return m0
+}
+
+// Driving and rest times of the current week.
+type DriverTimeAnalysis_CurrentWeek struct {
+	state                                  protoimpl.MessageState              `protogen:"opaque.v1"`
+	xxx_hidden_Driving                     *DriverTimeAnalysis_LimitedDuration `protobuf:"bytes,1,opt,name=driving"`
+	xxx_hidden_ExtendedDailyDrivingPeriods *DriverTimeAnalysis_LimitedUsage    `protobuf:"bytes,2,opt,name=extended_daily_driving_periods,json=extendedDailyDrivingPeriods"`
+	xxx_hidden_Working                     *durationpb.Duration                `protobuf:"bytes,3,opt,name=working"`
+	xxx_hidden_NextRestTime                *timestamppb.Timestamp              `protobuf:"bytes,4,opt,name=next_rest_time,json=nextRestTime"`
+	xxx_hidden_NextRestDuration            *durationpb.Duration                `protobuf:"bytes,5,opt,name=next_rest_duration,json=nextRestDuration"`
+	xxx_hidden_ReducedDailyRests           *DriverTimeAnalysis_LimitedUsage    `protobuf:"bytes,6,opt,name=reduced_daily_rests,json=reducedDailyRests"`
+	xxx_hidden_RestInProgress              bool                                `protobuf:"varint,7,opt,name=rest_in_progress,json=restInProgress"`
+	xxx_hidden_RestStartTime               *timestamppb.Timestamp              `protobuf:"bytes,8,opt,name=rest_start_time,json=restStartTime"`
+	XXX_raceDetectHookData                 protoimpl.RaceDetectHookData
+	XXX_presence                           [1]uint32
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) Reset() {
+	*x = DriverTimeAnalysis_CurrentWeek{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_CurrentWeek) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetDriving() *DriverTimeAnalysis_LimitedDuration {
+	if x != nil {
+		return x.xxx_hidden_Driving
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetExtendedDailyDrivingPeriods() *DriverTimeAnalysis_LimitedUsage {
+	if x != nil {
+		return x.xxx_hidden_ExtendedDailyDrivingPeriods
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetWorking() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Working
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetNextRestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_NextRestTime
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetNextRestDuration() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_NextRestDuration
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetReducedDailyRests() *DriverTimeAnalysis_LimitedUsage {
+	if x != nil {
+		return x.xxx_hidden_ReducedDailyRests
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetRestInProgress() bool {
+	if x != nil {
+		return x.xxx_hidden_RestInProgress
+	}
+	return false
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) GetRestStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RestStartTime
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetDriving(v *DriverTimeAnalysis_LimitedDuration) {
+	x.xxx_hidden_Driving = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetExtendedDailyDrivingPeriods(v *DriverTimeAnalysis_LimitedUsage) {
+	x.xxx_hidden_ExtendedDailyDrivingPeriods = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetWorking(v *durationpb.Duration) {
+	x.xxx_hidden_Working = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetNextRestTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_NextRestTime = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetNextRestDuration(v *durationpb.Duration) {
+	x.xxx_hidden_NextRestDuration = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetReducedDailyRests(v *DriverTimeAnalysis_LimitedUsage) {
+	x.xxx_hidden_ReducedDailyRests = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetRestInProgress(v bool) {
+	x.xxx_hidden_RestInProgress = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) SetRestStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RestStartTime = v
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasDriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driving != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasExtendedDailyDrivingPeriods() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExtendedDailyDrivingPeriods != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasWorking() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Working != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasNextRestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NextRestTime != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasNextRestDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NextRestDuration != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasReducedDailyRests() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ReducedDailyRests != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasRestInProgress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) HasRestStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RestStartTime != nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearDriving() {
+	x.xxx_hidden_Driving = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearExtendedDailyDrivingPeriods() {
+	x.xxx_hidden_ExtendedDailyDrivingPeriods = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearWorking() {
+	x.xxx_hidden_Working = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearNextRestTime() {
+	x.xxx_hidden_NextRestTime = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearNextRestDuration() {
+	x.xxx_hidden_NextRestDuration = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearReducedDailyRests() {
+	x.xxx_hidden_ReducedDailyRests = nil
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearRestInProgress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_RestInProgress = false
+}
+
+func (x *DriverTimeAnalysis_CurrentWeek) ClearRestStartTime() {
+	x.xxx_hidden_RestStartTime = nil
+}
+
+type DriverTimeAnalysis_CurrentWeek_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The weekly driving time and its limit.
+	Driving *DriverTimeAnalysis_LimitedDuration
+	// The number of extended daily driving periods used this week.
+	ExtendedDailyDrivingPeriods *DriverTimeAnalysis_LimitedUsage
+	// The working time of the current week.
+	Working *durationpb.Duration
+	// The time of the next required weekly rest.
+	NextRestTime *timestamppb.Timestamp
+	// The duration of the next required weekly rest.
+	NextRestDuration *durationpb.Duration
+	// The number of reduced daily rests used this week.
+	ReducedDailyRests *DriverTimeAnalysis_LimitedUsage
+	// Whether a weekly rest is in progress.
+	RestInProgress *bool
+	// The start time of the weekly rest in progress.
+	RestStartTime *timestamppb.Timestamp
+}
+
+func (b0 DriverTimeAnalysis_CurrentWeek_builder) Build() *DriverTimeAnalysis_CurrentWeek {
+	m0 := &DriverTimeAnalysis_CurrentWeek{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driving = b.Driving
+	x.xxx_hidden_ExtendedDailyDrivingPeriods = b.ExtendedDailyDrivingPeriods
+	x.xxx_hidden_Working = b.Working
+	x.xxx_hidden_NextRestTime = b.NextRestTime
+	x.xxx_hidden_NextRestDuration = b.NextRestDuration
+	x.xxx_hidden_ReducedDailyRests = b.ReducedDailyRests
+	if b.RestInProgress != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_RestInProgress = *b.RestInProgress
+	}
+	x.xxx_hidden_RestStartTime = b.RestStartTime
+	return m0
+}
+
+// Driving and rest times of the previous week.
+type DriverTimeAnalysis_PreviousWeek struct {
+	state              protoimpl.MessageState              `protogen:"opaque.v1"`
+	xxx_hidden_Driving *DriverTimeAnalysis_LimitedDuration `protobuf:"bytes,1,opt,name=driving"`
+	xxx_hidden_Resting *durationpb.Duration                `protobuf:"bytes,2,opt,name=resting"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) Reset() {
+	*x = DriverTimeAnalysis_PreviousWeek{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_PreviousWeek) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_PreviousWeek) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) GetDriving() *DriverTimeAnalysis_LimitedDuration {
+	if x != nil {
+		return x.xxx_hidden_Driving
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) GetResting() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Resting
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) SetDriving(v *DriverTimeAnalysis_LimitedDuration) {
+	x.xxx_hidden_Driving = v
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) SetResting(v *durationpb.Duration) {
+	x.xxx_hidden_Resting = v
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) HasDriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driving != nil
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) HasResting() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Resting != nil
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) ClearDriving() {
+	x.xxx_hidden_Driving = nil
+}
+
+func (x *DriverTimeAnalysis_PreviousWeek) ClearResting() {
+	x.xxx_hidden_Resting = nil
+}
+
+type DriverTimeAnalysis_PreviousWeek_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driving time of the previous week and its limit.
+	Driving *DriverTimeAnalysis_LimitedDuration
+	// The rest time of the previous week.
+	Resting *durationpb.Duration
+}
+
+func (b0 DriverTimeAnalysis_PreviousWeek_builder) Build() *DriverTimeAnalysis_PreviousWeek {
+	m0 := &DriverTimeAnalysis_PreviousWeek{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driving = b.Driving
+	x.xxx_hidden_Resting = b.Resting
+	return m0
+}
+
+// Driving time limit infringements.
+type DriverTimeAnalysis_Infringements struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DailyDrivingLimitExceeded  *durationpb.Duration   `protobuf:"bytes,1,opt,name=daily_driving_limit_exceeded,json=dailyDrivingLimitExceeded"`
+	xxx_hidden_WeeklyDrivingLimitExceeded *durationpb.Duration   `protobuf:"bytes,2,opt,name=weekly_driving_limit_exceeded,json=weeklyDrivingLimitExceeded"`
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_Infringements) Reset() {
+	*x = DriverTimeAnalysis_Infringements{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_Infringements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_Infringements) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_Infringements) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_Infringements) GetDailyDrivingLimitExceeded() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_DailyDrivingLimitExceeded
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_Infringements) GetWeeklyDrivingLimitExceeded() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_WeeklyDrivingLimitExceeded
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_Infringements) SetDailyDrivingLimitExceeded(v *durationpb.Duration) {
+	x.xxx_hidden_DailyDrivingLimitExceeded = v
+}
+
+func (x *DriverTimeAnalysis_Infringements) SetWeeklyDrivingLimitExceeded(v *durationpb.Duration) {
+	x.xxx_hidden_WeeklyDrivingLimitExceeded = v
+}
+
+func (x *DriverTimeAnalysis_Infringements) HasDailyDrivingLimitExceeded() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DailyDrivingLimitExceeded != nil
+}
+
+func (x *DriverTimeAnalysis_Infringements) HasWeeklyDrivingLimitExceeded() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_WeeklyDrivingLimitExceeded != nil
+}
+
+func (x *DriverTimeAnalysis_Infringements) ClearDailyDrivingLimitExceeded() {
+	x.xxx_hidden_DailyDrivingLimitExceeded = nil
+}
+
+func (x *DriverTimeAnalysis_Infringements) ClearWeeklyDrivingLimitExceeded() {
+	x.xxx_hidden_WeeklyDrivingLimitExceeded = nil
+}
+
+type DriverTimeAnalysis_Infringements_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The time by which the daily driving limit was exceeded.
+	DailyDrivingLimitExceeded *durationpb.Duration
+	// The time by which the weekly driving limit was exceeded.
+	WeeklyDrivingLimitExceeded *durationpb.Duration
+}
+
+func (b0 DriverTimeAnalysis_Infringements_builder) Build() *DriverTimeAnalysis_Infringements {
+	m0 := &DriverTimeAnalysis_Infringements{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DailyDrivingLimitExceeded = b.DailyDrivingLimitExceeded
+	x.xxx_hidden_WeeklyDrivingLimitExceeded = b.WeeklyDrivingLimitExceeded
+	return m0
+}
+
+// A duration with its limit.
+type DriverTimeAnalysis_LimitedDuration struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Duration *durationpb.Duration   `protobuf:"bytes,1,opt,name=duration"`
+	xxx_hidden_Limit    *durationpb.Duration   `protobuf:"bytes,2,opt,name=limit"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) Reset() {
+	*x = DriverTimeAnalysis_LimitedDuration{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_LimitedDuration) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_LimitedDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Duration
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) GetLimit() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return nil
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) SetDuration(v *durationpb.Duration) {
+	x.xxx_hidden_Duration = v
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) SetLimit(v *durationpb.Duration) {
+	x.xxx_hidden_Limit = v
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) HasDuration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Duration != nil
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Limit != nil
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) ClearDuration() {
+	x.xxx_hidden_Duration = nil
+}
+
+func (x *DriverTimeAnalysis_LimitedDuration) ClearLimit() {
+	x.xxx_hidden_Limit = nil
+}
+
+type DriverTimeAnalysis_LimitedDuration_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The used duration.
+	Duration *durationpb.Duration
+	// The maximum allowed duration.
+	Limit *durationpb.Duration
+}
+
+func (b0 DriverTimeAnalysis_LimitedDuration_builder) Build() *DriverTimeAnalysis_LimitedDuration {
+	m0 := &DriverTimeAnalysis_LimitedDuration{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Duration = b.Duration
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+// A usage count with its limit.
+type DriverTimeAnalysis_LimitedUsage struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Used        int64                  `protobuf:"varint,1,opt,name=used"`
+	xxx_hidden_Limit       int64                  `protobuf:"varint,2,opt,name=limit"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) Reset() {
+	*x = DriverTimeAnalysis_LimitedUsage{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeAnalysis_LimitedUsage) ProtoMessage() {}
+
+func (x *DriverTimeAnalysis_LimitedUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) GetUsed() int64 {
+	if x != nil {
+		return x.xxx_hidden_Used
+	}
+	return 0
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) GetLimit() int64 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) SetUsed(v int64) {
+	x.xxx_hidden_Used = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) SetLimit(v int64) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) HasUsed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) ClearUsed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Used = 0
+}
+
+func (x *DriverTimeAnalysis_LimitedUsage) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Limit = 0
+}
+
+type DriverTimeAnalysis_LimitedUsage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The number of times used.
+	Used *int64
+	// The maximum allowed number of times.
+	Limit *int64
+}
+
+func (b0 DriverTimeAnalysis_LimitedUsage_builder) Build() *DriverTimeAnalysis_LimitedUsage {
+	m0 := &DriverTimeAnalysis_LimitedUsage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Used != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Used = *b.Used
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_rawDesc = "" +
+	"\n" +
+	"<wayplatform/connect/trusttrack/v1/driver_time_analysis.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a:wayplatform/connect/trusttrack/v1/driver_assignation.proto\"\xa3\x1b\n" +
+	"\x12DriverTimeAnalysis\x12#\n" +
+	"\tdriver_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bdriverId\x12O\n" +
+	"\x10calculated_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\x0fcalculatedUntil\x12Z\n" +
+	"\x04role\x18\x03 \x01(\x0e2:.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.RoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\x12!\n" +
+	"\funknown_role\x18\x04 \x01(\tR\vunknownRole\x12Q\n" +
+	"\x05state\x18\x05 \x01(\v2;.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.StateR\x05state\x12a\n" +
+	"\vcurrent_day\x18\x06 \x01(\v2@.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDayR\n" +
+	"currentDay\x12d\n" +
+	"\fcurrent_week\x18\a \x01(\v2A.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeekR\vcurrentWeek\x12g\n" +
+	"\rprevious_week\x18\b \x01(\v2B.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.PreviousWeekR\fpreviousWeek\x12i\n" +
+	"\rinfringements\x18\t \x01(\v2C.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.InfringementsR\rinfringements\x12E\n" +
+	"\x11data_gap_duration\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x0fdataGapDuration\x12l\n" +
+	"\x17last_driver_assignation\x18\v \x01(\v24.wayplatform.connect.trusttrack.v1.DriverAssignationR\x15lastDriverAssignation\x1a\xc7\x03\n" +
+	"\x05State\x12l\n" +
+	"\bactivity\x18\x01 \x01(\x0e2D.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.ActivityB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bactivity\x12)\n" +
+	"\x10unknown_activity\x18\x02 \x01(\tR\x0funknownActivity\x12C\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\tstartTime\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\xa8\x01\n" +
+	"\bActivity\x12\x18\n" +
+	"\x14ACTIVITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ACTIVITY_UNKNOWN\x10\x01\x12\x1a\n" +
+	"\x16ACTIVITY_NOT_AVAILABLE\x10\x02\x12\v\n" +
+	"\aRESTING\x10\x03\x12\r\n" +
+	"\tAVAILABLE\x10\x04\x12\v\n" +
+	"\aWORKING\x10\x05\x12\v\n" +
+	"\aDRIVING\x10\x06\x12\t\n" +
+	"\x05ERROR\x10\a\x12\x0f\n" +
+	"\vUNAVAILABLE\x10\b\x1a\xd9\x05\n" +
+	"\n" +
+	"CurrentDay\x12\x81\x01\n" +
+	"\x0edriving_status\x18\x01 \x01(\x0e2N.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.DrivingStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\rdrivingStatus\x124\n" +
+	"\x16unknown_driving_status\x18\x02 \x01(\tR\x14unknownDrivingStatus\x12n\n" +
+	"\x0fregular_driving\x18\x03 \x01(\v2E.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDurationR\x0eregularDriving\x12p\n" +
+	"\x10extended_driving\x18\x04 \x01(\v2E.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDurationR\x0fextendedDriving\x123\n" +
+	"\aworking\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\aworking\x12J\n" +
+	"\x0enext_rest_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\fnextRestTime\x12(\n" +
+	"\x10rest_in_progress\x18\a \x01(\bR\x0erestInProgress\"\x83\x01\n" +
+	"\rDrivingStatus\x12\x1e\n" +
+	"\x1aDRIVING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DRIVING_STATUS_UNKNOWN\x10\x01\x12 \n" +
+	"\x1cDRIVING_STATUS_NOT_AVAILABLE\x10\x02\x12\n" +
+	"\n" +
+	"\x06SINGLE\x10\x03\x12\b\n" +
+	"\x04CREW\x10\x04\x1a\xae\x05\n" +
+	"\vCurrentWeek\x12_\n" +
+	"\adriving\x18\x01 \x01(\v2E.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDurationR\adriving\x12\x87\x01\n" +
+	"\x1eextended_daily_driving_periods\x18\x02 \x01(\v2B.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedUsageR\x1bextendedDailyDrivingPeriods\x123\n" +
+	"\aworking\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\aworking\x12J\n" +
+	"\x0enext_rest_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\fnextRestTime\x12G\n" +
+	"\x12next_rest_duration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x10nextRestDuration\x12r\n" +
+	"\x13reduced_daily_rests\x18\x06 \x01(\v2B.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedUsageR\x11reducedDailyRests\x12(\n" +
+	"\x10rest_in_progress\x18\a \x01(\bR\x0erestInProgress\x12L\n" +
+	"\x0frest_start_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02*\x00R\rrestStartTime\x1a\xa4\x01\n" +
+	"\fPreviousWeek\x12_\n" +
+	"\adriving\x18\x01 \x01(\v2E.wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDurationR\adriving\x123\n" +
+	"\aresting\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\aresting\x1a\xc9\x01\n" +
+	"\rInfringements\x12Z\n" +
+	"\x1cdaily_driving_limit_exceeded\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x19dailyDrivingLimitExceeded\x12\\\n" +
+	"\x1dweekly_driving_limit_exceeded\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x1aweeklyDrivingLimitExceeded\x1ay\n" +
+	"\x0fLimitedDuration\x125\n" +
+	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12/\n" +
+	"\x05limit\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05limit\x1aJ\n" +
+	"\fLimitedUsage\x12\x1b\n" +
+	"\x04used\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x04used\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05limit\"b\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fROLE_UNKNOWN\x10\x01\x12\x16\n" +
+	"\x12ROLE_NOT_AVAILABLE\x10\x02\x12\v\n" +
+	"\aPRIMARY\x10\x03\x12\r\n" +
+	"\tSECONDARY\x10\x04B\xca\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x17DriverTimeAnalysisProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_goTypes = []any{
+	(DriverTimeAnalysis_Role)(0),                     // 0: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Role
+	(DriverTimeAnalysis_State_Activity)(0),           // 1: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.Activity
+	(DriverTimeAnalysis_CurrentDay_DrivingStatus)(0), // 2: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.DrivingStatus
+	(*DriverTimeAnalysis)(nil),                       // 3: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis
+	(*DriverTimeAnalysis_State)(nil),                 // 4: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State
+	(*DriverTimeAnalysis_CurrentDay)(nil),            // 5: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay
+	(*DriverTimeAnalysis_CurrentWeek)(nil),           // 6: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek
+	(*DriverTimeAnalysis_PreviousWeek)(nil),          // 7: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.PreviousWeek
+	(*DriverTimeAnalysis_Infringements)(nil),         // 8: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Infringements
+	(*DriverTimeAnalysis_LimitedDuration)(nil),       // 9: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration
+	(*DriverTimeAnalysis_LimitedUsage)(nil),          // 10: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedUsage
+	(*timestamppb.Timestamp)(nil),                    // 11: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                      // 12: google.protobuf.Duration
+	(*DriverAssignation)(nil),                        // 13: wayplatform.connect.trusttrack.v1.DriverAssignation
+}
+var file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_depIdxs = []int32{
+	11, // 0: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.calculated_until:type_name -> google.protobuf.Timestamp
+	0,  // 1: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.role:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Role
+	4,  // 2: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.state:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State
+	5,  // 3: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.current_day:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay
+	6,  // 4: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.current_week:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek
+	7,  // 5: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.previous_week:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.PreviousWeek
+	8,  // 6: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.infringements:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Infringements
+	12, // 7: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.data_gap_duration:type_name -> google.protobuf.Duration
+	13, // 8: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.last_driver_assignation:type_name -> wayplatform.connect.trusttrack.v1.DriverAssignation
+	1,  // 9: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.activity:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.Activity
+	11, // 10: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.start_time:type_name -> google.protobuf.Timestamp
+	12, // 11: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.State.duration:type_name -> google.protobuf.Duration
+	2,  // 12: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.driving_status:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.DrivingStatus
+	9,  // 13: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.regular_driving:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration
+	9,  // 14: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.extended_driving:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration
+	12, // 15: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.working:type_name -> google.protobuf.Duration
+	11, // 16: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentDay.next_rest_time:type_name -> google.protobuf.Timestamp
+	9,  // 17: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.driving:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration
+	10, // 18: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.extended_daily_driving_periods:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedUsage
+	12, // 19: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.working:type_name -> google.protobuf.Duration
+	11, // 20: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.next_rest_time:type_name -> google.protobuf.Timestamp
+	12, // 21: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.next_rest_duration:type_name -> google.protobuf.Duration
+	10, // 22: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.reduced_daily_rests:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedUsage
+	11, // 23: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.CurrentWeek.rest_start_time:type_name -> google.protobuf.Timestamp
+	9,  // 24: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.PreviousWeek.driving:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration
+	12, // 25: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.PreviousWeek.resting:type_name -> google.protobuf.Duration
+	12, // 26: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Infringements.daily_driving_limit_exceeded:type_name -> google.protobuf.Duration
+	12, // 27: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.Infringements.weekly_driving_limit_exceeded:type_name -> google.protobuf.Duration
+	12, // 28: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration.duration:type_name -> google.protobuf.Duration
+	12, // 29: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis.LimitedDuration.limit:type_name -> google.protobuf.Duration
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto != nil {
+		return
+	}
+	file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_depIdxs = nil
+}
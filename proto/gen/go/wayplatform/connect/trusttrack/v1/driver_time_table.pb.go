@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/driver_time_table.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A monthly time table of a driver's working hours.
+type DriverTimeTable struct {
+	state                         protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_DriverName         *string                       `protobuf:"bytes,1,opt,name=driver_name,json=driverName"`
+	xxx_hidden_Identifier         *string                       `protobuf:"bytes,2,opt,name=identifier"`
+	xxx_hidden_TimeCardNumber     *string                       `protobuf:"bytes,3,opt,name=time_card_number,json=timeCardNumber"`
+	xxx_hidden_Days               *[]*DriverTimeTable_DailyTime `protobuf:"bytes,4,rep,name=days"`
+	xxx_hidden_TotalDayWorkingH   int64                         `protobuf:"varint,5,opt,name=total_day_working_h,json=totalDayWorkingH"`
+	xxx_hidden_TotalNightWorkingH int64                         `protobuf:"varint,6,opt,name=total_night_working_h,json=totalNightWorkingH"`
+	xxx_hidden_TotalWorkingH      int64                         `protobuf:"varint,7,opt,name=total_working_h,json=totalWorkingH"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *DriverTimeTable) Reset() {
+	*x = DriverTimeTable{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeTable) ProtoMessage() {}
+
+func (x *DriverTimeTable) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeTable) GetDriverName() string {
+	if x != nil {
+		if x.xxx_hidden_DriverName != nil {
+			return *x.xxx_hidden_DriverName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeTable) GetIdentifier() string {
+	if x != nil {
+		if x.xxx_hidden_Identifier != nil {
+			return *x.xxx_hidden_Identifier
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeTable) GetTimeCardNumber() string {
+	if x != nil {
+		if x.xxx_hidden_TimeCardNumber != nil {
+			return *x.xxx_hidden_TimeCardNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DriverTimeTable) GetDays() []*DriverTimeTable_DailyTime {
+	if x != nil {
+		if x.xxx_hidden_Days != nil {
+			return *x.xxx_hidden_Days
+		}
+	}
+	return nil
+}
+
+func (x *DriverTimeTable) GetTotalDayWorkingH() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalDayWorkingH
+	}
+	return 0
+}
+
+func (x *DriverTimeTable) GetTotalNightWorkingH() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalNightWorkingH
+	}
+	return 0
+}
+
+func (x *DriverTimeTable) GetTotalWorkingH() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalWorkingH
+	}
+	return 0
+}
+
+func (x *DriverTimeTable) SetDriverName(v string) {
+	x.xxx_hidden_DriverName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *DriverTimeTable) SetIdentifier(v string) {
+	x.xxx_hidden_Identifier = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *DriverTimeTable) SetTimeCardNumber(v string) {
+	x.xxx_hidden_TimeCardNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *DriverTimeTable) SetDays(v []*DriverTimeTable_DailyTime) {
+	x.xxx_hidden_Days = &v
+}
+
+func (x *DriverTimeTable) SetTotalDayWorkingH(v int64) {
+	x.xxx_hidden_TotalDayWorkingH = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *DriverTimeTable) SetTotalNightWorkingH(v int64) {
+	x.xxx_hidden_TotalNightWorkingH = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *DriverTimeTable) SetTotalWorkingH(v int64) {
+	x.xxx_hidden_TotalWorkingH = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *DriverTimeTable) HasDriverName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DriverTimeTable) HasIdentifier() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverTimeTable) HasTimeCardNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverTimeTable) HasTotalDayWorkingH() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DriverTimeTable) HasTotalNightWorkingH() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *DriverTimeTable) HasTotalWorkingH() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *DriverTimeTable) ClearDriverName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverName = nil
+}
+
+func (x *DriverTimeTable) ClearIdentifier() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Identifier = nil
+}
+
+func (x *DriverTimeTable) ClearTimeCardNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TimeCardNumber = nil
+}
+
+func (x *DriverTimeTable) ClearTotalDayWorkingH() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TotalDayWorkingH = 0
+}
+
+func (x *DriverTimeTable) ClearTotalNightWorkingH() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_TotalNightWorkingH = 0
+}
+
+func (x *DriverTimeTable) ClearTotalWorkingH() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_TotalWorkingH = 0
+}
+
+type DriverTimeTable_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The name of the driver.
+	DriverName *string
+	// The identifier of the driver.
+	Identifier *string
+	// The time card number of the driver.
+	TimeCardNumber *string
+	// The working times per day.
+	Days []*DriverTimeTable_DailyTime
+	// The total working time during business hours (units: hours).
+	TotalDayWorkingH *int64
+	// The total working time outside business hours (units: hours).
+	TotalNightWorkingH *int64
+	// The total working time (units: hours).
+	TotalWorkingH *int64
+}
+
+func (b0 DriverTimeTable_builder) Build() *DriverTimeTable {
+	m0 := &DriverTimeTable{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_DriverName = b.DriverName
+	}
+	if b.Identifier != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Identifier = b.Identifier
+	}
+	if b.TimeCardNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_TimeCardNumber = b.TimeCardNumber
+	}
+	x.xxx_hidden_Days = &b.Days
+	if b.TotalDayWorkingH != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_TotalDayWorkingH = *b.TotalDayWorkingH
+	}
+	if b.TotalNightWorkingH != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_TotalNightWorkingH = *b.TotalNightWorkingH
+	}
+	if b.TotalWorkingH != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_TotalWorkingH = *b.TotalWorkingH
+	}
+	return m0
+}
+
+// The working time of a single day.
+type DriverTimeTable_DailyTime struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Date               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date"`
+	xxx_hidden_Holiday            bool                   `protobuf:"varint,2,opt,name=holiday"`
+	xxx_hidden_DayWorkDurationS   float64                `protobuf:"fixed64,3,opt,name=day_work_duration_s,json=dayWorkDurationS"`
+	xxx_hidden_NightWorkDurationS float64                `protobuf:"fixed64,4,opt,name=night_work_duration_s,json=nightWorkDurationS"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *DriverTimeTable_DailyTime) Reset() {
+	*x = DriverTimeTable_DailyTime{}
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTimeTable_DailyTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTimeTable_DailyTime) ProtoMessage() {}
+
+func (x *DriverTimeTable_DailyTime) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DriverTimeTable_DailyTime) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Date
+	}
+	return nil
+}
+
+func (x *DriverTimeTable_DailyTime) GetHoliday() bool {
+	if x != nil {
+		return x.xxx_hidden_Holiday
+	}
+	return false
+}
+
+func (x *DriverTimeTable_DailyTime) GetDayWorkDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_DayWorkDurationS
+	}
+	return 0
+}
+
+func (x *DriverTimeTable_DailyTime) GetNightWorkDurationS() float64 {
+	if x != nil {
+		return x.xxx_hidden_NightWorkDurationS
+	}
+	return 0
+}
+
+func (x *DriverTimeTable_DailyTime) SetDate(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Date = v
+}
+
+func (x *DriverTimeTable_DailyTime) SetHoliday(v bool) {
+	x.xxx_hidden_Holiday = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DriverTimeTable_DailyTime) SetDayWorkDurationS(v float64) {
+	x.xxx_hidden_DayWorkDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *DriverTimeTable_DailyTime) SetNightWorkDurationS(v float64) {
+	x.xxx_hidden_NightWorkDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *DriverTimeTable_DailyTime) HasDate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Date != nil
+}
+
+func (x *DriverTimeTable_DailyTime) HasHoliday() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DriverTimeTable_DailyTime) HasDayWorkDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DriverTimeTable_DailyTime) HasNightWorkDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DriverTimeTable_DailyTime) ClearDate() {
+	x.xxx_hidden_Date = nil
+}
+
+func (x *DriverTimeTable_DailyTime) ClearHoliday() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Holiday = false
+}
+
+func (x *DriverTimeTable_DailyTime) ClearDayWorkDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DayWorkDurationS = 0
+}
+
+func (x *DriverTimeTable_DailyTime) ClearNightWorkDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_NightWorkDurationS = 0
+}
+
+type DriverTimeTable_DailyTime_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The date of the day.
+	Date *timestamppb.Timestamp
+	// Whether the day is a holiday.
+	Holiday *bool
+	// The working time during business hours in seconds.
+	DayWorkDurationS *float64
+	// The working time outside business hours in seconds.
+	NightWorkDurationS *float64
+}
+
+func (b0 DriverTimeTable_DailyTime_builder) Build() *DriverTimeTable_DailyTime {
+	m0 := &DriverTimeTable_DailyTime{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Date = b.Date
+	if b.Holiday != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Holiday = *b.Holiday
+	}
+	if b.DayWorkDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_DayWorkDurationS = *b.DayWorkDurationS
+	}
+	if b.NightWorkDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_NightWorkDurationS = *b.NightWorkDurationS
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_driver_time_table_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_rawDesc = "" +
+	"\n" +
+	"9wayplatform/connect/trusttrack/v1/driver_time_table.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x04\n" +
+	"\x0fDriverTimeTable\x12$\n" +
+	"\vdriver_name\x18\x01 \x01(\tB\x03\x80\x01\x01R\n" +
+	"driverName\x12#\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tB\x03\x80\x01\x01R\n" +
+	"identifier\x12-\n" +
+	"\x10time_card_number\x18\x03 \x01(\tB\x03\x80\x01\x01R\x0etimeCardNumber\x12P\n" +
+	"\x04days\x18\x04 \x03(\v2<.wayplatform.connect.trusttrack.v1.DriverTimeTable.DailyTimeR\x04days\x126\n" +
+	"\x13total_day_working_h\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10totalDayWorkingH\x12:\n" +
+	"\x15total_night_working_h\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x12totalNightWorkingH\x12/\n" +
+	"\x0ftotal_working_h\x18\a \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rtotalWorkingH\x1a\xe4\x01\n" +
+	"\tDailyTime\x12;\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\x04date\x12\x18\n" +
+	"\aholiday\x18\x02 \x01(\bR\aholiday\x12=\n" +
+	"\x13day_work_duration_s\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x10dayWorkDurationS\x12A\n" +
+	"\x15night_work_duration_s\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x12nightWorkDurationSB\xc7\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x14DriverTimeTableProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_goTypes = []any{
+	(*DriverTimeTable)(nil),           // 0: wayplatform.connect.trusttrack.v1.DriverTimeTable
+	(*DriverTimeTable_DailyTime)(nil), // 1: wayplatform.connect.trusttrack.v1.DriverTimeTable.DailyTime
+	(*timestamppb.Timestamp)(nil),     // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.trusttrack.v1.DriverTimeTable.days:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeTable.DailyTime
+	2, // 1: wayplatform.connect.trusttrack.v1.DriverTimeTable.DailyTime.date:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_driver_time_table_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_driver_time_table_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_depIdxs = nil
+}
@@ -0,0 +1,4829 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/ecodriving.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ecodriving parameters of an object or a driver for a time period.
+type Ecodriving struct {
+	state                    protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_SubjectId     *string                   `protobuf:"bytes,1,opt,name=subject_id,json=subjectId"`
+	xxx_hidden_Acceleration  *Ecodriving_Acceleration  `protobuf:"bytes,2,opt,name=acceleration"`
+	xxx_hidden_Braking       *Ecodriving_Braking       `protobuf:"bytes,3,opt,name=braking"`
+	xxx_hidden_Cornering     *Ecodriving_Cornering     `protobuf:"bytes,4,opt,name=cornering"`
+	xxx_hidden_CruiseControl *Ecodriving_CruiseControl `protobuf:"bytes,5,opt,name=cruise_control,json=cruiseControl"`
+	xxx_hidden_Engine        *Ecodriving_Engine        `protobuf:"bytes,6,opt,name=engine"`
+	xxx_hidden_Idling        *Ecodriving_Idling        `protobuf:"bytes,7,opt,name=idling"`
+	xxx_hidden_Main          *Ecodriving_Main          `protobuf:"bytes,8,opt,name=main"`
+	xxx_hidden_Speed         *Ecodriving_Speed         `protobuf:"bytes,9,opt,name=speed"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Ecodriving) Reset() {
+	*x = Ecodriving{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving) ProtoMessage() {}
+
+func (x *Ecodriving) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving) GetSubjectId() string {
+	if x != nil {
+		if x.xxx_hidden_SubjectId != nil {
+			return *x.xxx_hidden_SubjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Ecodriving) GetAcceleration() *Ecodriving_Acceleration {
+	if x != nil {
+		return x.xxx_hidden_Acceleration
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetBraking() *Ecodriving_Braking {
+	if x != nil {
+		return x.xxx_hidden_Braking
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetCornering() *Ecodriving_Cornering {
+	if x != nil {
+		return x.xxx_hidden_Cornering
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetCruiseControl() *Ecodriving_CruiseControl {
+	if x != nil {
+		return x.xxx_hidden_CruiseControl
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetEngine() *Ecodriving_Engine {
+	if x != nil {
+		return x.xxx_hidden_Engine
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetIdling() *Ecodriving_Idling {
+	if x != nil {
+		return x.xxx_hidden_Idling
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetMain() *Ecodriving_Main {
+	if x != nil {
+		return x.xxx_hidden_Main
+	}
+	return nil
+}
+
+func (x *Ecodriving) GetSpeed() *Ecodriving_Speed {
+	if x != nil {
+		return x.xxx_hidden_Speed
+	}
+	return nil
+}
+
+func (x *Ecodriving) SetSubjectId(v string) {
+	x.xxx_hidden_SubjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *Ecodriving) SetAcceleration(v *Ecodriving_Acceleration) {
+	x.xxx_hidden_Acceleration = v
+}
+
+func (x *Ecodriving) SetBraking(v *Ecodriving_Braking) {
+	x.xxx_hidden_Braking = v
+}
+
+func (x *Ecodriving) SetCornering(v *Ecodriving_Cornering) {
+	x.xxx_hidden_Cornering = v
+}
+
+func (x *Ecodriving) SetCruiseControl(v *Ecodriving_CruiseControl) {
+	x.xxx_hidden_CruiseControl = v
+}
+
+func (x *Ecodriving) SetEngine(v *Ecodriving_Engine) {
+	x.xxx_hidden_Engine = v
+}
+
+func (x *Ecodriving) SetIdling(v *Ecodriving_Idling) {
+	x.xxx_hidden_Idling = v
+}
+
+func (x *Ecodriving) SetMain(v *Ecodriving_Main) {
+	x.xxx_hidden_Main = v
+}
+
+func (x *Ecodriving) SetSpeed(v *Ecodriving_Speed) {
+	x.xxx_hidden_Speed = v
+}
+
+func (x *Ecodriving) HasSubjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving) HasAcceleration() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Acceleration != nil
+}
+
+func (x *Ecodriving) HasBraking() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Braking != nil
+}
+
+func (x *Ecodriving) HasCornering() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Cornering != nil
+}
+
+func (x *Ecodriving) HasCruiseControl() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CruiseControl != nil
+}
+
+func (x *Ecodriving) HasEngine() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Engine != nil
+}
+
+func (x *Ecodriving) HasIdling() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Idling != nil
+}
+
+func (x *Ecodriving) HasMain() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Main != nil
+}
+
+func (x *Ecodriving) HasSpeed() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Speed != nil
+}
+
+func (x *Ecodriving) ClearSubjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SubjectId = nil
+}
+
+func (x *Ecodriving) ClearAcceleration() {
+	x.xxx_hidden_Acceleration = nil
+}
+
+func (x *Ecodriving) ClearBraking() {
+	x.xxx_hidden_Braking = nil
+}
+
+func (x *Ecodriving) ClearCornering() {
+	x.xxx_hidden_Cornering = nil
+}
+
+func (x *Ecodriving) ClearCruiseControl() {
+	x.xxx_hidden_CruiseControl = nil
+}
+
+func (x *Ecodriving) ClearEngine() {
+	x.xxx_hidden_Engine = nil
+}
+
+func (x *Ecodriving) ClearIdling() {
+	x.xxx_hidden_Idling = nil
+}
+
+func (x *Ecodriving) ClearMain() {
+	x.xxx_hidden_Main = nil
+}
+
+func (x *Ecodriving) ClearSpeed() {
+	x.xxx_hidden_Speed = nil
+}
+
+type Ecodriving_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object or driver the parameters were calculated for.
+	SubjectId *string
+	// Acceleration parameters.
+	Acceleration *Ecodriving_Acceleration
+	// Braking parameters.
+	Braking *Ecodriving_Braking
+	// Cornering parameters.
+	Cornering *Ecodriving_Cornering
+	// Cruise control parameters.
+	CruiseControl *Ecodriving_CruiseControl
+	// Engine parameters.
+	Engine *Ecodriving_Engine
+	// Idling parameters.
+	Idling *Ecodriving_Idling
+	// Main parameters.
+	Main *Ecodriving_Main
+	// Speed parameters.
+	Speed *Ecodriving_Speed
+}
+
+func (b0 Ecodriving_builder) Build() *Ecodriving {
+	m0 := &Ecodriving{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SubjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_SubjectId = b.SubjectId
+	}
+	x.xxx_hidden_Acceleration = b.Acceleration
+	x.xxx_hidden_Braking = b.Braking
+	x.xxx_hidden_Cornering = b.Cornering
+	x.xxx_hidden_CruiseControl = b.CruiseControl
+	x.xxx_hidden_Engine = b.Engine
+	x.xxx_hidden_Idling = b.Idling
+	x.xxx_hidden_Main = b.Main
+	x.xxx_hidden_Speed = b.Speed
+	return m0
+}
+
+// Acceleration parameters.
+type Ecodriving_Acceleration struct {
+	state                                          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AccelerationScore                   int32                  `protobuf:"varint,1,opt,name=acceleration_score,json=accelerationScore"`
+	xxx_hidden_BadAccelerationEventCount           float32                `protobuf:"fixed32,2,opt,name=bad_acceleration_event_count,json=badAccelerationEventCount"`
+	xxx_hidden_BadAccelerationEventRatePer_100Km   float32                `protobuf:"fixed32,3,opt,name=bad_acceleration_event_rate_per_100km,json=badAccelerationEventRatePer100km"`
+	xxx_hidden_GoodAccelerationEventCount          float32                `protobuf:"fixed32,4,opt,name=good_acceleration_event_count,json=goodAccelerationEventCount"`
+	xxx_hidden_GoodAccelerationEventRatePer_100Km  float32                `protobuf:"fixed32,5,opt,name=good_acceleration_event_rate_per_100km,json=goodAccelerationEventRatePer100km"`
+	xxx_hidden_HarshAccelerationEventCount         float32                `protobuf:"fixed32,6,opt,name=harsh_acceleration_event_count,json=harshAccelerationEventCount"`
+	xxx_hidden_HarshAccelerationEventRatePer_100Km float32                `protobuf:"fixed32,7,opt,name=harsh_acceleration_event_rate_per_100km,json=harshAccelerationEventRatePer100km"`
+	xxx_hidden_MaximumAccelerationMps2             float32                `protobuf:"fixed32,8,opt,name=maximum_acceleration_mps2,json=maximumAccelerationMps2"`
+	XXX_raceDetectHookData                         protoimpl.RaceDetectHookData
+	XXX_presence                                   [1]uint32
+	unknownFields                                  protoimpl.UnknownFields
+	sizeCache                                      protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Acceleration) Reset() {
+	*x = Ecodriving_Acceleration{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Acceleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Acceleration) ProtoMessage() {}
+
+func (x *Ecodriving_Acceleration) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Acceleration) GetAccelerationScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_AccelerationScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetBadAccelerationEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_BadAccelerationEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetBadAccelerationEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_BadAccelerationEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetGoodAccelerationEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_GoodAccelerationEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetGoodAccelerationEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_GoodAccelerationEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetHarshAccelerationEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_HarshAccelerationEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetHarshAccelerationEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_HarshAccelerationEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) GetMaximumAccelerationMps2() float32 {
+	if x != nil {
+		return x.xxx_hidden_MaximumAccelerationMps2
+	}
+	return 0
+}
+
+func (x *Ecodriving_Acceleration) SetAccelerationScore(v int32) {
+	x.xxx_hidden_AccelerationScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetBadAccelerationEventCount(v float32) {
+	x.xxx_hidden_BadAccelerationEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetBadAccelerationEventRatePer_100Km(v float32) {
+	x.xxx_hidden_BadAccelerationEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetGoodAccelerationEventCount(v float32) {
+	x.xxx_hidden_GoodAccelerationEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetGoodAccelerationEventRatePer_100Km(v float32) {
+	x.xxx_hidden_GoodAccelerationEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetHarshAccelerationEventCount(v float32) {
+	x.xxx_hidden_HarshAccelerationEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetHarshAccelerationEventRatePer_100Km(v float32) {
+	x.xxx_hidden_HarshAccelerationEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *Ecodriving_Acceleration) SetMaximumAccelerationMps2(v float32) {
+	x.xxx_hidden_MaximumAccelerationMps2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *Ecodriving_Acceleration) HasAccelerationScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Acceleration) HasBadAccelerationEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Acceleration) HasBadAccelerationEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Acceleration) HasGoodAccelerationEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Acceleration) HasGoodAccelerationEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Acceleration) HasHarshAccelerationEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_Acceleration) HasHarshAccelerationEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_Acceleration) HasMaximumAccelerationMps2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_Acceleration) ClearAccelerationScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AccelerationScore = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearBadAccelerationEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BadAccelerationEventCount = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearBadAccelerationEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_BadAccelerationEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearGoodAccelerationEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_GoodAccelerationEventCount = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearGoodAccelerationEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_GoodAccelerationEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearHarshAccelerationEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_HarshAccelerationEventCount = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearHarshAccelerationEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_HarshAccelerationEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Acceleration) ClearMaximumAccelerationMps2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_MaximumAccelerationMps2 = 0
+}
+
+type Ecodriving_Acceleration_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The acceleration score (0-100).
+	AccelerationScore *int32
+	// The number of bad acceleration events.
+	BadAccelerationEventCount *float32
+	// The rate of bad acceleration events (units: events/100km).
+	BadAccelerationEventRatePer_100Km *float32
+	// The number of good acceleration events.
+	GoodAccelerationEventCount *float32
+	// The rate of good acceleration events (units: events/100km).
+	GoodAccelerationEventRatePer_100Km *float32
+	// The number of harsh acceleration events.
+	HarshAccelerationEventCount *float32
+	// The rate of harsh acceleration events (units: events/100km).
+	HarshAccelerationEventRatePer_100Km *float32
+	// The maximum acceleration (units: m/s²).
+	MaximumAccelerationMps2 *float32
+}
+
+func (b0 Ecodriving_Acceleration_builder) Build() *Ecodriving_Acceleration {
+	m0 := &Ecodriving_Acceleration{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AccelerationScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_AccelerationScore = *b.AccelerationScore
+	}
+	if b.BadAccelerationEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_BadAccelerationEventCount = *b.BadAccelerationEventCount
+	}
+	if b.BadAccelerationEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_BadAccelerationEventRatePer_100Km = *b.BadAccelerationEventRatePer_100Km
+	}
+	if b.GoodAccelerationEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_GoodAccelerationEventCount = *b.GoodAccelerationEventCount
+	}
+	if b.GoodAccelerationEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_GoodAccelerationEventRatePer_100Km = *b.GoodAccelerationEventRatePer_100Km
+	}
+	if b.HarshAccelerationEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_HarshAccelerationEventCount = *b.HarshAccelerationEventCount
+	}
+	if b.HarshAccelerationEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_HarshAccelerationEventRatePer_100Km = *b.HarshAccelerationEventRatePer_100Km
+	}
+	if b.MaximumAccelerationMps2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_MaximumAccelerationMps2 = *b.MaximumAccelerationMps2
+	}
+	return m0
+}
+
+// Braking parameters.
+type Ecodriving_Braking struct {
+	state                                                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BrakingScore                                int32                  `protobuf:"varint,1,opt,name=braking_score,json=brakingScore"`
+	xxx_hidden_BrakingDistanceKm                           float32                `protobuf:"fixed32,2,opt,name=braking_distance_km,json=brakingDistanceKm"`
+	xxx_hidden_BrakingDistancePercent                      float32                `protobuf:"fixed32,3,opt,name=braking_distance_percent,json=brakingDistancePercent"`
+	xxx_hidden_BrakingDurationS                            float32                `protobuf:"fixed32,4,opt,name=braking_duration_s,json=brakingDurationS"`
+	xxx_hidden_EngineBrakingDistanceKm                     float32                `protobuf:"fixed32,5,opt,name=engine_braking_distance_km,json=engineBrakingDistanceKm"`
+	xxx_hidden_EngineBrakingDistancePercent                float32                `protobuf:"fixed32,6,opt,name=engine_braking_distance_percent,json=engineBrakingDistancePercent"`
+	xxx_hidden_NormalBrakingEventCount                     float32                `protobuf:"fixed32,7,opt,name=normal_braking_event_count,json=normalBrakingEventCount"`
+	xxx_hidden_NormalBrakingEventRatePer_100Km             float32                `protobuf:"fixed32,8,opt,name=normal_braking_event_rate_per_100km,json=normalBrakingEventRatePer100km"`
+	xxx_hidden_NormalBrakingStopsPercent                   float32                `protobuf:"fixed32,9,opt,name=normal_braking_stops_percent,json=normalBrakingStopsPercent"`
+	xxx_hidden_HarshBrakingEventCount                      float32                `protobuf:"fixed32,10,opt,name=harsh_braking_event_count,json=harshBrakingEventCount"`
+	xxx_hidden_HarshBrakingEventRatePer_100Km              float32                `protobuf:"fixed32,11,opt,name=harsh_braking_event_rate_per_100km,json=harshBrakingEventRatePer100km"`
+	xxx_hidden_ExtremeBrakingEventCount                    float32                `protobuf:"fixed32,12,opt,name=extreme_braking_event_count,json=extremeBrakingEventCount"`
+	xxx_hidden_ExtremeBrakingEventRatePer_100Km            float32                `protobuf:"fixed32,13,opt,name=extreme_braking_event_rate_per_100km,json=extremeBrakingEventRatePer100km"`
+	xxx_hidden_MaximumBrakingMps2                          float32                `protobuf:"fixed32,14,opt,name=maximum_braking_mps2,json=maximumBrakingMps2"`
+	xxx_hidden_ParkingBrakeAbuseEventCount                 float32                `protobuf:"fixed32,15,opt,name=parking_brake_abuse_event_count,json=parkingBrakeAbuseEventCount"`
+	xxx_hidden_ParkingBrakeAbuseRatePer_100Km              float32                `protobuf:"fixed32,16,opt,name=parking_brake_abuse_rate_per_100km,json=parkingBrakeAbuseRatePer100km"`
+	xxx_hidden_RetarderDistanceKm                          float32                `protobuf:"fixed32,17,opt,name=retarder_distance_km,json=retarderDistanceKm"`
+	xxx_hidden_RetarderDistancePercent                     float32                `protobuf:"fixed32,18,opt,name=retarder_distance_percent,json=retarderDistancePercent"`
+	xxx_hidden_RetarderDurationS                           float32                `protobuf:"fixed32,19,opt,name=retarder_duration_s,json=retarderDurationS"`
+	xxx_hidden_RetarderDurationPercent                     float32                `protobuf:"fixed32,20,opt,name=retarder_duration_percent,json=retarderDurationPercent"`
+	xxx_hidden_RetarderWithoutCruiseControlDistanceKm      float32                `protobuf:"fixed32,21,opt,name=retarder_without_cruise_control_distance_km,json=retarderWithoutCruiseControlDistanceKm"`
+	xxx_hidden_RetarderWithoutCruiseControlDistancePercent float32                `protobuf:"fixed32,22,opt,name=retarder_without_cruise_control_distance_percent,json=retarderWithoutCruiseControlDistancePercent"`
+	xxx_hidden_StopsEventCount                             float32                `protobuf:"fixed32,23,opt,name=stops_event_count,json=stopsEventCount"`
+	xxx_hidden_StopsRatePer_100Km                          float32                `protobuf:"fixed32,24,opt,name=stops_rate_per_100km,json=stopsRatePer100km"`
+	XXX_raceDetectHookData                                 protoimpl.RaceDetectHookData
+	XXX_presence                                           [1]uint32
+	unknownFields                                          protoimpl.UnknownFields
+	sizeCache                                              protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Braking) Reset() {
+	*x = Ecodriving_Braking{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Braking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Braking) ProtoMessage() {}
+
+func (x *Ecodriving_Braking) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Braking) GetBrakingScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_BrakingScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetBrakingDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_BrakingDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetBrakingDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_BrakingDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetBrakingDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_BrakingDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetEngineBrakingDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineBrakingDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetEngineBrakingDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineBrakingDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetNormalBrakingEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_NormalBrakingEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetNormalBrakingEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_NormalBrakingEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetNormalBrakingStopsPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_NormalBrakingStopsPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetHarshBrakingEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_HarshBrakingEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetHarshBrakingEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_HarshBrakingEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetExtremeBrakingEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_ExtremeBrakingEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetExtremeBrakingEventRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_ExtremeBrakingEventRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetMaximumBrakingMps2() float32 {
+	if x != nil {
+		return x.xxx_hidden_MaximumBrakingMps2
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetParkingBrakeAbuseEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_ParkingBrakeAbuseEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetParkingBrakeAbuseRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_ParkingBrakeAbuseRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderDurationPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderDurationPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderWithoutCruiseControlDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderWithoutCruiseControlDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetRetarderWithoutCruiseControlDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RetarderWithoutCruiseControlDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetStopsEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_StopsEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) GetStopsRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_StopsRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Braking) SetBrakingScore(v int32) {
+	x.xxx_hidden_BrakingScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 24)
+}
+
+func (x *Ecodriving_Braking) SetBrakingDistanceKm(v float32) {
+	x.xxx_hidden_BrakingDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 24)
+}
+
+func (x *Ecodriving_Braking) SetBrakingDistancePercent(v float32) {
+	x.xxx_hidden_BrakingDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 24)
+}
+
+func (x *Ecodriving_Braking) SetBrakingDurationS(v float32) {
+	x.xxx_hidden_BrakingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 24)
+}
+
+func (x *Ecodriving_Braking) SetEngineBrakingDistanceKm(v float32) {
+	x.xxx_hidden_EngineBrakingDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 24)
+}
+
+func (x *Ecodriving_Braking) SetEngineBrakingDistancePercent(v float32) {
+	x.xxx_hidden_EngineBrakingDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 24)
+}
+
+func (x *Ecodriving_Braking) SetNormalBrakingEventCount(v float32) {
+	x.xxx_hidden_NormalBrakingEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 24)
+}
+
+func (x *Ecodriving_Braking) SetNormalBrakingEventRatePer_100Km(v float32) {
+	x.xxx_hidden_NormalBrakingEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 24)
+}
+
+func (x *Ecodriving_Braking) SetNormalBrakingStopsPercent(v float32) {
+	x.xxx_hidden_NormalBrakingStopsPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 24)
+}
+
+func (x *Ecodriving_Braking) SetHarshBrakingEventCount(v float32) {
+	x.xxx_hidden_HarshBrakingEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 24)
+}
+
+func (x *Ecodriving_Braking) SetHarshBrakingEventRatePer_100Km(v float32) {
+	x.xxx_hidden_HarshBrakingEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 24)
+}
+
+func (x *Ecodriving_Braking) SetExtremeBrakingEventCount(v float32) {
+	x.xxx_hidden_ExtremeBrakingEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 24)
+}
+
+func (x *Ecodriving_Braking) SetExtremeBrakingEventRatePer_100Km(v float32) {
+	x.xxx_hidden_ExtremeBrakingEventRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 24)
+}
+
+func (x *Ecodriving_Braking) SetMaximumBrakingMps2(v float32) {
+	x.xxx_hidden_MaximumBrakingMps2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 24)
+}
+
+func (x *Ecodriving_Braking) SetParkingBrakeAbuseEventCount(v float32) {
+	x.xxx_hidden_ParkingBrakeAbuseEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 24)
+}
+
+func (x *Ecodriving_Braking) SetParkingBrakeAbuseRatePer_100Km(v float32) {
+	x.xxx_hidden_ParkingBrakeAbuseRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderDistanceKm(v float32) {
+	x.xxx_hidden_RetarderDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderDistancePercent(v float32) {
+	x.xxx_hidden_RetarderDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderDurationS(v float32) {
+	x.xxx_hidden_RetarderDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderDurationPercent(v float32) {
+	x.xxx_hidden_RetarderDurationPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderWithoutCruiseControlDistanceKm(v float32) {
+	x.xxx_hidden_RetarderWithoutCruiseControlDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 24)
+}
+
+func (x *Ecodriving_Braking) SetRetarderWithoutCruiseControlDistancePercent(v float32) {
+	x.xxx_hidden_RetarderWithoutCruiseControlDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 24)
+}
+
+func (x *Ecodriving_Braking) SetStopsEventCount(v float32) {
+	x.xxx_hidden_StopsEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 24)
+}
+
+func (x *Ecodriving_Braking) SetStopsRatePer_100Km(v float32) {
+	x.xxx_hidden_StopsRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 24)
+}
+
+func (x *Ecodriving_Braking) HasBrakingScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Braking) HasBrakingDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Braking) HasBrakingDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Braking) HasBrakingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Braking) HasEngineBrakingDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Braking) HasEngineBrakingDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_Braking) HasNormalBrakingEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_Braking) HasNormalBrakingEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_Braking) HasNormalBrakingStopsPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Ecodriving_Braking) HasHarshBrakingEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Ecodriving_Braking) HasHarshBrakingEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Ecodriving_Braking) HasExtremeBrakingEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Ecodriving_Braking) HasExtremeBrakingEventRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Ecodriving_Braking) HasMaximumBrakingMps2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *Ecodriving_Braking) HasParkingBrakeAbuseEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *Ecodriving_Braking) HasParkingBrakeAbuseRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Ecodriving_Braking) HasRetarderDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *Ecodriving_Braking) HasRetarderDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *Ecodriving_Braking) HasRetarderDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *Ecodriving_Braking) HasRetarderDurationPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *Ecodriving_Braking) HasRetarderWithoutCruiseControlDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *Ecodriving_Braking) HasRetarderWithoutCruiseControlDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *Ecodriving_Braking) HasStopsEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *Ecodriving_Braking) HasStopsRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 23)
+}
+
+func (x *Ecodriving_Braking) ClearBrakingScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_BrakingScore = 0
+}
+
+func (x *Ecodriving_Braking) ClearBrakingDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BrakingDistanceKm = 0
+}
+
+func (x *Ecodriving_Braking) ClearBrakingDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_BrakingDistancePercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearBrakingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_BrakingDurationS = 0
+}
+
+func (x *Ecodriving_Braking) ClearEngineBrakingDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_EngineBrakingDistanceKm = 0
+}
+
+func (x *Ecodriving_Braking) ClearEngineBrakingDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_EngineBrakingDistancePercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearNormalBrakingEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_NormalBrakingEventCount = 0
+}
+
+func (x *Ecodriving_Braking) ClearNormalBrakingEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_NormalBrakingEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Braking) ClearNormalBrakingStopsPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_NormalBrakingStopsPercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearHarshBrakingEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_HarshBrakingEventCount = 0
+}
+
+func (x *Ecodriving_Braking) ClearHarshBrakingEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_HarshBrakingEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Braking) ClearExtremeBrakingEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_ExtremeBrakingEventCount = 0
+}
+
+func (x *Ecodriving_Braking) ClearExtremeBrakingEventRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_ExtremeBrakingEventRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Braking) ClearMaximumBrakingMps2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_MaximumBrakingMps2 = 0
+}
+
+func (x *Ecodriving_Braking) ClearParkingBrakeAbuseEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_ParkingBrakeAbuseEventCount = 0
+}
+
+func (x *Ecodriving_Braking) ClearParkingBrakeAbuseRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_ParkingBrakeAbuseRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_RetarderDistanceKm = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_RetarderDistancePercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_RetarderDurationS = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderDurationPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_RetarderDurationPercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderWithoutCruiseControlDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_RetarderWithoutCruiseControlDistanceKm = 0
+}
+
+func (x *Ecodriving_Braking) ClearRetarderWithoutCruiseControlDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_RetarderWithoutCruiseControlDistancePercent = 0
+}
+
+func (x *Ecodriving_Braking) ClearStopsEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_StopsEventCount = 0
+}
+
+func (x *Ecodriving_Braking) ClearStopsRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 23)
+	x.xxx_hidden_StopsRatePer_100Km = 0
+}
+
+type Ecodriving_Braking_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The braking score (0-100).
+	BrakingScore *int32
+	// The distance driven while braking (units: km).
+	BrakingDistanceKm *float32
+	// The share of distance driven while braking (units: %).
+	BrakingDistancePercent *float32
+	// The duration of braking (units: s).
+	BrakingDurationS *float32
+	// The distance driven while engine braking (units: km).
+	EngineBrakingDistanceKm *float32
+	// The share of distance driven while engine braking (units: %).
+	EngineBrakingDistancePercent *float32
+	// The number of normal braking events.
+	NormalBrakingEventCount *float32
+	// The rate of normal braking events (units: events/100km).
+	NormalBrakingEventRatePer_100Km *float32
+	// The share of stops made with normal braking (units: %).
+	NormalBrakingStopsPercent *float32
+	// The number of harsh braking events.
+	HarshBrakingEventCount *float32
+	// The rate of harsh braking events (units: events/100km).
+	HarshBrakingEventRatePer_100Km *float32
+	// The number of extreme braking events.
+	ExtremeBrakingEventCount *float32
+	// The rate of extreme braking events (units: events/100km).
+	ExtremeBrakingEventRatePer_100Km *float32
+	// The maximum deceleration (units: m/s²).
+	MaximumBrakingMps2 *float32
+	// The number of parking brake abuse events.
+	ParkingBrakeAbuseEventCount *float32
+	// The rate of parking brake abuse events (units: events/100km).
+	ParkingBrakeAbuseRatePer_100Km *float32
+	// The distance driven with the retarder engaged (units: km).
+	RetarderDistanceKm *float32
+	// The share of distance driven with the retarder engaged (units: %).
+	RetarderDistancePercent *float32
+	// The duration with the retarder engaged (units: s).
+	RetarderDurationS *float32
+	// The share of time with the retarder engaged (units: %).
+	RetarderDurationPercent *float32
+	// The distance driven with the retarder engaged and cruise control off (units: km).
+	RetarderWithoutCruiseControlDistanceKm *float32
+	// The share of distance driven with the retarder engaged and cruise control off (units: %).
+	RetarderWithoutCruiseControlDistancePercent *float32
+	// The number of stops.
+	StopsEventCount *float32
+	// The rate of stops (units: events/100km).
+	StopsRatePer_100Km *float32
+}
+
+func (b0 Ecodriving_Braking_builder) Build() *Ecodriving_Braking {
+	m0 := &Ecodriving_Braking{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.BrakingScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 24)
+		x.xxx_hidden_BrakingScore = *b.BrakingScore
+	}
+	if b.BrakingDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 24)
+		x.xxx_hidden_BrakingDistanceKm = *b.BrakingDistanceKm
+	}
+	if b.BrakingDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 24)
+		x.xxx_hidden_BrakingDistancePercent = *b.BrakingDistancePercent
+	}
+	if b.BrakingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 24)
+		x.xxx_hidden_BrakingDurationS = *b.BrakingDurationS
+	}
+	if b.EngineBrakingDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 24)
+		x.xxx_hidden_EngineBrakingDistanceKm = *b.EngineBrakingDistanceKm
+	}
+	if b.EngineBrakingDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 24)
+		x.xxx_hidden_EngineBrakingDistancePercent = *b.EngineBrakingDistancePercent
+	}
+	if b.NormalBrakingEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 24)
+		x.xxx_hidden_NormalBrakingEventCount = *b.NormalBrakingEventCount
+	}
+	if b.NormalBrakingEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 24)
+		x.xxx_hidden_NormalBrakingEventRatePer_100Km = *b.NormalBrakingEventRatePer_100Km
+	}
+	if b.NormalBrakingStopsPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 24)
+		x.xxx_hidden_NormalBrakingStopsPercent = *b.NormalBrakingStopsPercent
+	}
+	if b.HarshBrakingEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 24)
+		x.xxx_hidden_HarshBrakingEventCount = *b.HarshBrakingEventCount
+	}
+	if b.HarshBrakingEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 24)
+		x.xxx_hidden_HarshBrakingEventRatePer_100Km = *b.HarshBrakingEventRatePer_100Km
+	}
+	if b.ExtremeBrakingEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 24)
+		x.xxx_hidden_ExtremeBrakingEventCount = *b.ExtremeBrakingEventCount
+	}
+	if b.ExtremeBrakingEventRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 24)
+		x.xxx_hidden_ExtremeBrakingEventRatePer_100Km = *b.ExtremeBrakingEventRatePer_100Km
+	}
+	if b.MaximumBrakingMps2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 24)
+		x.xxx_hidden_MaximumBrakingMps2 = *b.MaximumBrakingMps2
+	}
+	if b.ParkingBrakeAbuseEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 24)
+		x.xxx_hidden_ParkingBrakeAbuseEventCount = *b.ParkingBrakeAbuseEventCount
+	}
+	if b.ParkingBrakeAbuseRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 24)
+		x.xxx_hidden_ParkingBrakeAbuseRatePer_100Km = *b.ParkingBrakeAbuseRatePer_100Km
+	}
+	if b.RetarderDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 24)
+		x.xxx_hidden_RetarderDistanceKm = *b.RetarderDistanceKm
+	}
+	if b.RetarderDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 24)
+		x.xxx_hidden_RetarderDistancePercent = *b.RetarderDistancePercent
+	}
+	if b.RetarderDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 24)
+		x.xxx_hidden_RetarderDurationS = *b.RetarderDurationS
+	}
+	if b.RetarderDurationPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 24)
+		x.xxx_hidden_RetarderDurationPercent = *b.RetarderDurationPercent
+	}
+	if b.RetarderWithoutCruiseControlDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 24)
+		x.xxx_hidden_RetarderWithoutCruiseControlDistanceKm = *b.RetarderWithoutCruiseControlDistanceKm
+	}
+	if b.RetarderWithoutCruiseControlDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 24)
+		x.xxx_hidden_RetarderWithoutCruiseControlDistancePercent = *b.RetarderWithoutCruiseControlDistancePercent
+	}
+	if b.StopsEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 24)
+		x.xxx_hidden_StopsEventCount = *b.StopsEventCount
+	}
+	if b.StopsRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 24)
+		x.xxx_hidden_StopsRatePer_100Km = *b.StopsRatePer_100Km
+	}
+	return m0
+}
+
+// Cornering parameters.
+type Ecodriving_Cornering struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CorneringEventCount    float32                `protobuf:"fixed32,1,opt,name=cornering_event_count,json=corneringEventCount"`
+	xxx_hidden_CorneringRatePer_100Km float32                `protobuf:"fixed32,2,opt,name=cornering_rate_per_100km,json=corneringRatePer100km"`
+	xxx_hidden_MaximumCorneringMps2   float32                `protobuf:"fixed32,3,opt,name=maximum_cornering_mps2,json=maximumCorneringMps2"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Cornering) Reset() {
+	*x = Ecodriving_Cornering{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Cornering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Cornering) ProtoMessage() {}
+
+func (x *Ecodriving_Cornering) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Cornering) GetCorneringEventCount() float32 {
+	if x != nil {
+		return x.xxx_hidden_CorneringEventCount
+	}
+	return 0
+}
+
+func (x *Ecodriving_Cornering) GetCorneringRatePer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_CorneringRatePer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Cornering) GetMaximumCorneringMps2() float32 {
+	if x != nil {
+		return x.xxx_hidden_MaximumCorneringMps2
+	}
+	return 0
+}
+
+func (x *Ecodriving_Cornering) SetCorneringEventCount(v float32) {
+	x.xxx_hidden_CorneringEventCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Ecodriving_Cornering) SetCorneringRatePer_100Km(v float32) {
+	x.xxx_hidden_CorneringRatePer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *Ecodriving_Cornering) SetMaximumCorneringMps2(v float32) {
+	x.xxx_hidden_MaximumCorneringMps2 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *Ecodriving_Cornering) HasCorneringEventCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Cornering) HasCorneringRatePer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Cornering) HasMaximumCorneringMps2() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Cornering) ClearCorneringEventCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CorneringEventCount = 0
+}
+
+func (x *Ecodriving_Cornering) ClearCorneringRatePer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CorneringRatePer_100Km = 0
+}
+
+func (x *Ecodriving_Cornering) ClearMaximumCorneringMps2() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MaximumCorneringMps2 = 0
+}
+
+type Ecodriving_Cornering_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The number of harsh cornering events.
+	CorneringEventCount *float32
+	// The rate of harsh cornering events (units: events/100km).
+	CorneringRatePer_100Km *float32
+	// The maximum lateral acceleration (units: m/s²).
+	MaximumCorneringMps2 *float32
+}
+
+func (b0 Ecodriving_Cornering_builder) Build() *Ecodriving_Cornering {
+	m0 := &Ecodriving_Cornering{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CorneringEventCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_CorneringEventCount = *b.CorneringEventCount
+	}
+	if b.CorneringRatePer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_CorneringRatePer_100Km = *b.CorneringRatePer_100Km
+	}
+	if b.MaximumCorneringMps2 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_MaximumCorneringMps2 = *b.MaximumCorneringMps2
+	}
+	return m0
+}
+
+// Cruise control parameters.
+type Ecodriving_CruiseControl struct {
+	state                                                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CruiseControlScore                                    int32                  `protobuf:"varint,1,opt,name=cruise_control_score,json=cruiseControlScore"`
+	xxx_hidden_CruiseControlPercent                                  float32                `protobuf:"fixed32,2,opt,name=cruise_control_percent,json=cruiseControlPercent"`
+	xxx_hidden_CruiseControlDistanceKm                               float32                `protobuf:"fixed32,3,opt,name=cruise_control_distance_km,json=cruiseControlDistanceKm"`
+	xxx_hidden_CruiseControlDistancePercent                          float32                `protobuf:"fixed32,4,opt,name=cruise_control_distance_percent,json=cruiseControlDistancePercent"`
+	xxx_hidden_CruiseControlDistanceFromAllDistancePercent           float32                `protobuf:"fixed32,5,opt,name=cruise_control_distance_from_all_distance_percent,json=cruiseControlDistanceFromAllDistancePercent"`
+	xxx_hidden_CruiseControlDurationS                                float32                `protobuf:"fixed32,6,opt,name=cruise_control_duration_s,json=cruiseControlDurationS"`
+	xxx_hidden_CruiseControlDurationPercent                          float32                `protobuf:"fixed32,7,opt,name=cruise_control_duration_percent,json=cruiseControlDurationPercent"`
+	xxx_hidden_CruiseControlFuelConsumedL                            float32                `protobuf:"fixed32,8,opt,name=cruise_control_fuel_consumed_l,json=cruiseControlFuelConsumedL"`
+	xxx_hidden_CruiseControlFuelConsumptionLPer_100Km                float32                `protobuf:"fixed32,9,opt,name=cruise_control_fuel_consumption_l_per_100km,json=cruiseControlFuelConsumptionLPer100km"`
+	xxx_hidden_CruiseControlCouldBeOnDistanceKm                      float32                `protobuf:"fixed32,10,opt,name=cruise_control_could_be_on_distance_km,json=cruiseControlCouldBeOnDistanceKm"`
+	xxx_hidden_CruiseControlCouldBeOnDurationS                       float32                `protobuf:"fixed32,11,opt,name=cruise_control_could_be_on_duration_s,json=cruiseControlCouldBeOnDurationS"`
+	xxx_hidden_CruiseControlWasOnDistanceKm                          float32                `protobuf:"fixed32,12,opt,name=cruise_control_was_on_distance_km,json=cruiseControlWasOnDistanceKm"`
+	xxx_hidden_CruiseControlWasOnDurationS                           float32                `protobuf:"fixed32,13,opt,name=cruise_control_was_on_duration_s,json=cruiseControlWasOnDurationS"`
+	xxx_hidden_CruiseControlWithAcceleratorDistanceKm                float32                `protobuf:"fixed32,14,opt,name=cruise_control_with_accelerator_distance_km,json=cruiseControlWithAcceleratorDistanceKm"`
+	xxx_hidden_CruiseControlWithAcceleratorDistancePercent           float32                `protobuf:"fixed32,15,opt,name=cruise_control_with_accelerator_distance_percent,json=cruiseControlWithAcceleratorDistancePercent"`
+	xxx_hidden_CruiseControlWithAcceleratorDurationS                 float32                `protobuf:"fixed32,16,opt,name=cruise_control_with_accelerator_duration_s,json=cruiseControlWithAcceleratorDurationS"`
+	xxx_hidden_CruiseControlWithAcceleratorDurationPercent           float32                `protobuf:"fixed32,17,opt,name=cruise_control_with_accelerator_duration_percent,json=cruiseControlWithAcceleratorDurationPercent"`
+	xxx_hidden_CruiseControlWithAcceleratorFuelConsumedL             float32                `protobuf:"fixed32,18,opt,name=cruise_control_with_accelerator_fuel_consumed_l,json=cruiseControlWithAcceleratorFuelConsumedL"`
+	xxx_hidden_CruiseControlWithAcceleratorFuelConsumptionLPer_100Km float32                `protobuf:"fixed32,19,opt,name=cruise_control_with_accelerator_fuel_consumption_l_per_100km,json=cruiseControlWithAcceleratorFuelConsumptionLPer100km"`
+	xxx_hidden_FreeRollingWithCruiseControlDistanceKm                float32                `protobuf:"fixed32,20,opt,name=free_rolling_with_cruise_control_distance_km,json=freeRollingWithCruiseControlDistanceKm"`
+	xxx_hidden_FreeRollingWithCruiseControlDistancePercent           float32                `protobuf:"fixed32,21,opt,name=free_rolling_with_cruise_control_distance_percent,json=freeRollingWithCruiseControlDistancePercent"`
+	xxx_hidden_FreeRollingWithCruiseControlFuelConsumedL             float32                `protobuf:"fixed32,22,opt,name=free_rolling_with_cruise_control_fuel_consumed_l,json=freeRollingWithCruiseControlFuelConsumedL"`
+	xxx_hidden_FreeRollingWithCruiseControlFuelConsumptionLPer_100Km float32                `protobuf:"fixed32,23,opt,name=free_rolling_with_cruise_control_fuel_consumption_l_per_100km,json=freeRollingWithCruiseControlFuelConsumptionLPer100km"`
+	XXX_raceDetectHookData                                           protoimpl.RaceDetectHookData
+	XXX_presence                                                     [1]uint32
+	unknownFields                                                    protoimpl.UnknownFields
+	sizeCache                                                        protoimpl.SizeCache
+}
+
+func (x *Ecodriving_CruiseControl) Reset() {
+	*x = Ecodriving_CruiseControl{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_CruiseControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_CruiseControl) ProtoMessage() {}
+
+func (x *Ecodriving_CruiseControl) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlDistanceFromAllDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlDistanceFromAllDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlDurationPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlDurationPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlCouldBeOnDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlCouldBeOnDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlCouldBeOnDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlCouldBeOnDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWasOnDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWasOnDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWasOnDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWasOnDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorDurationPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorDurationPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetCruiseControlWithAcceleratorFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetFreeRollingWithCruiseControlDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithCruiseControlDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetFreeRollingWithCruiseControlDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithCruiseControlDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetFreeRollingWithCruiseControlFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) GetFreeRollingWithCruiseControlFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlScore(v int32) {
+	x.xxx_hidden_CruiseControlScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlPercent(v float32) {
+	x.xxx_hidden_CruiseControlPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlDistanceKm(v float32) {
+	x.xxx_hidden_CruiseControlDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlDistancePercent(v float32) {
+	x.xxx_hidden_CruiseControlDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlDistanceFromAllDistancePercent(v float32) {
+	x.xxx_hidden_CruiseControlDistanceFromAllDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlDurationS(v float32) {
+	x.xxx_hidden_CruiseControlDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlDurationPercent(v float32) {
+	x.xxx_hidden_CruiseControlDurationPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlFuelConsumedL(v float32) {
+	x.xxx_hidden_CruiseControlFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_CruiseControlFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlCouldBeOnDistanceKm(v float32) {
+	x.xxx_hidden_CruiseControlCouldBeOnDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlCouldBeOnDurationS(v float32) {
+	x.xxx_hidden_CruiseControlCouldBeOnDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWasOnDistanceKm(v float32) {
+	x.xxx_hidden_CruiseControlWasOnDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWasOnDurationS(v float32) {
+	x.xxx_hidden_CruiseControlWasOnDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorDistanceKm(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorDistancePercent(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorDurationS(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorDurationPercent(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorDurationPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorFuelConsumedL(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetCruiseControlWithAcceleratorFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetFreeRollingWithCruiseControlDistanceKm(v float32) {
+	x.xxx_hidden_FreeRollingWithCruiseControlDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetFreeRollingWithCruiseControlDistancePercent(v float32) {
+	x.xxx_hidden_FreeRollingWithCruiseControlDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetFreeRollingWithCruiseControlFuelConsumedL(v float32) {
+	x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 23)
+}
+
+func (x *Ecodriving_CruiseControl) SetFreeRollingWithCruiseControlFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 23)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlDistanceFromAllDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlDurationPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlCouldBeOnDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlCouldBeOnDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWasOnDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWasOnDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorDurationPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *Ecodriving_CruiseControl) HasCruiseControlWithAcceleratorFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *Ecodriving_CruiseControl) HasFreeRollingWithCruiseControlDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *Ecodriving_CruiseControl) HasFreeRollingWithCruiseControlDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *Ecodriving_CruiseControl) HasFreeRollingWithCruiseControlFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *Ecodriving_CruiseControl) HasFreeRollingWithCruiseControlFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CruiseControlScore = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CruiseControlPercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CruiseControlDistanceKm = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CruiseControlDistancePercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlDistanceFromAllDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_CruiseControlDistanceFromAllDistancePercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_CruiseControlDurationS = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlDurationPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_CruiseControlDurationPercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_CruiseControlFuelConsumedL = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_CruiseControlFuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlCouldBeOnDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_CruiseControlCouldBeOnDistanceKm = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlCouldBeOnDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_CruiseControlCouldBeOnDurationS = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWasOnDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_CruiseControlWasOnDistanceKm = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWasOnDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_CruiseControlWasOnDurationS = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_CruiseControlWithAcceleratorDistanceKm = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_CruiseControlWithAcceleratorDistancePercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_CruiseControlWithAcceleratorDurationS = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorDurationPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_CruiseControlWithAcceleratorDurationPercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumedL = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearCruiseControlWithAcceleratorFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearFreeRollingWithCruiseControlDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_FreeRollingWithCruiseControlDistanceKm = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearFreeRollingWithCruiseControlDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_FreeRollingWithCruiseControlDistancePercent = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearFreeRollingWithCruiseControlFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumedL = 0
+}
+
+func (x *Ecodriving_CruiseControl) ClearFreeRollingWithCruiseControlFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumptionLPer_100Km = 0
+}
+
+type Ecodriving_CruiseControl_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The cruise control score (0-100).
+	CruiseControlScore *int32
+	// The share of cruise control usage (units: %).
+	CruiseControlPercent *float32
+	// The distance driven with cruise control (units: km).
+	CruiseControlDistanceKm *float32
+	// The share of distance driven with cruise control where it could be on (units: %).
+	CruiseControlDistancePercent *float32
+	// The share of the total distance driven with cruise control (units: %).
+	CruiseControlDistanceFromAllDistancePercent *float32
+	// The duration of driving with cruise control (units: s).
+	CruiseControlDurationS *float32
+	// The share of time driven with cruise control (units: %).
+	CruiseControlDurationPercent *float32
+	// The fuel consumed with cruise control (units: l).
+	CruiseControlFuelConsumedL *float32
+	// The fuel consumption with cruise control (units: l/100km).
+	CruiseControlFuelConsumptionLPer_100Km *float32
+	// The distance where cruise control could have been on (units: km).
+	CruiseControlCouldBeOnDistanceKm *float32
+	// The duration where cruise control could have been on (units: s).
+	CruiseControlCouldBeOnDurationS *float32
+	// The distance where cruise control was on (units: km).
+	CruiseControlWasOnDistanceKm *float32
+	// The duration where cruise control was on (units: s).
+	CruiseControlWasOnDurationS *float32
+	// The distance driven with cruise control and the accelerator pressed (units: km).
+	CruiseControlWithAcceleratorDistanceKm *float32
+	// The share of distance driven with cruise control and the accelerator pressed (units: %).
+	CruiseControlWithAcceleratorDistancePercent *float32
+	// The duration of driving with cruise control and the accelerator pressed (units: s).
+	CruiseControlWithAcceleratorDurationS *float32
+	// The share of time driven with cruise control and the accelerator pressed (units: %).
+	CruiseControlWithAcceleratorDurationPercent *float32
+	// The fuel consumed with cruise control and the accelerator pressed (units: l).
+	CruiseControlWithAcceleratorFuelConsumedL *float32
+	// The fuel consumption with cruise control and the accelerator pressed (units: l/100km).
+	CruiseControlWithAcceleratorFuelConsumptionLPer_100Km *float32
+	// The distance free rolling with cruise control (units: km).
+	FreeRollingWithCruiseControlDistanceKm *float32
+	// The share of distance free rolling with cruise control (units: %).
+	FreeRollingWithCruiseControlDistancePercent *float32
+	// The fuel consumed free rolling with cruise control (units: l).
+	FreeRollingWithCruiseControlFuelConsumedL *float32
+	// The fuel consumption free rolling with cruise control (units: l/100km).
+	FreeRollingWithCruiseControlFuelConsumptionLPer_100Km *float32
+}
+
+func (b0 Ecodriving_CruiseControl_builder) Build() *Ecodriving_CruiseControl {
+	m0 := &Ecodriving_CruiseControl{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CruiseControlScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 23)
+		x.xxx_hidden_CruiseControlScore = *b.CruiseControlScore
+	}
+	if b.CruiseControlPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 23)
+		x.xxx_hidden_CruiseControlPercent = *b.CruiseControlPercent
+	}
+	if b.CruiseControlDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 23)
+		x.xxx_hidden_CruiseControlDistanceKm = *b.CruiseControlDistanceKm
+	}
+	if b.CruiseControlDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 23)
+		x.xxx_hidden_CruiseControlDistancePercent = *b.CruiseControlDistancePercent
+	}
+	if b.CruiseControlDistanceFromAllDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 23)
+		x.xxx_hidden_CruiseControlDistanceFromAllDistancePercent = *b.CruiseControlDistanceFromAllDistancePercent
+	}
+	if b.CruiseControlDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 23)
+		x.xxx_hidden_CruiseControlDurationS = *b.CruiseControlDurationS
+	}
+	if b.CruiseControlDurationPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 23)
+		x.xxx_hidden_CruiseControlDurationPercent = *b.CruiseControlDurationPercent
+	}
+	if b.CruiseControlFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 23)
+		x.xxx_hidden_CruiseControlFuelConsumedL = *b.CruiseControlFuelConsumedL
+	}
+	if b.CruiseControlFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 23)
+		x.xxx_hidden_CruiseControlFuelConsumptionLPer_100Km = *b.CruiseControlFuelConsumptionLPer_100Km
+	}
+	if b.CruiseControlCouldBeOnDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 23)
+		x.xxx_hidden_CruiseControlCouldBeOnDistanceKm = *b.CruiseControlCouldBeOnDistanceKm
+	}
+	if b.CruiseControlCouldBeOnDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 23)
+		x.xxx_hidden_CruiseControlCouldBeOnDurationS = *b.CruiseControlCouldBeOnDurationS
+	}
+	if b.CruiseControlWasOnDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 23)
+		x.xxx_hidden_CruiseControlWasOnDistanceKm = *b.CruiseControlWasOnDistanceKm
+	}
+	if b.CruiseControlWasOnDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 23)
+		x.xxx_hidden_CruiseControlWasOnDurationS = *b.CruiseControlWasOnDurationS
+	}
+	if b.CruiseControlWithAcceleratorDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorDistanceKm = *b.CruiseControlWithAcceleratorDistanceKm
+	}
+	if b.CruiseControlWithAcceleratorDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorDistancePercent = *b.CruiseControlWithAcceleratorDistancePercent
+	}
+	if b.CruiseControlWithAcceleratorDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorDurationS = *b.CruiseControlWithAcceleratorDurationS
+	}
+	if b.CruiseControlWithAcceleratorDurationPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorDurationPercent = *b.CruiseControlWithAcceleratorDurationPercent
+	}
+	if b.CruiseControlWithAcceleratorFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumedL = *b.CruiseControlWithAcceleratorFuelConsumedL
+	}
+	if b.CruiseControlWithAcceleratorFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 23)
+		x.xxx_hidden_CruiseControlWithAcceleratorFuelConsumptionLPer_100Km = *b.CruiseControlWithAcceleratorFuelConsumptionLPer_100Km
+	}
+	if b.FreeRollingWithCruiseControlDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 23)
+		x.xxx_hidden_FreeRollingWithCruiseControlDistanceKm = *b.FreeRollingWithCruiseControlDistanceKm
+	}
+	if b.FreeRollingWithCruiseControlDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 23)
+		x.xxx_hidden_FreeRollingWithCruiseControlDistancePercent = *b.FreeRollingWithCruiseControlDistancePercent
+	}
+	if b.FreeRollingWithCruiseControlFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 23)
+		x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumedL = *b.FreeRollingWithCruiseControlFuelConsumedL
+	}
+	if b.FreeRollingWithCruiseControlFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 23)
+		x.xxx_hidden_FreeRollingWithCruiseControlFuelConsumptionLPer_100Km = *b.FreeRollingWithCruiseControlFuelConsumptionLPer_100Km
+	}
+	return m0
+}
+
+// Engine parameters.
+type Ecodriving_Engine struct {
+	state                                                               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_EngineScore                                              int32                  `protobuf:"varint,1,opt,name=engine_score,json=engineScore"`
+	xxx_hidden_EngineOverloadedDistanceKm                               float32                `protobuf:"fixed32,2,opt,name=engine_overloaded_distance_km,json=engineOverloadedDistanceKm"`
+	xxx_hidden_EngineOverloadedDistancePercent                          float32                `protobuf:"fixed32,3,opt,name=engine_overloaded_distance_percent,json=engineOverloadedDistancePercent"`
+	xxx_hidden_EngineOverloadedFuelConsumedL                            float32                `protobuf:"fixed32,4,opt,name=engine_overloaded_fuel_consumed_l,json=engineOverloadedFuelConsumedL"`
+	xxx_hidden_EngineOverloadedFuelConsumptionLPer_100Km                float32                `protobuf:"fixed32,5,opt,name=engine_overloaded_fuel_consumption_l_per_100km,json=engineOverloadedFuelConsumptionLPer100km"`
+	xxx_hidden_FreeRollingDistanceKm                                    float32                `protobuf:"fixed32,6,opt,name=free_rolling_distance_km,json=freeRollingDistanceKm"`
+	xxx_hidden_FreeRollingDistancePercent                               float32                `protobuf:"fixed32,7,opt,name=free_rolling_distance_percent,json=freeRollingDistancePercent"`
+	xxx_hidden_FreeRollingWithoutCruiseControlDistanceKm                float32                `protobuf:"fixed32,8,opt,name=free_rolling_without_cruise_control_distance_km,json=freeRollingWithoutCruiseControlDistanceKm"`
+	xxx_hidden_FreeRollingWithoutCruiseControlDistancePercent           float32                `protobuf:"fixed32,9,opt,name=free_rolling_without_cruise_control_distance_percent,json=freeRollingWithoutCruiseControlDistancePercent"`
+	xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumedL             float32                `protobuf:"fixed32,10,opt,name=free_rolling_without_cruise_control_fuel_consumed_l,json=freeRollingWithoutCruiseControlFuelConsumedL"`
+	xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km float32                `protobuf:"fixed32,11,opt,name=free_rolling_without_cruise_control_fuel_consumption_l_per_100km,json=freeRollingWithoutCruiseControlFuelConsumptionLPer100km"`
+	xxx_hidden_HighestGearDistanceKm                                    float32                `protobuf:"fixed32,12,opt,name=highest_gear_distance_km,json=highestGearDistanceKm"`
+	xxx_hidden_HighestGearDistancePercent                               float32                `protobuf:"fixed32,13,opt,name=highest_gear_distance_percent,json=highestGearDistancePercent"`
+	xxx_hidden_HighestGearFuelConsumedL                                 float32                `protobuf:"fixed32,14,opt,name=highest_gear_fuel_consumed_l,json=highestGearFuelConsumedL"`
+	xxx_hidden_HighestGearFuelConsumptionLPer_100Km                     float32                `protobuf:"fixed32,15,opt,name=highest_gear_fuel_consumption_l_per_100km,json=highestGearFuelConsumptionLPer100km"`
+	xxx_hidden_MaximumRpm                                               float32                `protobuf:"fixed32,16,opt,name=maximum_rpm,json=maximumRpm"`
+	xxx_hidden_RpmInGreenBandDistanceKm                                 float32                `protobuf:"fixed32,17,opt,name=rpm_in_green_band_distance_km,json=rpmInGreenBandDistanceKm"`
+	xxx_hidden_RpmInRedBandDistanceKm                                   float32                `protobuf:"fixed32,18,opt,name=rpm_in_red_band_distance_km,json=rpmInRedBandDistanceKm"`
+	xxx_hidden_RpmInRedBandDistancePercent                              float32                `protobuf:"fixed32,19,opt,name=rpm_in_red_band_distance_percent,json=rpmInRedBandDistancePercent"`
+	xxx_hidden_RpmInRedBandDurationS                                    float32                `protobuf:"fixed32,20,opt,name=rpm_in_red_band_duration_s,json=rpmInRedBandDurationS"`
+	xxx_hidden_RpmInRedBandPercent                                      float32                `protobuf:"fixed32,21,opt,name=rpm_in_red_band_percent,json=rpmInRedBandPercent"`
+	xxx_hidden_RpmRange1DistanceKm                                      float32                `protobuf:"fixed32,22,opt,name=rpm_range1_distance_km,json=rpmRange1DistanceKm"`
+	xxx_hidden_RpmRange1DistancePercent                                 float32                `protobuf:"fixed32,23,opt,name=rpm_range1_distance_percent,json=rpmRange1DistancePercent"`
+	xxx_hidden_RpmRange1FuelConsumedL                                   float32                `protobuf:"fixed32,24,opt,name=rpm_range1_fuel_consumed_l,json=rpmRange1FuelConsumedL"`
+	xxx_hidden_RpmRange1FuelConsumptionLPer_100Km                       float32                `protobuf:"fixed32,25,opt,name=rpm_range1_fuel_consumption_l_per_100km,json=rpmRange1FuelConsumptionLPer100km"`
+	xxx_hidden_RpmRange2DistanceKm                                      float32                `protobuf:"fixed32,26,opt,name=rpm_range2_distance_km,json=rpmRange2DistanceKm"`
+	xxx_hidden_RpmRange2DistancePercent                                 float32                `protobuf:"fixed32,27,opt,name=rpm_range2_distance_percent,json=rpmRange2DistancePercent"`
+	xxx_hidden_RpmRange2FuelConsumedL                                   float32                `protobuf:"fixed32,28,opt,name=rpm_range2_fuel_consumed_l,json=rpmRange2FuelConsumedL"`
+	xxx_hidden_RpmRange2FuelConsumptionLPer_100Km                       float32                `protobuf:"fixed32,29,opt,name=rpm_range2_fuel_consumption_l_per_100km,json=rpmRange2FuelConsumptionLPer100km"`
+	xxx_hidden_RpmRange3DistanceKm                                      float32                `protobuf:"fixed32,30,opt,name=rpm_range3_distance_km,json=rpmRange3DistanceKm"`
+	xxx_hidden_RpmRange3DistancePercent                                 float32                `protobuf:"fixed32,31,opt,name=rpm_range3_distance_percent,json=rpmRange3DistancePercent"`
+	xxx_hidden_RpmRange3FuelConsumedL                                   float32                `protobuf:"fixed32,32,opt,name=rpm_range3_fuel_consumed_l,json=rpmRange3FuelConsumedL"`
+	xxx_hidden_RpmRange3FuelConsumptionLPer_100Km                       float32                `protobuf:"fixed32,33,opt,name=rpm_range3_fuel_consumption_l_per_100km,json=rpmRange3FuelConsumptionLPer100km"`
+	xxx_hidden_RpmRange4DistanceKm                                      float32                `protobuf:"fixed32,34,opt,name=rpm_range4_distance_km,json=rpmRange4DistanceKm"`
+	xxx_hidden_RpmRange4DistancePercent                                 float32                `protobuf:"fixed32,35,opt,name=rpm_range4_distance_percent,json=rpmRange4DistancePercent"`
+	xxx_hidden_RpmRange4FuelConsumedL                                   float32                `protobuf:"fixed32,36,opt,name=rpm_range4_fuel_consumed_l,json=rpmRange4FuelConsumedL"`
+	xxx_hidden_RpmRange4FuelConsumptionLPer_100Km                       float32                `protobuf:"fixed32,37,opt,name=rpm_range4_fuel_consumption_l_per_100km,json=rpmRange4FuelConsumptionLPer100km"`
+	XXX_raceDetectHookData                                              protoimpl.RaceDetectHookData
+	XXX_presence                                                        [2]uint32
+	unknownFields                                                       protoimpl.UnknownFields
+	sizeCache                                                           protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Engine) Reset() {
+	*x = Ecodriving_Engine{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Engine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Engine) ProtoMessage() {}
+
+func (x *Ecodriving_Engine) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Engine) GetEngineScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_EngineScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetEngineOverloadedDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineOverloadedDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetEngineOverloadedDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineOverloadedDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetEngineOverloadedFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineOverloadedFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetEngineOverloadedFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_EngineOverloadedFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingWithoutCruiseControlDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithoutCruiseControlDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingWithoutCruiseControlDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithoutCruiseControlDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingWithoutCruiseControlFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetFreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetHighestGearDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_HighestGearDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetHighestGearDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_HighestGearDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetHighestGearFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_HighestGearFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetHighestGearFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_HighestGearFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetMaximumRpm() float32 {
+	if x != nil {
+		return x.xxx_hidden_MaximumRpm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmInGreenBandDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmInGreenBandDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmInRedBandDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmInRedBandDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmInRedBandDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmInRedBandDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmInRedBandDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmInRedBandDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmInRedBandPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmInRedBandPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange1DistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange1DistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange1DistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange1DistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange1FuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange1FuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange1FuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange1FuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange2DistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange2DistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange2DistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange2DistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange2FuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange2FuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange2FuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange2FuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange3DistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange3DistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange3DistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange3DistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange3FuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange3FuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange3FuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange3FuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange4DistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange4DistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange4DistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange4DistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange4FuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange4FuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) GetRpmRange4FuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_RpmRange4FuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Engine) SetEngineScore(v int32) {
+	x.xxx_hidden_EngineScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 37)
+}
+
+func (x *Ecodriving_Engine) SetEngineOverloadedDistanceKm(v float32) {
+	x.xxx_hidden_EngineOverloadedDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 37)
+}
+
+func (x *Ecodriving_Engine) SetEngineOverloadedDistancePercent(v float32) {
+	x.xxx_hidden_EngineOverloadedDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 37)
+}
+
+func (x *Ecodriving_Engine) SetEngineOverloadedFuelConsumedL(v float32) {
+	x.xxx_hidden_EngineOverloadedFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 37)
+}
+
+func (x *Ecodriving_Engine) SetEngineOverloadedFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_EngineOverloadedFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingDistanceKm(v float32) {
+	x.xxx_hidden_FreeRollingDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingDistancePercent(v float32) {
+	x.xxx_hidden_FreeRollingDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingWithoutCruiseControlDistanceKm(v float32) {
+	x.xxx_hidden_FreeRollingWithoutCruiseControlDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingWithoutCruiseControlDistancePercent(v float32) {
+	x.xxx_hidden_FreeRollingWithoutCruiseControlDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingWithoutCruiseControlFuelConsumedL(v float32) {
+	x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 37)
+}
+
+func (x *Ecodriving_Engine) SetFreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 37)
+}
+
+func (x *Ecodriving_Engine) SetHighestGearDistanceKm(v float32) {
+	x.xxx_hidden_HighestGearDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 37)
+}
+
+func (x *Ecodriving_Engine) SetHighestGearDistancePercent(v float32) {
+	x.xxx_hidden_HighestGearDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 37)
+}
+
+func (x *Ecodriving_Engine) SetHighestGearFuelConsumedL(v float32) {
+	x.xxx_hidden_HighestGearFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 37)
+}
+
+func (x *Ecodriving_Engine) SetHighestGearFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_HighestGearFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 37)
+}
+
+func (x *Ecodriving_Engine) SetMaximumRpm(v float32) {
+	x.xxx_hidden_MaximumRpm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmInGreenBandDistanceKm(v float32) {
+	x.xxx_hidden_RpmInGreenBandDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmInRedBandDistanceKm(v float32) {
+	x.xxx_hidden_RpmInRedBandDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmInRedBandDistancePercent(v float32) {
+	x.xxx_hidden_RpmInRedBandDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmInRedBandDurationS(v float32) {
+	x.xxx_hidden_RpmInRedBandDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmInRedBandPercent(v float32) {
+	x.xxx_hidden_RpmInRedBandPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange1DistanceKm(v float32) {
+	x.xxx_hidden_RpmRange1DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange1DistancePercent(v float32) {
+	x.xxx_hidden_RpmRange1DistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 22, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange1FuelConsumedL(v float32) {
+	x.xxx_hidden_RpmRange1FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 23, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange1FuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_RpmRange1FuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 24, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange2DistanceKm(v float32) {
+	x.xxx_hidden_RpmRange2DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 25, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange2DistancePercent(v float32) {
+	x.xxx_hidden_RpmRange2DistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 26, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange2FuelConsumedL(v float32) {
+	x.xxx_hidden_RpmRange2FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 27, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange2FuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_RpmRange2FuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 28, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange3DistanceKm(v float32) {
+	x.xxx_hidden_RpmRange3DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 29, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange3DistancePercent(v float32) {
+	x.xxx_hidden_RpmRange3DistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 30, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange3FuelConsumedL(v float32) {
+	x.xxx_hidden_RpmRange3FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 31, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange3FuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_RpmRange3FuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 32, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange4DistanceKm(v float32) {
+	x.xxx_hidden_RpmRange4DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 33, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange4DistancePercent(v float32) {
+	x.xxx_hidden_RpmRange4DistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 34, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange4FuelConsumedL(v float32) {
+	x.xxx_hidden_RpmRange4FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 35, 37)
+}
+
+func (x *Ecodriving_Engine) SetRpmRange4FuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_RpmRange4FuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 36, 37)
+}
+
+func (x *Ecodriving_Engine) HasEngineScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Engine) HasEngineOverloadedDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Engine) HasEngineOverloadedDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Engine) HasEngineOverloadedFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Engine) HasEngineOverloadedFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingWithoutCruiseControlDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingWithoutCruiseControlDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingWithoutCruiseControlFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Ecodriving_Engine) HasFreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Ecodriving_Engine) HasHighestGearDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Ecodriving_Engine) HasHighestGearDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Ecodriving_Engine) HasHighestGearFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *Ecodriving_Engine) HasHighestGearFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *Ecodriving_Engine) HasMaximumRpm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Ecodriving_Engine) HasRpmInGreenBandDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *Ecodriving_Engine) HasRpmInRedBandDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *Ecodriving_Engine) HasRpmInRedBandDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *Ecodriving_Engine) HasRpmInRedBandDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *Ecodriving_Engine) HasRpmInRedBandPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange1DistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange1DistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 22)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange1FuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 23)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange1FuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 24)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange2DistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 25)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange2DistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 26)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange2FuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 27)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange2FuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 28)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange3DistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 29)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange3DistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 30)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange3FuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 31)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange3FuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[1]), 32)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange4DistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[1]), 33)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange4DistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[1]), 34)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange4FuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[1]), 35)
+}
+
+func (x *Ecodriving_Engine) HasRpmRange4FuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[1]), 36)
+}
+
+func (x *Ecodriving_Engine) ClearEngineScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_EngineScore = 0
+}
+
+func (x *Ecodriving_Engine) ClearEngineOverloadedDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EngineOverloadedDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearEngineOverloadedDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_EngineOverloadedDistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearEngineOverloadedFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_EngineOverloadedFuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearEngineOverloadedFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_EngineOverloadedFuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_FreeRollingDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_FreeRollingDistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingWithoutCruiseControlDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_FreeRollingWithoutCruiseControlDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingWithoutCruiseControlDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_FreeRollingWithoutCruiseControlDistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingWithoutCruiseControlFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearFreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearHighestGearDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_HighestGearDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearHighestGearDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_HighestGearDistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearHighestGearFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_HighestGearFuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearHighestGearFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_HighestGearFuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearMaximumRpm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_MaximumRpm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmInGreenBandDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_RpmInGreenBandDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmInRedBandDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_RpmInRedBandDistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmInRedBandDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_RpmInRedBandDistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmInRedBandDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_RpmInRedBandDurationS = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmInRedBandPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_RpmInRedBandPercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange1DistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_RpmRange1DistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange1DistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 22)
+	x.xxx_hidden_RpmRange1DistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange1FuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 23)
+	x.xxx_hidden_RpmRange1FuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange1FuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 24)
+	x.xxx_hidden_RpmRange1FuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange2DistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 25)
+	x.xxx_hidden_RpmRange2DistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange2DistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 26)
+	x.xxx_hidden_RpmRange2DistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange2FuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 27)
+	x.xxx_hidden_RpmRange2FuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange2FuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 28)
+	x.xxx_hidden_RpmRange2FuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange3DistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 29)
+	x.xxx_hidden_RpmRange3DistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange3DistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 30)
+	x.xxx_hidden_RpmRange3DistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange3FuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 31)
+	x.xxx_hidden_RpmRange3FuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange3FuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[1]), 32)
+	x.xxx_hidden_RpmRange3FuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange4DistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[1]), 33)
+	x.xxx_hidden_RpmRange4DistanceKm = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange4DistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[1]), 34)
+	x.xxx_hidden_RpmRange4DistancePercent = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange4FuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[1]), 35)
+	x.xxx_hidden_RpmRange4FuelConsumedL = 0
+}
+
+func (x *Ecodriving_Engine) ClearRpmRange4FuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[1]), 36)
+	x.xxx_hidden_RpmRange4FuelConsumptionLPer_100Km = 0
+}
+
+type Ecodriving_Engine_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The engine score (0-100).
+	EngineScore *int32
+	// The distance driven with the engine overloaded (units: km).
+	EngineOverloadedDistanceKm *float32
+	// The share of distance driven with the engine overloaded (units: %).
+	EngineOverloadedDistancePercent *float32
+	// The fuel consumed with the engine overloaded (units: l).
+	EngineOverloadedFuelConsumedL *float32
+	// The fuel consumption with the engine overloaded (units: l/100km).
+	EngineOverloadedFuelConsumptionLPer_100Km *float32
+	// The distance free rolling (units: km).
+	FreeRollingDistanceKm *float32
+	// The share of distance free rolling (units: %).
+	FreeRollingDistancePercent *float32
+	// The distance free rolling without cruise control (units: km).
+	FreeRollingWithoutCruiseControlDistanceKm *float32
+	// The share of distance free rolling without cruise control (units: %).
+	FreeRollingWithoutCruiseControlDistancePercent *float32
+	// The fuel consumed free rolling without cruise control (units: l).
+	FreeRollingWithoutCruiseControlFuelConsumedL *float32
+	// The fuel consumption free rolling without cruise control (units: l/100km).
+	FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km *float32
+	// The distance driven in the highest gear (units: km).
+	HighestGearDistanceKm *float32
+	// The share of distance driven in the highest gear (units: %).
+	HighestGearDistancePercent *float32
+	// The fuel consumed in the highest gear (units: l).
+	HighestGearFuelConsumedL *float32
+	// The fuel consumption in the highest gear (units: l/100km).
+	HighestGearFuelConsumptionLPer_100Km *float32
+	// The maximum engine speed (units: rpm).
+	MaximumRpm *float32
+	// The distance driven with engine speed in the green band (units: km).
+	RpmInGreenBandDistanceKm *float32
+	// The distance driven with engine speed in the red band (units: km).
+	RpmInRedBandDistanceKm *float32
+	// The share of distance driven with engine speed in the red band (units: %).
+	RpmInRedBandDistancePercent *float32
+	// The duration with engine speed in the red band (units: s).
+	RpmInRedBandDurationS *float32
+	// The share of time with engine speed in the red band (units: %).
+	RpmInRedBandPercent *float32
+	// The distance driven in engine speed range 1 (units: km).
+	RpmRange1DistanceKm *float32
+	// The share of distance driven in engine speed range 1 (units: %).
+	RpmRange1DistancePercent *float32
+	// The fuel consumed in engine speed range 1 (units: l).
+	RpmRange1FuelConsumedL *float32
+	// The fuel consumption in engine speed range 1 (units: l/100km).
+	RpmRange1FuelConsumptionLPer_100Km *float32
+	// The distance driven in engine speed range 2 (units: km).
+	RpmRange2DistanceKm *float32
+	// The share of distance driven in engine speed range 2 (units: %).
+	RpmRange2DistancePercent *float32
+	// The fuel consumed in engine speed range 2 (units: l).
+	RpmRange2FuelConsumedL *float32
+	// The fuel consumption in engine speed range 2 (units: l/100km).
+	RpmRange2FuelConsumptionLPer_100Km *float32
+	// The distance driven in engine speed range 3 (units: km).
+	RpmRange3DistanceKm *float32
+	// The share of distance driven in engine speed range 3 (units: %).
+	RpmRange3DistancePercent *float32
+	// The fuel consumed in engine speed range 3 (units: l).
+	RpmRange3FuelConsumedL *float32
+	// The fuel consumption in engine speed range 3 (units: l/100km).
+	RpmRange3FuelConsumptionLPer_100Km *float32
+	// The distance driven in engine speed range 4 (units: km).
+	RpmRange4DistanceKm *float32
+	// The share of distance driven in engine speed range 4 (units: %).
+	RpmRange4DistancePercent *float32
+	// The fuel consumed in engine speed range 4 (units: l).
+	RpmRange4FuelConsumedL *float32
+	// The fuel consumption in engine speed range 4 (units: l/100km).
+	RpmRange4FuelConsumptionLPer_100Km *float32
+}
+
+func (b0 Ecodriving_Engine_builder) Build() *Ecodriving_Engine {
+	m0 := &Ecodriving_Engine{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.EngineScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 37)
+		x.xxx_hidden_EngineScore = *b.EngineScore
+	}
+	if b.EngineOverloadedDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 37)
+		x.xxx_hidden_EngineOverloadedDistanceKm = *b.EngineOverloadedDistanceKm
+	}
+	if b.EngineOverloadedDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 37)
+		x.xxx_hidden_EngineOverloadedDistancePercent = *b.EngineOverloadedDistancePercent
+	}
+	if b.EngineOverloadedFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 37)
+		x.xxx_hidden_EngineOverloadedFuelConsumedL = *b.EngineOverloadedFuelConsumedL
+	}
+	if b.EngineOverloadedFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 37)
+		x.xxx_hidden_EngineOverloadedFuelConsumptionLPer_100Km = *b.EngineOverloadedFuelConsumptionLPer_100Km
+	}
+	if b.FreeRollingDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 37)
+		x.xxx_hidden_FreeRollingDistanceKm = *b.FreeRollingDistanceKm
+	}
+	if b.FreeRollingDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 37)
+		x.xxx_hidden_FreeRollingDistancePercent = *b.FreeRollingDistancePercent
+	}
+	if b.FreeRollingWithoutCruiseControlDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 37)
+		x.xxx_hidden_FreeRollingWithoutCruiseControlDistanceKm = *b.FreeRollingWithoutCruiseControlDistanceKm
+	}
+	if b.FreeRollingWithoutCruiseControlDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 37)
+		x.xxx_hidden_FreeRollingWithoutCruiseControlDistancePercent = *b.FreeRollingWithoutCruiseControlDistancePercent
+	}
+	if b.FreeRollingWithoutCruiseControlFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 37)
+		x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumedL = *b.FreeRollingWithoutCruiseControlFuelConsumedL
+	}
+	if b.FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 37)
+		x.xxx_hidden_FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km = *b.FreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km
+	}
+	if b.HighestGearDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 37)
+		x.xxx_hidden_HighestGearDistanceKm = *b.HighestGearDistanceKm
+	}
+	if b.HighestGearDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 37)
+		x.xxx_hidden_HighestGearDistancePercent = *b.HighestGearDistancePercent
+	}
+	if b.HighestGearFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 37)
+		x.xxx_hidden_HighestGearFuelConsumedL = *b.HighestGearFuelConsumedL
+	}
+	if b.HighestGearFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 37)
+		x.xxx_hidden_HighestGearFuelConsumptionLPer_100Km = *b.HighestGearFuelConsumptionLPer_100Km
+	}
+	if b.MaximumRpm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 37)
+		x.xxx_hidden_MaximumRpm = *b.MaximumRpm
+	}
+	if b.RpmInGreenBandDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 37)
+		x.xxx_hidden_RpmInGreenBandDistanceKm = *b.RpmInGreenBandDistanceKm
+	}
+	if b.RpmInRedBandDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 37)
+		x.xxx_hidden_RpmInRedBandDistanceKm = *b.RpmInRedBandDistanceKm
+	}
+	if b.RpmInRedBandDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 37)
+		x.xxx_hidden_RpmInRedBandDistancePercent = *b.RpmInRedBandDistancePercent
+	}
+	if b.RpmInRedBandDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 37)
+		x.xxx_hidden_RpmInRedBandDurationS = *b.RpmInRedBandDurationS
+	}
+	if b.RpmInRedBandPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 37)
+		x.xxx_hidden_RpmInRedBandPercent = *b.RpmInRedBandPercent
+	}
+	if b.RpmRange1DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 37)
+		x.xxx_hidden_RpmRange1DistanceKm = *b.RpmRange1DistanceKm
+	}
+	if b.RpmRange1DistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 22, 37)
+		x.xxx_hidden_RpmRange1DistancePercent = *b.RpmRange1DistancePercent
+	}
+	if b.RpmRange1FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 23, 37)
+		x.xxx_hidden_RpmRange1FuelConsumedL = *b.RpmRange1FuelConsumedL
+	}
+	if b.RpmRange1FuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 24, 37)
+		x.xxx_hidden_RpmRange1FuelConsumptionLPer_100Km = *b.RpmRange1FuelConsumptionLPer_100Km
+	}
+	if b.RpmRange2DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 25, 37)
+		x.xxx_hidden_RpmRange2DistanceKm = *b.RpmRange2DistanceKm
+	}
+	if b.RpmRange2DistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 26, 37)
+		x.xxx_hidden_RpmRange2DistancePercent = *b.RpmRange2DistancePercent
+	}
+	if b.RpmRange2FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 27, 37)
+		x.xxx_hidden_RpmRange2FuelConsumedL = *b.RpmRange2FuelConsumedL
+	}
+	if b.RpmRange2FuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 28, 37)
+		x.xxx_hidden_RpmRange2FuelConsumptionLPer_100Km = *b.RpmRange2FuelConsumptionLPer_100Km
+	}
+	if b.RpmRange3DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 29, 37)
+		x.xxx_hidden_RpmRange3DistanceKm = *b.RpmRange3DistanceKm
+	}
+	if b.RpmRange3DistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 30, 37)
+		x.xxx_hidden_RpmRange3DistancePercent = *b.RpmRange3DistancePercent
+	}
+	if b.RpmRange3FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 31, 37)
+		x.xxx_hidden_RpmRange3FuelConsumedL = *b.RpmRange3FuelConsumedL
+	}
+	if b.RpmRange3FuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 32, 37)
+		x.xxx_hidden_RpmRange3FuelConsumptionLPer_100Km = *b.RpmRange3FuelConsumptionLPer_100Km
+	}
+	if b.RpmRange4DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 33, 37)
+		x.xxx_hidden_RpmRange4DistanceKm = *b.RpmRange4DistanceKm
+	}
+	if b.RpmRange4DistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 34, 37)
+		x.xxx_hidden_RpmRange4DistancePercent = *b.RpmRange4DistancePercent
+	}
+	if b.RpmRange4FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 35, 37)
+		x.xxx_hidden_RpmRange4FuelConsumedL = *b.RpmRange4FuelConsumedL
+	}
+	if b.RpmRange4FuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 36, 37)
+		x.xxx_hidden_RpmRange4FuelConsumptionLPer_100Km = *b.RpmRange4FuelConsumptionLPer_100Km
+	}
+	return m0
+}
+
+// Idling parameters.
+type Ecodriving_Idling struct {
+	state                                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_IdlingScore                int32                  `protobuf:"varint,1,opt,name=idling_score,json=idlingScore"`
+	xxx_hidden_IdlingDurationS            float32                `protobuf:"fixed32,2,opt,name=idling_duration_s,json=idlingDurationS"`
+	xxx_hidden_IdlingFuelConsumedL        float32                `protobuf:"fixed32,3,opt,name=idling_fuel_consumed_l,json=idlingFuelConsumedL"`
+	xxx_hidden_ExcessIdlingPercent        float32                `protobuf:"fixed32,4,opt,name=excess_idling_percent,json=excessIdlingPercent"`
+	xxx_hidden_FuelUsedWhileIdlingPercent float32                `protobuf:"fixed32,5,opt,name=fuel_used_while_idling_percent,json=fuelUsedWhileIdlingPercent"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Idling) Reset() {
+	*x = Ecodriving_Idling{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Idling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Idling) ProtoMessage() {}
+
+func (x *Ecodriving_Idling) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Idling) GetIdlingScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_IdlingScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Idling) GetIdlingDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_IdlingDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Idling) GetIdlingFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_IdlingFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Idling) GetExcessIdlingPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_ExcessIdlingPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Idling) GetFuelUsedWhileIdlingPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_FuelUsedWhileIdlingPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Idling) SetIdlingScore(v int32) {
+	x.xxx_hidden_IdlingScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *Ecodriving_Idling) SetIdlingDurationS(v float32) {
+	x.xxx_hidden_IdlingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *Ecodriving_Idling) SetIdlingFuelConsumedL(v float32) {
+	x.xxx_hidden_IdlingFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *Ecodriving_Idling) SetExcessIdlingPercent(v float32) {
+	x.xxx_hidden_ExcessIdlingPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *Ecodriving_Idling) SetFuelUsedWhileIdlingPercent(v float32) {
+	x.xxx_hidden_FuelUsedWhileIdlingPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *Ecodriving_Idling) HasIdlingScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Idling) HasIdlingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Idling) HasIdlingFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Idling) HasExcessIdlingPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Idling) HasFuelUsedWhileIdlingPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Idling) ClearIdlingScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_IdlingScore = 0
+}
+
+func (x *Ecodriving_Idling) ClearIdlingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_IdlingDurationS = 0
+}
+
+func (x *Ecodriving_Idling) ClearIdlingFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IdlingFuelConsumedL = 0
+}
+
+func (x *Ecodriving_Idling) ClearExcessIdlingPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ExcessIdlingPercent = 0
+}
+
+func (x *Ecodriving_Idling) ClearFuelUsedWhileIdlingPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_FuelUsedWhileIdlingPercent = 0
+}
+
+type Ecodriving_Idling_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The idling score (0-100).
+	IdlingScore *int32
+	// The duration of idling (units: s).
+	IdlingDurationS *float32
+	// The fuel consumed while idling (units: l).
+	IdlingFuelConsumedL *float32
+	// The share of excess idling (units: %).
+	ExcessIdlingPercent *float32
+	// The share of fuel used while idling (units: %).
+	FuelUsedWhileIdlingPercent *float32
+}
+
+func (b0 Ecodriving_Idling_builder) Build() *Ecodriving_Idling {
+	m0 := &Ecodriving_Idling{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.IdlingScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_IdlingScore = *b.IdlingScore
+	}
+	if b.IdlingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_IdlingDurationS = *b.IdlingDurationS
+	}
+	if b.IdlingFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_IdlingFuelConsumedL = *b.IdlingFuelConsumedL
+	}
+	if b.ExcessIdlingPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ExcessIdlingPercent = *b.ExcessIdlingPercent
+	}
+	if b.FuelUsedWhileIdlingPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_FuelUsedWhileIdlingPercent = *b.FuelUsedWhileIdlingPercent
+	}
+	return m0
+}
+
+// Main parameters.
+type Ecodriving_Main struct {
+	state                                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TotalScore                         int32                  `protobuf:"varint,1,opt,name=total_score,json=totalScore"`
+	xxx_hidden_DistanceKm                         float32                `protobuf:"fixed32,2,opt,name=distance_km,json=distanceKm"`
+	xxx_hidden_DrivingDurationS                   float32                `protobuf:"fixed32,3,opt,name=driving_duration_s,json=drivingDurationS"`
+	xxx_hidden_FuelConsumedL                      float32                `protobuf:"fixed32,4,opt,name=fuel_consumed_l,json=fuelConsumedL"`
+	xxx_hidden_FuelConsumptionLPer_100Km          float32                `protobuf:"fixed32,5,opt,name=fuel_consumption_l_per_100km,json=fuelConsumptionLPer100km"`
+	xxx_hidden_FuelConsumptionByWeightLPer_100Tkm float32                `protobuf:"fixed32,6,opt,name=fuel_consumption_by_weight_l_per_100tkm,json=fuelConsumptionByWeightLPer100tkm"`
+	xxx_hidden_AverageWeightT                     float32                `protobuf:"fixed32,7,opt,name=average_weight_t,json=averageWeightT"`
+	xxx_hidden_AverageGradientPercent             float32                `protobuf:"fixed32,8,opt,name=average_gradient_percent,json=averageGradientPercent"`
+	xxx_hidden_AverageTurningAngleDeg             float32                `protobuf:"fixed32,9,opt,name=average_turning_angle_deg,json=averageTurningAngleDeg"`
+	XXX_raceDetectHookData                        protoimpl.RaceDetectHookData
+	XXX_presence                                  [1]uint32
+	unknownFields                                 protoimpl.UnknownFields
+	sizeCache                                     protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Main) Reset() {
+	*x = Ecodriving_Main{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Main) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Main) ProtoMessage() {}
+
+func (x *Ecodriving_Main) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Main) GetTotalScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_DistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetDrivingDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_DrivingDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetFuelConsumptionByWeightLPer_100Tkm() float32 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumptionByWeightLPer_100Tkm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetAverageWeightT() float32 {
+	if x != nil {
+		return x.xxx_hidden_AverageWeightT
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetAverageGradientPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_AverageGradientPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) GetAverageTurningAngleDeg() float32 {
+	if x != nil {
+		return x.xxx_hidden_AverageTurningAngleDeg
+	}
+	return 0
+}
+
+func (x *Ecodriving_Main) SetTotalScore(v int32) {
+	x.xxx_hidden_TotalScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *Ecodriving_Main) SetDistanceKm(v float32) {
+	x.xxx_hidden_DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *Ecodriving_Main) SetDrivingDurationS(v float32) {
+	x.xxx_hidden_DrivingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *Ecodriving_Main) SetFuelConsumedL(v float32) {
+	x.xxx_hidden_FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *Ecodriving_Main) SetFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_FuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *Ecodriving_Main) SetFuelConsumptionByWeightLPer_100Tkm(v float32) {
+	x.xxx_hidden_FuelConsumptionByWeightLPer_100Tkm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *Ecodriving_Main) SetAverageWeightT(v float32) {
+	x.xxx_hidden_AverageWeightT = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *Ecodriving_Main) SetAverageGradientPercent(v float32) {
+	x.xxx_hidden_AverageGradientPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *Ecodriving_Main) SetAverageTurningAngleDeg(v float32) {
+	x.xxx_hidden_AverageTurningAngleDeg = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *Ecodriving_Main) HasTotalScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Main) HasDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Main) HasDrivingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Main) HasFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Main) HasFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Main) HasFuelConsumptionByWeightLPer_100Tkm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_Main) HasAverageWeightT() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_Main) HasAverageGradientPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_Main) HasAverageTurningAngleDeg() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Ecodriving_Main) ClearTotalScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TotalScore = 0
+}
+
+func (x *Ecodriving_Main) ClearDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DistanceKm = 0
+}
+
+func (x *Ecodriving_Main) ClearDrivingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DrivingDurationS = 0
+}
+
+func (x *Ecodriving_Main) ClearFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_FuelConsumedL = 0
+}
+
+func (x *Ecodriving_Main) ClearFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_FuelConsumptionLPer_100Km = 0
+}
+
+func (x *Ecodriving_Main) ClearFuelConsumptionByWeightLPer_100Tkm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_FuelConsumptionByWeightLPer_100Tkm = 0
+}
+
+func (x *Ecodriving_Main) ClearAverageWeightT() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_AverageWeightT = 0
+}
+
+func (x *Ecodriving_Main) ClearAverageGradientPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_AverageGradientPercent = 0
+}
+
+func (x *Ecodriving_Main) ClearAverageTurningAngleDeg() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_AverageTurningAngleDeg = 0
+}
+
+type Ecodriving_Main_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The total ecodriving score (0-100).
+	TotalScore *int32
+	// The distance driven (units: km).
+	DistanceKm *float32
+	// The duration of driving (units: s).
+	DrivingDurationS *float32
+	// The fuel consumed (units: l).
+	FuelConsumedL *float32
+	// The fuel consumption (units: l/100km).
+	FuelConsumptionLPer_100Km *float32
+	// The fuel consumption per transported weight (units: l/100t·km).
+	FuelConsumptionByWeightLPer_100Tkm *float32
+	// The average weight of the vehicle (units: t).
+	AverageWeightT *float32
+	// The average road gradient (units: %).
+	AverageGradientPercent *float32
+	// The average turning angle (units: deg).
+	AverageTurningAngleDeg *float32
+}
+
+func (b0 Ecodriving_Main_builder) Build() *Ecodriving_Main {
+	m0 := &Ecodriving_Main{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TotalScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_TotalScore = *b.TotalScore
+	}
+	if b.DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_DistanceKm = *b.DistanceKm
+	}
+	if b.DrivingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_DrivingDurationS = *b.DrivingDurationS
+	}
+	if b.FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_FuelConsumedL = *b.FuelConsumedL
+	}
+	if b.FuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_FuelConsumptionLPer_100Km = *b.FuelConsumptionLPer_100Km
+	}
+	if b.FuelConsumptionByWeightLPer_100Tkm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_FuelConsumptionByWeightLPer_100Tkm = *b.FuelConsumptionByWeightLPer_100Tkm
+	}
+	if b.AverageWeightT != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_AverageWeightT = *b.AverageWeightT
+	}
+	if b.AverageGradientPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_AverageGradientPercent = *b.AverageGradientPercent
+	}
+	if b.AverageTurningAngleDeg != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_AverageTurningAngleDeg = *b.AverageTurningAngleDeg
+	}
+	return m0
+}
+
+// Speed parameters.
+type Ecodriving_Speed struct {
+	state                                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SpeedScore                            int32                  `protobuf:"varint,1,opt,name=speed_score,json=speedScore"`
+	xxx_hidden_AverageSpeedKmh                       float32                `protobuf:"fixed32,2,opt,name=average_speed_kmh,json=averageSpeedKmh"`
+	xxx_hidden_AverageDrivingSpeedKmh                float32                `protobuf:"fixed32,3,opt,name=average_driving_speed_kmh,json=averageDrivingSpeedKmh"`
+	xxx_hidden_MaximumSpeedKmh                       float32                `protobuf:"fixed32,4,opt,name=maximum_speed_kmh,json=maximumSpeedKmh"`
+	xxx_hidden_NormalSpeedDistanceKm                 float32                `protobuf:"fixed32,5,opt,name=normal_speed_distance_km,json=normalSpeedDistanceKm"`
+	xxx_hidden_OverspeedingDistanceKm                float32                `protobuf:"fixed32,6,opt,name=overspeeding_distance_km,json=overspeedingDistanceKm"`
+	xxx_hidden_OverspeedingDistancePercent           float32                `protobuf:"fixed32,7,opt,name=overspeeding_distance_percent,json=overspeedingDistancePercent"`
+	xxx_hidden_OverspeedingDurationS                 float32                `protobuf:"fixed32,8,opt,name=overspeeding_duration_s,json=overspeedingDurationS"`
+	xxx_hidden_OverspeedingPercent                   float32                `protobuf:"fixed32,9,opt,name=overspeeding_percent,json=overspeedingPercent"`
+	xxx_hidden_OverspeedingFuelConsumedL             float32                `protobuf:"fixed32,10,opt,name=overspeeding_fuel_consumed_l,json=overspeedingFuelConsumedL"`
+	xxx_hidden_OverspeedingFuelConsumptionLPer_100Km float32                `protobuf:"fixed32,11,opt,name=overspeeding_fuel_consumption_l_per_100km,json=overspeedingFuelConsumptionLPer100km"`
+	XXX_raceDetectHookData                           protoimpl.RaceDetectHookData
+	XXX_presence                                     [1]uint32
+	unknownFields                                    protoimpl.UnknownFields
+	sizeCache                                        protoimpl.SizeCache
+}
+
+func (x *Ecodriving_Speed) Reset() {
+	*x = Ecodriving_Speed{}
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ecodriving_Speed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ecodriving_Speed) ProtoMessage() {}
+
+func (x *Ecodriving_Speed) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Ecodriving_Speed) GetSpeedScore() int32 {
+	if x != nil {
+		return x.xxx_hidden_SpeedScore
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetAverageSpeedKmh() float32 {
+	if x != nil {
+		return x.xxx_hidden_AverageSpeedKmh
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetAverageDrivingSpeedKmh() float32 {
+	if x != nil {
+		return x.xxx_hidden_AverageDrivingSpeedKmh
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetMaximumSpeedKmh() float32 {
+	if x != nil {
+		return x.xxx_hidden_MaximumSpeedKmh
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetNormalSpeedDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_NormalSpeedDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingDistanceKm() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingDistanceKm
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingDistancePercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingDistancePercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingDurationS() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingDurationS
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingPercent() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingPercent
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingFuelConsumedL() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingFuelConsumedL
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) GetOverspeedingFuelConsumptionLPer_100Km() float32 {
+	if x != nil {
+		return x.xxx_hidden_OverspeedingFuelConsumptionLPer_100Km
+	}
+	return 0
+}
+
+func (x *Ecodriving_Speed) SetSpeedScore(v int32) {
+	x.xxx_hidden_SpeedScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+}
+
+func (x *Ecodriving_Speed) SetAverageSpeedKmh(v float32) {
+	x.xxx_hidden_AverageSpeedKmh = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+}
+
+func (x *Ecodriving_Speed) SetAverageDrivingSpeedKmh(v float32) {
+	x.xxx_hidden_AverageDrivingSpeedKmh = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+}
+
+func (x *Ecodriving_Speed) SetMaximumSpeedKmh(v float32) {
+	x.xxx_hidden_MaximumSpeedKmh = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+}
+
+func (x *Ecodriving_Speed) SetNormalSpeedDistanceKm(v float32) {
+	x.xxx_hidden_NormalSpeedDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingDistanceKm(v float32) {
+	x.xxx_hidden_OverspeedingDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingDistancePercent(v float32) {
+	x.xxx_hidden_OverspeedingDistancePercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingDurationS(v float32) {
+	x.xxx_hidden_OverspeedingDurationS = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingPercent(v float32) {
+	x.xxx_hidden_OverspeedingPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingFuelConsumedL(v float32) {
+	x.xxx_hidden_OverspeedingFuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
+}
+
+func (x *Ecodriving_Speed) SetOverspeedingFuelConsumptionLPer_100Km(v float32) {
+	x.xxx_hidden_OverspeedingFuelConsumptionLPer_100Km = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 11)
+}
+
+func (x *Ecodriving_Speed) HasSpeedScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Ecodriving_Speed) HasAverageSpeedKmh() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Ecodriving_Speed) HasAverageDrivingSpeedKmh() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Ecodriving_Speed) HasMaximumSpeedKmh() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Ecodriving_Speed) HasNormalSpeedDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingDistancePercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingDurationS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Ecodriving_Speed) HasOverspeedingFuelConsumptionLPer_100Km() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Ecodriving_Speed) ClearSpeedScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SpeedScore = 0
+}
+
+func (x *Ecodriving_Speed) ClearAverageSpeedKmh() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_AverageSpeedKmh = 0
+}
+
+func (x *Ecodriving_Speed) ClearAverageDrivingSpeedKmh() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_AverageDrivingSpeedKmh = 0
+}
+
+func (x *Ecodriving_Speed) ClearMaximumSpeedKmh() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaximumSpeedKmh = 0
+}
+
+func (x *Ecodriving_Speed) ClearNormalSpeedDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_NormalSpeedDistanceKm = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_OverspeedingDistanceKm = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingDistancePercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_OverspeedingDistancePercent = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingDurationS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_OverspeedingDurationS = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_OverspeedingPercent = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_OverspeedingFuelConsumedL = 0
+}
+
+func (x *Ecodriving_Speed) ClearOverspeedingFuelConsumptionLPer_100Km() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_OverspeedingFuelConsumptionLPer_100Km = 0
+}
+
+type Ecodriving_Speed_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The speed score (0-100).
+	SpeedScore *int32
+	// The average speed (units: km/h).
+	AverageSpeedKmh *float32
+	// The average speed while driving (units: km/h).
+	AverageDrivingSpeedKmh *float32
+	// The maximum speed (units: km/h).
+	MaximumSpeedKmh *float32
+	// The distance driven within the speed limit (units: km).
+	NormalSpeedDistanceKm *float32
+	// The distance driven while overspeeding (units: km).
+	OverspeedingDistanceKm *float32
+	// The share of distance driven while overspeeding (units: %).
+	OverspeedingDistancePercent *float32
+	// The duration of overspeeding (units: s).
+	OverspeedingDurationS *float32
+	// The share of time overspeeding (units: %).
+	OverspeedingPercent *float32
+	// The fuel consumed while overspeeding (units: l).
+	OverspeedingFuelConsumedL *float32
+	// The fuel consumption while overspeeding (units: l/100km).
+	OverspeedingFuelConsumptionLPer_100Km *float32
+}
+
+func (b0 Ecodriving_Speed_builder) Build() *Ecodriving_Speed {
+	m0 := &Ecodriving_Speed{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SpeedScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		x.xxx_hidden_SpeedScore = *b.SpeedScore
+	}
+	if b.AverageSpeedKmh != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		x.xxx_hidden_AverageSpeedKmh = *b.AverageSpeedKmh
+	}
+	if b.AverageDrivingSpeedKmh != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		x.xxx_hidden_AverageDrivingSpeedKmh = *b.AverageDrivingSpeedKmh
+	}
+	if b.MaximumSpeedKmh != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		x.xxx_hidden_MaximumSpeedKmh = *b.MaximumSpeedKmh
+	}
+	if b.NormalSpeedDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
+		x.xxx_hidden_NormalSpeedDistanceKm = *b.NormalSpeedDistanceKm
+	}
+	if b.OverspeedingDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
+		x.xxx_hidden_OverspeedingDistanceKm = *b.OverspeedingDistanceKm
+	}
+	if b.OverspeedingDistancePercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
+		x.xxx_hidden_OverspeedingDistancePercent = *b.OverspeedingDistancePercent
+	}
+	if b.OverspeedingDurationS != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
+		x.xxx_hidden_OverspeedingDurationS = *b.OverspeedingDurationS
+	}
+	if b.OverspeedingPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
+		x.xxx_hidden_OverspeedingPercent = *b.OverspeedingPercent
+	}
+	if b.OverspeedingFuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
+		x.xxx_hidden_OverspeedingFuelConsumedL = *b.OverspeedingFuelConsumedL
+	}
+	if b.OverspeedingFuelConsumptionLPer_100Km != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 11)
+		x.xxx_hidden_OverspeedingFuelConsumptionLPer_100Km = *b.OverspeedingFuelConsumptionLPer_100Km
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_ecodriving_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_ecodriving_proto_rawDesc = "" +
+	"\n" +
+	"2wayplatform/connect/trusttrack/v1/ecodriving.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\"\xf5P\n" +
+	"\n" +
+	"Ecodriving\x12%\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tsubjectId\x12^\n" +
+	"\facceleration\x18\x02 \x01(\v2:.wayplatform.connect.trusttrack.v1.Ecodriving.AccelerationR\facceleration\x12O\n" +
+	"\abraking\x18\x03 \x01(\v25.wayplatform.connect.trusttrack.v1.Ecodriving.BrakingR\abraking\x12U\n" +
+	"\tcornering\x18\x04 \x01(\v27.wayplatform.connect.trusttrack.v1.Ecodriving.CorneringR\tcornering\x12b\n" +
+	"\x0ecruise_control\x18\x05 \x01(\v2;.wayplatform.connect.trusttrack.v1.Ecodriving.CruiseControlR\rcruiseControl\x12L\n" +
+	"\x06engine\x18\x06 \x01(\v24.wayplatform.connect.trusttrack.v1.Ecodriving.EngineR\x06engine\x12L\n" +
+	"\x06idling\x18\a \x01(\v24.wayplatform.connect.trusttrack.v1.Ecodriving.IdlingR\x06idling\x12F\n" +
+	"\x04main\x18\b \x01(\v22.wayplatform.connect.trusttrack.v1.Ecodriving.MainR\x04main\x12I\n" +
+	"\x05speed\x18\t \x01(\v23.wayplatform.connect.trusttrack.v1.Ecodriving.SpeedR\x05speed\x1a\x9a\x05\n" +
+	"\fAcceleration\x128\n" +
+	"\x12acceleration_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x11accelerationScore\x12K\n" +
+	"\x1cbad_acceleration_event_count\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x19badAccelerationEventCount\x12[\n" +
+	"%bad_acceleration_event_rate_per_100km\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R badAccelerationEventRatePer100km\x12M\n" +
+	"\x1dgood_acceleration_event_count\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1agoodAccelerationEventCount\x12]\n" +
+	"&good_acceleration_event_rate_per_100km\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!goodAccelerationEventRatePer100km\x12O\n" +
+	"\x1eharsh_acceleration_event_count\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1bharshAccelerationEventCount\x12_\n" +
+	"'harsh_acceleration_event_rate_per_100km\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\"harshAccelerationEventRatePer100km\x12F\n" +
+	"\x19maximum_acceleration_mps2\x18\b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17maximumAccelerationMps2\x1a\x86\x0e\n" +
+	"\aBraking\x12.\n" +
+	"\rbraking_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\fbrakingScore\x12:\n" +
+	"\x13braking_distance_km\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x11brakingDistanceKm\x12D\n" +
+	"\x18braking_distance_percent\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16brakingDistancePercent\x128\n" +
+	"\x12braking_duration_s\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x10brakingDurationS\x12G\n" +
+	"\x1aengine_braking_distance_km\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17engineBrakingDistanceKm\x12Q\n" +
+	"\x1fengine_braking_distance_percent\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1cengineBrakingDistancePercent\x12G\n" +
+	"\x1anormal_braking_event_count\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17normalBrakingEventCount\x12W\n" +
+	"#normal_braking_event_rate_per_100km\x18\b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1enormalBrakingEventRatePer100km\x12K\n" +
+	"\x1cnormal_braking_stops_percent\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x19normalBrakingStopsPercent\x12E\n" +
+	"\x19harsh_braking_event_count\x18\n" +
+	" \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16harshBrakingEventCount\x12U\n" +
+	"\"harsh_braking_event_rate_per_100km\x18\v \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1dharshBrakingEventRatePer100km\x12I\n" +
+	"\x1bextreme_braking_event_count\x18\f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18extremeBrakingEventCount\x12Y\n" +
+	"$extreme_braking_event_rate_per_100km\x18\r \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1fextremeBrakingEventRatePer100km\x12<\n" +
+	"\x14maximum_braking_mps2\x18\x0e \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x12maximumBrakingMps2\x12P\n" +
+	"\x1fparking_brake_abuse_event_count\x18\x0f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1bparkingBrakeAbuseEventCount\x12U\n" +
+	"\"parking_brake_abuse_rate_per_100km\x18\x10 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1dparkingBrakeAbuseRatePer100km\x12<\n" +
+	"\x14retarder_distance_km\x18\x11 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x12retarderDistanceKm\x12F\n" +
+	"\x19retarder_distance_percent\x18\x12 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17retarderDistancePercent\x12:\n" +
+	"\x13retarder_duration_s\x18\x13 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x11retarderDurationS\x12F\n" +
+	"\x19retarder_duration_percent\x18\x14 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17retarderDurationPercent\x12g\n" +
+	"+retarder_without_cruise_control_distance_km\x18\x15 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R&retarderWithoutCruiseControlDistanceKm\x12q\n" +
+	"0retarder_without_cruise_control_distance_percent\x18\x16 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R+retarderWithoutCruiseControlDistancePercent\x126\n" +
+	"\x11stops_event_count\x18\x17 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0fstopsEventCount\x12;\n" +
+	"\x14stops_rate_per_100km\x18\x18 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x11stopsRatePer100km\x1a\xd2\x01\n" +
+	"\tCornering\x12>\n" +
+	"\x15cornering_event_count\x18\x01 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13corneringEventCount\x12C\n" +
+	"\x18cornering_rate_per_100km\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15corneringRatePer100km\x12@\n" +
+	"\x16maximum_cornering_mps2\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x14maximumCorneringMps2\x1a\xda\x11\n" +
+	"\rCruiseControl\x12;\n" +
+	"\x14cruise_control_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x12cruiseControlScore\x12@\n" +
+	"\x16cruise_control_percent\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x14cruiseControlPercent\x12G\n" +
+	"\x1acruise_control_distance_km\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x17cruiseControlDistanceKm\x12Q\n" +
+	"\x1fcruise_control_distance_percent\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1ccruiseControlDistancePercent\x12r\n" +
+	"1cruise_control_distance_from_all_distance_percent\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R+cruiseControlDistanceFromAllDistancePercent\x12E\n" +
+	"\x19cruise_control_duration_s\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16cruiseControlDurationS\x12Q\n" +
+	"\x1fcruise_control_duration_percent\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1ccruiseControlDurationPercent\x12N\n" +
+	"\x1ecruise_control_fuel_consumed_l\x18\b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1acruiseControlFuelConsumedL\x12f\n" +
+	"+cruise_control_fuel_consumption_l_per_100km\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R%cruiseControlFuelConsumptionLPer100km\x12\\\n" +
+	"&cruise_control_could_be_on_distance_km\x18\n" +
+	" \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R cruiseControlCouldBeOnDistanceKm\x12Z\n" +
+	"%cruise_control_could_be_on_duration_s\x18\v \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1fcruiseControlCouldBeOnDurationS\x12S\n" +
+	"!cruise_control_was_on_distance_km\x18\f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1ccruiseControlWasOnDistanceKm\x12Q\n" +
+	" cruise_control_was_on_duration_s\x18\r \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1bcruiseControlWasOnDurationS\x12g\n" +
+	"+cruise_control_with_accelerator_distance_km\x18\x0e \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R&cruiseControlWithAcceleratorDistanceKm\x12q\n" +
+	"0cruise_control_with_accelerator_distance_percent\x18\x0f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R+cruiseControlWithAcceleratorDistancePercent\x12e\n" +
+	"*cruise_control_with_accelerator_duration_s\x18\x10 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R%cruiseControlWithAcceleratorDurationS\x12q\n" +
+	"0cruise_control_with_accelerator_duration_percent\x18\x11 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R+cruiseControlWithAcceleratorDurationPercent\x12n\n" +
+	"/cruise_control_with_accelerator_fuel_consumed_l\x18\x12 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R)cruiseControlWithAcceleratorFuelConsumedL\x12\x86\x01\n" +
+	"<cruise_control_with_accelerator_fuel_consumption_l_per_100km\x18\x13 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R4cruiseControlWithAcceleratorFuelConsumptionLPer100km\x12h\n" +
+	",free_rolling_with_cruise_control_distance_km\x18\x14 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R&freeRollingWithCruiseControlDistanceKm\x12r\n" +
+	"1free_rolling_with_cruise_control_distance_percent\x18\x15 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R+freeRollingWithCruiseControlDistancePercent\x12o\n" +
+	"0free_rolling_with_cruise_control_fuel_consumed_l\x18\x16 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R)freeRollingWithCruiseControlFuelConsumedL\x12\x87\x01\n" +
+	"=free_rolling_with_cruise_control_fuel_consumption_l_per_100km\x18\x17 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R4freeRollingWithCruiseControlFuelConsumptionLPer100km\x1a\xda\x17\n" +
+	"\x06Engine\x12,\n" +
+	"\fengine_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\vengineScore\x12M\n" +
+	"\x1dengine_overloaded_distance_km\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1aengineOverloadedDistanceKm\x12W\n" +
+	"\"engine_overloaded_distance_percent\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1fengineOverloadedDistancePercent\x12T\n" +
+	"!engine_overloaded_fuel_consumed_l\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1dengineOverloadedFuelConsumedL\x12l\n" +
+	".engine_overloaded_fuel_consumption_l_per_100km\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R(engineOverloadedFuelConsumptionLPer100km\x12C\n" +
+	"\x18free_rolling_distance_km\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15freeRollingDistanceKm\x12M\n" +
+	"\x1dfree_rolling_distance_percent\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1afreeRollingDistancePercent\x12n\n" +
+	"/free_rolling_without_cruise_control_distance_km\x18\b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R)freeRollingWithoutCruiseControlDistanceKm\x12x\n" +
+	"4free_rolling_without_cruise_control_distance_percent\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R.freeRollingWithoutCruiseControlDistancePercent\x12u\n" +
+	"3free_rolling_without_cruise_control_fuel_consumed_l\x18\n" +
+	" \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R,freeRollingWithoutCruiseControlFuelConsumedL\x12\x8d\x01\n" +
+	"@free_rolling_without_cruise_control_fuel_consumption_l_per_100km\x18\v \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R7freeRollingWithoutCruiseControlFuelConsumptionLPer100km\x12C\n" +
+	"\x18highest_gear_distance_km\x18\f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15highestGearDistanceKm\x12M\n" +
+	"\x1dhighest_gear_distance_percent\x18\r \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1ahighestGearDistancePercent\x12J\n" +
+	"\x1chighest_gear_fuel_consumed_l\x18\x0e \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18highestGearFuelConsumedL\x12b\n" +
+	")highest_gear_fuel_consumption_l_per_100km\x18\x0f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R#highestGearFuelConsumptionLPer100km\x12+\n" +
+	"\vmaximum_rpm\x18\x10 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\n" +
+	"maximumRpm\x12K\n" +
+	"\x1drpm_in_green_band_distance_km\x18\x11 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18rpmInGreenBandDistanceKm\x12G\n" +
+	"\x1brpm_in_red_band_distance_km\x18\x12 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16rpmInRedBandDistanceKm\x12Q\n" +
+	" rpm_in_red_band_distance_percent\x18\x13 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1brpmInRedBandDistancePercent\x12E\n" +
+	"\x1arpm_in_red_band_duration_s\x18\x14 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15rpmInRedBandDurationS\x12@\n" +
+	"\x17rpm_in_red_band_percent\x18\x15 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13rpmInRedBandPercent\x12?\n" +
+	"\x16rpm_range1_distance_km\x18\x16 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13rpmRange1DistanceKm\x12I\n" +
+	"\x1brpm_range1_distance_percent\x18\x17 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18rpmRange1DistancePercent\x12F\n" +
+	"\x1arpm_range1_fuel_consumed_l\x18\x18 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16rpmRange1FuelConsumedL\x12^\n" +
+	"'rpm_range1_fuel_consumption_l_per_100km\x18\x19 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!rpmRange1FuelConsumptionLPer100km\x12?\n" +
+	"\x16rpm_range2_distance_km\x18\x1a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13rpmRange2DistanceKm\x12I\n" +
+	"\x1brpm_range2_distance_percent\x18\x1b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18rpmRange2DistancePercent\x12F\n" +
+	"\x1arpm_range2_fuel_consumed_l\x18\x1c \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16rpmRange2FuelConsumedL\x12^\n" +
+	"'rpm_range2_fuel_consumption_l_per_100km\x18\x1d \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!rpmRange2FuelConsumptionLPer100km\x12?\n" +
+	"\x16rpm_range3_distance_km\x18\x1e \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13rpmRange3DistanceKm\x12I\n" +
+	"\x1brpm_range3_distance_percent\x18\x1f \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18rpmRange3DistancePercent\x12F\n" +
+	"\x1arpm_range3_fuel_consumed_l\x18  \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16rpmRange3FuelConsumedL\x12^\n" +
+	"'rpm_range3_fuel_consumption_l_per_100km\x18! \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!rpmRange3FuelConsumptionLPer100km\x12?\n" +
+	"\x16rpm_range4_distance_km\x18\" \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13rpmRange4DistanceKm\x12I\n" +
+	"\x1brpm_range4_distance_percent\x18# \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18rpmRange4DistancePercent\x12F\n" +
+	"\x1arpm_range4_fuel_consumed_l\x18$ \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16rpmRange4FuelConsumedL\x12^\n" +
+	"'rpm_range4_fuel_consumption_l_per_100km\x18% \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!rpmRange4FuelConsumptionLPer100km\x1a\xbf\x02\n" +
+	"\x06Idling\x12,\n" +
+	"\fidling_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\vidlingScore\x126\n" +
+	"\x11idling_duration_s\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0fidlingDurationS\x12?\n" +
+	"\x16idling_fuel_consumed_l\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13idlingFuelConsumedL\x12>\n" +
+	"\x15excess_idling_percent\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13excessIdlingPercent\x12N\n" +
+	"\x1efuel_used_while_idling_percent\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1afuelUsedWhileIdlingPercent\x1a\xb0\x04\n" +
+	"\x04Main\x12*\n" +
+	"\vtotal_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\n" +
+	"totalScore\x12+\n" +
+	"\vdistance_km\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\n" +
+	"distanceKm\x128\n" +
+	"\x12driving_duration_s\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x10drivingDurationS\x122\n" +
+	"\x0ffuel_consumed_l\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\rfuelConsumedL\x12J\n" +
+	"\x1cfuel_consumption_l_per_100km\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x18fuelConsumptionLPer100km\x12^\n" +
+	"'fuel_consumption_by_weight_l_per_100tkm\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R!fuelConsumptionByWeightLPer100tkm\x124\n" +
+	"\x10average_weight_t\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0eaverageWeightT\x128\n" +
+	"\x18average_gradient_percent\x18\b \x01(\x02R\x16averageGradientPercent\x12E\n" +
+	"\x19average_turning_angle_deg\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16averageTurningAngleDeg\x1a\xfa\x05\n" +
+	"\x05Speed\x12*\n" +
+	"\vspeed_score\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\n" +
+	"speedScore\x126\n" +
+	"\x11average_speed_kmh\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0faverageSpeedKmh\x12E\n" +
+	"\x19average_driving_speed_kmh\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16averageDrivingSpeedKmh\x126\n" +
+	"\x11maximum_speed_kmh\x18\x04 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x0fmaximumSpeedKmh\x12C\n" +
+	"\x18normal_speed_distance_km\x18\x05 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15normalSpeedDistanceKm\x12D\n" +
+	"\x18overspeeding_distance_km\x18\x06 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x16overspeedingDistanceKm\x12N\n" +
+	"\x1doverspeeding_distance_percent\x18\a \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x1boverspeedingDistancePercent\x12B\n" +
+	"\x17overspeeding_duration_s\x18\b \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x15overspeedingDurationS\x12=\n" +
+	"\x14overspeeding_percent\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x13overspeedingPercent\x12K\n" +
+	"\x1coverspeeding_fuel_consumed_l\x18\n" +
+	" \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x19overspeedingFuelConsumedL\x12c\n" +
+	")overspeeding_fuel_consumption_l_per_100km\x18\v \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R$overspeedingFuelConsumptionLPer100kmB\xc2\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x0fEcodrivingProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_wayplatform_connect_trusttrack_v1_ecodriving_proto_goTypes = []any{
+	(*Ecodriving)(nil),               // 0: wayplatform.connect.trusttrack.v1.Ecodriving
+	(*Ecodriving_Acceleration)(nil),  // 1: wayplatform.connect.trusttrack.v1.Ecodriving.Acceleration
+	(*Ecodriving_Braking)(nil),       // 2: wayplatform.connect.trusttrack.v1.Ecodriving.Braking
+	(*Ecodriving_Cornering)(nil),     // 3: wayplatform.connect.trusttrack.v1.Ecodriving.Cornering
+	(*Ecodriving_CruiseControl)(nil), // 4: wayplatform.connect.trusttrack.v1.Ecodriving.CruiseControl
+	(*Ecodriving_Engine)(nil),        // 5: wayplatform.connect.trusttrack.v1.Ecodriving.Engine
+	(*Ecodriving_Idling)(nil),        // 6: wayplatform.connect.trusttrack.v1.Ecodriving.Idling
+	(*Ecodriving_Main)(nil),          // 7: wayplatform.connect.trusttrack.v1.Ecodriving.Main
+	(*Ecodriving_Speed)(nil),         // 8: wayplatform.connect.trusttrack.v1.Ecodriving.Speed
+}
+var file_wayplatform_connect_trusttrack_v1_ecodriving_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.trusttrack.v1.Ecodriving.acceleration:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Acceleration
+	2, // 1: wayplatform.connect.trusttrack.v1.Ecodriving.braking:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Braking
+	3, // 2: wayplatform.connect.trusttrack.v1.Ecodriving.cornering:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Cornering
+	4, // 3: wayplatform.connect.trusttrack.v1.Ecodriving.cruise_control:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.CruiseControl
+	5, // 4: wayplatform.connect.trusttrack.v1.Ecodriving.engine:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Engine
+	6, // 5: wayplatform.connect.trusttrack.v1.Ecodriving.idling:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Idling
+	7, // 6: wayplatform.connect.trusttrack.v1.Ecodriving.main:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Main
+	8, // 7: wayplatform.connect.trusttrack.v1.Ecodriving.speed:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving.Speed
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_ecodriving_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_ecodriving_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_ecodriving_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_ecodriving_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_ecodriving_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_ecodriving_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_ecodriving_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_ecodriving_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_ecodriving_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_ecodriving_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_ecodriving_proto_depIdxs = nil
+}
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/error_details.proto
+
+package trusttrackv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Details of an error response from the TrustTrack API.
+// Attached to errors returned by the client as connect error details.
+type ErrorDetails struct {
+	state                  protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_HttpStatus  int32                       `protobuf:"varint,1,opt,name=http_status,json=httpStatus"`
+	xxx_hidden_Message     *string                     `protobuf:"bytes,2,opt,name=message"`
+	xxx_hidden_FieldErrors *[]*ErrorDetails_FieldError `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors"`
+	xxx_hidden_Method      *string                     `protobuf:"bytes,4,opt,name=method"`
+	xxx_hidden_Path        *string                     `protobuf:"bytes,5,opt,name=path"`
+	xxx_hidden_Attempts    int32                       `protobuf:"varint,6,opt,name=attempts"`
+	xxx_hidden_RetryAfter  *durationpb.Duration        `protobuf:"bytes,7,opt,name=retry_after,json=retryAfter"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ErrorDetails) Reset() {
+	*x = ErrorDetails{}
+	mi := &file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetails) ProtoMessage() {}
+
+func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ErrorDetails) GetHttpStatus() int32 {
+	if x != nil {
+		return x.xxx_hidden_HttpStatus
+	}
+	return 0
+}
+
+func (x *ErrorDetails) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ErrorDetails) GetFieldErrors() []*ErrorDetails_FieldError {
+	if x != nil {
+		if x.xxx_hidden_FieldErrors != nil {
+			return *x.xxx_hidden_FieldErrors
+		}
+	}
+	return nil
+}
+
+func (x *ErrorDetails) GetMethod() string {
+	if x != nil {
+		if x.xxx_hidden_Method != nil {
+			return *x.xxx_hidden_Method
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ErrorDetails) GetPath() string {
+	if x != nil {
+		if x.xxx_hidden_Path != nil {
+			return *x.xxx_hidden_Path
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ErrorDetails) GetAttempts() int32 {
+	if x != nil {
+		return x.xxx_hidden_Attempts
+	}
+	return 0
+}
+
+func (x *ErrorDetails) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_RetryAfter
+	}
+	return nil
+}
+
+func (x *ErrorDetails) SetHttpStatus(v int32) {
+	x.xxx_hidden_HttpStatus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *ErrorDetails) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *ErrorDetails) SetFieldErrors(v []*ErrorDetails_FieldError) {
+	x.xxx_hidden_FieldErrors = &v
+}
+
+func (x *ErrorDetails) SetMethod(v string) {
+	x.xxx_hidden_Method = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *ErrorDetails) SetPath(v string) {
+	x.xxx_hidden_Path = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *ErrorDetails) SetAttempts(v int32) {
+	x.xxx_hidden_Attempts = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *ErrorDetails) SetRetryAfter(v *durationpb.Duration) {
+	x.xxx_hidden_RetryAfter = v
+}
+
+func (x *ErrorDetails) HasHttpStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ErrorDetails) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ErrorDetails) HasMethod() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ErrorDetails) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ErrorDetails) HasAttempts() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ErrorDetails) HasRetryAfter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RetryAfter != nil
+}
+
+func (x *ErrorDetails) ClearHttpStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_HttpStatus = 0
+}
+
+func (x *ErrorDetails) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Message = nil
+}
+
+func (x *ErrorDetails) ClearMethod() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Method = nil
+}
+
+func (x *ErrorDetails) ClearPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Path = nil
+}
+
+func (x *ErrorDetails) ClearAttempts() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Attempts = 0
+}
+
+func (x *ErrorDetails) ClearRetryAfter() {
+	x.xxx_hidden_RetryAfter = nil
+}
+
+type ErrorDetails_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The HTTP status code of the response.
+	HttpStatus *int32
+	// The error message returned by the API.
+	Message *string
+	// The field errors returned by the API.
+	FieldErrors []*ErrorDetails_FieldError
+	// The HTTP method of the request.
+	Method *string
+	// The path and query of the request, with the API key redacted.
+	Path *string
+	// The number of attempts made for the request.
+	Attempts *int32
+	// The delay requested by the Retry-After header of the response, if any.
+	RetryAfter *durationpb.Duration
+}
+
+func (b0 ErrorDetails_builder) Build() *ErrorDetails {
+	m0 := &ErrorDetails{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.HttpStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_HttpStatus = *b.HttpStatus
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Message = b.Message
+	}
+	x.xxx_hidden_FieldErrors = &b.FieldErrors
+	if b.Method != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Method = b.Method
+	}
+	if b.Path != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Path = b.Path
+	}
+	if b.Attempts != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Attempts = *b.Attempts
+	}
+	x.xxx_hidden_RetryAfter = b.RetryAfter
+	return m0
+}
+
+// An error for a field of the request.
+type ErrorDetails_FieldError struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Field       *string                `protobuf:"bytes,1,opt,name=field"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,2,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ErrorDetails_FieldError) Reset() {
+	*x = ErrorDetails_FieldError{}
+	mi := &file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetails_FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetails_FieldError) ProtoMessage() {}
+
+func (x *ErrorDetails_FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ErrorDetails_FieldError) GetField() string {
+	if x != nil {
+		if x.xxx_hidden_Field != nil {
+			return *x.xxx_hidden_Field
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ErrorDetails_FieldError) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ErrorDetails_FieldError) SetField(v string) {
+	x.xxx_hidden_Field = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ErrorDetails_FieldError) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ErrorDetails_FieldError) HasField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ErrorDetails_FieldError) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ErrorDetails_FieldError) ClearField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Field = nil
+}
+
+func (x *ErrorDetails_FieldError) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Message = nil
+}
+
+type ErrorDetails_FieldError_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The field of the request.
+	Field *string
+	// The error message for the field.
+	Message *string
+}
+
+func (b0 ErrorDetails_FieldError_builder) Build() *ErrorDetails_FieldError {
+	m0 := &ErrorDetails_FieldError{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Field != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Field = b.Field
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Message = b.Message
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_error_details_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_error_details_proto_rawDesc = "" +
+	"\n" +
+	"5wayplatform/connect/trusttrack/v1/error_details.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1egoogle/protobuf/duration.proto\"\xea\x02\n" +
+	"\fErrorDetails\x12\x1f\n" +
+	"\vhttp_status\x18\x01 \x01(\x05R\n" +
+	"httpStatus\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12]\n" +
+	"\ffield_errors\x18\x03 \x03(\v2:.wayplatform.connect.trusttrack.v1.ErrorDetails.FieldErrorR\vfieldErrors\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12:\n" +
+	"\vretry_after\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"retryAfter\x1a<\n" +
+	"\n" +
+	"FieldError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessageB\xc4\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x11ErrorDetailsProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_error_details_proto_goTypes = []any{
+	(*ErrorDetails)(nil),            // 0: wayplatform.connect.trusttrack.v1.ErrorDetails
+	(*ErrorDetails_FieldError)(nil), // 1: wayplatform.connect.trusttrack.v1.ErrorDetails.FieldError
+	(*durationpb.Duration)(nil),     // 2: google.protobuf.Duration
+}
+var file_wayplatform_connect_trusttrack_v1_error_details_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.trusttrack.v1.ErrorDetails.field_errors:type_name -> wayplatform.connect.trusttrack.v1.ErrorDetails.FieldError
+	2, // 1: wayplatform.connect.trusttrack.v1.ErrorDetails.retry_after:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_error_details_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_error_details_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_error_details_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_error_details_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_error_details_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_error_details_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_error_details_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_error_details_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_error_details_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_error_details_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_error_details_proto_depIdxs = nil
+}
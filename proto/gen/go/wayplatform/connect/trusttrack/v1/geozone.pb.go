@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/geozone.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a geozone type.
+type Geozone_Type int32
+
+const (
+	Geozone_TYPE_UNSPECIFIED   Geozone_Type = 0
+	Geozone_TYPE_UNKNOWN       Geozone_Type = 1
+	Geozone_TYPE_NOT_AVAILABLE Geozone_Type = 2
+	Geozone_POINT              Geozone_Type = 3
+	Geozone_POLYGON            Geozone_Type = 4
+)
+
+// Enum value maps for Geozone_Type.
+var (
+	Geozone_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNKNOWN",
+		2: "TYPE_NOT_AVAILABLE",
+		3: "POINT",
+		4: "POLYGON",
+	}
+	Geozone_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"TYPE_UNKNOWN":       1,
+		"TYPE_NOT_AVAILABLE": 2,
+		"POINT":              3,
+		"POLYGON":            4,
+	}
+)
+
+func (x Geozone_Type) Enum() *Geozone_Type {
+	p := new(Geozone_Type)
+	*p = x
+	return p
+}
+
+func (x Geozone_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Geozone_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_geozone_proto_enumTypes[0].Descriptor()
+}
+
+func (Geozone_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_geozone_proto_enumTypes[0]
+}
+
+func (x Geozone_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// A geozone.
+type Geozone struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Notes       *string                `protobuf:"bytes,3,opt,name=notes"`
+	xxx_hidden_Type        Geozone_Type           `protobuf:"varint,4,opt,name=type,enum=wayplatform.connect.trusttrack.v1.Geozone_Type"`
+	xxx_hidden_UnknownType *string                `protobuf:"bytes,5,opt,name=unknown_type,json=unknownType"`
+	xxx_hidden_Circle      *Geozone_Circle        `protobuf:"bytes,6,opt,name=circle"`
+	xxx_hidden_Polygon     *Geozone_Polygon       `protobuf:"bytes,7,opt,name=polygon"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Geozone) Reset() {
+	*x = Geozone{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geozone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geozone) ProtoMessage() {}
+
+func (x *Geozone) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Geozone) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Geozone) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Geozone) GetNotes() string {
+	if x != nil {
+		if x.xxx_hidden_Notes != nil {
+			return *x.xxx_hidden_Notes
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Geozone) GetType() Geozone_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return Geozone_TYPE_UNSPECIFIED
+}
+
+func (x *Geozone) GetUnknownType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownType != nil {
+			return *x.xxx_hidden_UnknownType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Geozone) GetCircle() *Geozone_Circle {
+	if x != nil {
+		return x.xxx_hidden_Circle
+	}
+	return nil
+}
+
+func (x *Geozone) GetPolygon() *Geozone_Polygon {
+	if x != nil {
+		return x.xxx_hidden_Polygon
+	}
+	return nil
+}
+
+func (x *Geozone) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *Geozone) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *Geozone) SetNotes(v string) {
+	x.xxx_hidden_Notes = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *Geozone) SetType(v Geozone_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *Geozone) SetUnknownType(v string) {
+	x.xxx_hidden_UnknownType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *Geozone) SetCircle(v *Geozone_Circle) {
+	x.xxx_hidden_Circle = v
+}
+
+func (x *Geozone) SetPolygon(v *Geozone_Polygon) {
+	x.xxx_hidden_Polygon = v
+}
+
+func (x *Geozone) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Geozone) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Geozone) HasNotes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Geozone) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Geozone) HasUnknownType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Geozone) HasCircle() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Circle != nil
+}
+
+func (x *Geozone) HasPolygon() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Polygon != nil
+}
+
+func (x *Geozone) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Geozone) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Geozone) ClearNotes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Notes = nil
+}
+
+func (x *Geozone) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Type = Geozone_TYPE_UNSPECIFIED
+}
+
+func (x *Geozone) ClearUnknownType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnknownType = nil
+}
+
+func (x *Geozone) ClearCircle() {
+	x.xxx_hidden_Circle = nil
+}
+
+func (x *Geozone) ClearPolygon() {
+	x.xxx_hidden_Polygon = nil
+}
+
+type Geozone_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the geozone.
+	Id *string
+	// The name of the geozone.
+	Name *string
+	// Notes about the geozone.
+	Notes *string
+	// The type of geozone.
+	Type *Geozone_Type
+	// The unknown type of geozone.
+	// This field is used when the type is TYPE_UNKNOWN.
+	UnknownType *string
+	// The circle geometry of the geozone.
+	// Set for point geozones when geometry is requested.
+	Circle *Geozone_Circle
+	// The polygon geometry of the geozone.
+	// Set for polygon geozones when geometry is requested.
+	Polygon *Geozone_Polygon
+}
+
+func (b0 Geozone_builder) Build() *Geozone {
+	m0 := &Geozone{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Notes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Notes = b.Notes
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnknownType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_UnknownType = b.UnknownType
+	}
+	x.xxx_hidden_Circle = b.Circle
+	x.xxx_hidden_Polygon = b.Polygon
+	return m0
+}
+
+// A circle with a center point and a radius.
+type Geozone_Circle struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Center      *Geozone_Point         `protobuf:"bytes,1,opt,name=center"`
+	xxx_hidden_RadiusM     float64                `protobuf:"fixed64,2,opt,name=radius_m,json=radiusM"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Geozone_Circle) Reset() {
+	*x = Geozone_Circle{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geozone_Circle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geozone_Circle) ProtoMessage() {}
+
+func (x *Geozone_Circle) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Geozone_Circle) GetCenter() *Geozone_Point {
+	if x != nil {
+		return x.xxx_hidden_Center
+	}
+	return nil
+}
+
+func (x *Geozone_Circle) GetRadiusM() float64 {
+	if x != nil {
+		return x.xxx_hidden_RadiusM
+	}
+	return 0
+}
+
+func (x *Geozone_Circle) SetCenter(v *Geozone_Point) {
+	x.xxx_hidden_Center = v
+}
+
+func (x *Geozone_Circle) SetRadiusM(v float64) {
+	x.xxx_hidden_RadiusM = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *Geozone_Circle) HasCenter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Center != nil
+}
+
+func (x *Geozone_Circle) HasRadiusM() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Geozone_Circle) ClearCenter() {
+	x.xxx_hidden_Center = nil
+}
+
+func (x *Geozone_Circle) ClearRadiusM() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_RadiusM = 0
+}
+
+type Geozone_Circle_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The center point of the circle.
+	Center *Geozone_Point
+	// The radius of the circle in meters.
+	RadiusM *float64
+}
+
+func (b0 Geozone_Circle_builder) Build() *Geozone_Circle {
+	m0 := &Geozone_Circle{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Center = b.Center
+	if b.RadiusM != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_RadiusM = *b.RadiusM
+	}
+	return m0
+}
+
+// A polygon made of one or more linear rings.
+type Geozone_Polygon struct {
+	state            protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Rings *[]*Geozone_Polygon_Ring `protobuf:"bytes,1,rep,name=rings"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Geozone_Polygon) Reset() {
+	*x = Geozone_Polygon{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geozone_Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geozone_Polygon) ProtoMessage() {}
+
+func (x *Geozone_Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Geozone_Polygon) GetRings() []*Geozone_Polygon_Ring {
+	if x != nil {
+		if x.xxx_hidden_Rings != nil {
+			return *x.xxx_hidden_Rings
+		}
+	}
+	return nil
+}
+
+func (x *Geozone_Polygon) SetRings(v []*Geozone_Polygon_Ring) {
+	x.xxx_hidden_Rings = &v
+}
+
+type Geozone_Polygon_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The rings of the polygon.
+	// The first ring is the exterior boundary, any following rings are holes.
+	Rings []*Geozone_Polygon_Ring
+}
+
+func (b0 Geozone_Polygon_builder) Build() *Geozone_Polygon {
+	m0 := &Geozone_Polygon{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Rings = &b.Rings
+	return m0
+}
+
+// A geographic point.
+type Geozone_Point struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Latitude    float64                `protobuf:"fixed64,1,opt,name=latitude"`
+	xxx_hidden_Longitude   float64                `protobuf:"fixed64,2,opt,name=longitude"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Geozone_Point) Reset() {
+	*x = Geozone_Point{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geozone_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geozone_Point) ProtoMessage() {}
+
+func (x *Geozone_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Geozone_Point) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *Geozone_Point) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *Geozone_Point) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *Geozone_Point) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *Geozone_Point) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Geozone_Point) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Geozone_Point) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *Geozone_Point) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Longitude = 0
+}
+
+type Geozone_Point_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The latitude of the point.
+	Latitude *float64
+	// The longitude of the point.
+	Longitude *float64
+}
+
+func (b0 Geozone_Point_builder) Build() *Geozone_Point {
+	m0 := &Geozone_Point{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	return m0
+}
+
+// A closed linear ring of points.
+type Geozone_Polygon_Ring struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Points *[]*Geozone_Point      `protobuf:"bytes,1,rep,name=points"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Geozone_Polygon_Ring) Reset() {
+	*x = Geozone_Polygon_Ring{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geozone_Polygon_Ring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geozone_Polygon_Ring) ProtoMessage() {}
+
+func (x *Geozone_Polygon_Ring) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Geozone_Polygon_Ring) GetPoints() []*Geozone_Point {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *Geozone_Polygon_Ring) SetPoints(v []*Geozone_Point) {
+	x.xxx_hidden_Points = &v
+}
+
+type Geozone_Polygon_Ring_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The points of the ring.
+	Points []*Geozone_Point
+}
+
+func (b0 Geozone_Polygon_Ring_builder) Build() *Geozone_Polygon_Ring {
+	m0 := &Geozone_Polygon_Ring{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Points = &b.Points
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_geozone_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_geozone_proto_rawDesc = "" +
+	"\n" +
+	"/wayplatform/connect/trusttrack/v1/geozone.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\"\xd9\x06\n" +
+	"\aGeozone\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12O\n" +
+	"\x04type\x18\x04 \x01(\x0e2/.wayplatform.connect.trusttrack.v1.Geozone.TypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12!\n" +
+	"\funknown_type\x18\x05 \x01(\tR\vunknownType\x12I\n" +
+	"\x06circle\x18\x06 \x01(\v21.wayplatform.connect.trusttrack.v1.Geozone.CircleR\x06circle\x12L\n" +
+	"\apolygon\x18\a \x01(\v22.wayplatform.connect.trusttrack.v1.Geozone.PolygonR\apolygon\x1a}\n" +
+	"\x06Circle\x12H\n" +
+	"\x06center\x18\x01 \x01(\v20.wayplatform.connect.trusttrack.v1.Geozone.PointR\x06center\x12)\n" +
+	"\bradius_m\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\aradiusM\x1a\xaa\x01\n" +
+	"\aPolygon\x12M\n" +
+	"\x05rings\x18\x01 \x03(\v27.wayplatform.connect.trusttrack.v1.Geozone.Polygon.RingR\x05rings\x1aP\n" +
+	"\x04Ring\x12H\n" +
+	"\x06points\x18\x01 \x03(\v20.wayplatform.connect.trusttrack.v1.Geozone.PointR\x06points\x1as\n" +
+	"\x05Point\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\"^\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x01\x12\x16\n" +
+	"\x12TYPE_NOT_AVAILABLE\x10\x02\x12\t\n" +
+	"\x05POINT\x10\x03\x12\v\n" +
+	"\aPOLYGON\x10\x04B\xbf\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\fGeozoneProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_geozone_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_wayplatform_connect_trusttrack_v1_geozone_proto_goTypes = []any{
+	(Geozone_Type)(0),            // 0: wayplatform.connect.trusttrack.v1.Geozone.Type
+	(*Geozone)(nil),              // 1: wayplatform.connect.trusttrack.v1.Geozone
+	(*Geozone_Circle)(nil),       // 2: wayplatform.connect.trusttrack.v1.Geozone.Circle
+	(*Geozone_Polygon)(nil),      // 3: wayplatform.connect.trusttrack.v1.Geozone.Polygon
+	(*Geozone_Point)(nil),        // 4: wayplatform.connect.trusttrack.v1.Geozone.Point
+	(*Geozone_Polygon_Ring)(nil), // 5: wayplatform.connect.trusttrack.v1.Geozone.Polygon.Ring
+}
+var file_wayplatform_connect_trusttrack_v1_geozone_proto_depIdxs = []int32{
+	0, // 0: wayplatform.connect.trusttrack.v1.Geozone.type:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Type
+	2, // 1: wayplatform.connect.trusttrack.v1.Geozone.circle:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Circle
+	3, // 2: wayplatform.connect.trusttrack.v1.Geozone.polygon:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Polygon
+	4, // 3: wayplatform.connect.trusttrack.v1.Geozone.Circle.center:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Point
+	5, // 4: wayplatform.connect.trusttrack.v1.Geozone.Polygon.rings:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Polygon.Ring
+	4, // 5: wayplatform.connect.trusttrack.v1.Geozone.Polygon.Ring.points:type_name -> wayplatform.connect.trusttrack.v1.Geozone.Point
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_geozone_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_geozone_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_geozone_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_geozone_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_geozone_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_geozone_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_geozone_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_geozone_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_geozone_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_geozone_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_geozone_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_geozone_proto_depIdxs = nil
+}
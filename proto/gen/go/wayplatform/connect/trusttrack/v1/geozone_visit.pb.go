@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/geozone_visit.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents the direction of a geozone event.
+type GeozoneVisit_Event_Direction int32
+
+const (
+	GeozoneVisit_Event_DIRECTION_UNSPECIFIED GeozoneVisit_Event_Direction = 0
+	GeozoneVisit_Event_DIRECTION_UNKNOWN     GeozoneVisit_Event_Direction = 1
+	GeozoneVisit_Event_ENTER                 GeozoneVisit_Event_Direction = 2
+	GeozoneVisit_Event_EXIT                  GeozoneVisit_Event_Direction = 3
+)
+
+// Enum value maps for GeozoneVisit_Event_Direction.
+var (
+	GeozoneVisit_Event_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "DIRECTION_UNKNOWN",
+		2: "ENTER",
+		3: "EXIT",
+	}
+	GeozoneVisit_Event_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"DIRECTION_UNKNOWN":     1,
+		"ENTER":                 2,
+		"EXIT":                  3,
+	}
+)
+
+func (x GeozoneVisit_Event_Direction) Enum() *GeozoneVisit_Event_Direction {
+	p := new(GeozoneVisit_Event_Direction)
+	*p = x
+	return p
+}
+
+func (x GeozoneVisit_Event_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GeozoneVisit_Event_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_enumTypes[0].Descriptor()
+}
+
+func (GeozoneVisit_Event_Direction) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_enumTypes[0]
+}
+
+func (x GeozoneVisit_Event_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// The visits of an object to a geozone.
+type GeozoneVisit struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_GeozoneId   *string                `protobuf:"bytes,2,opt,name=geozone_id,json=geozoneId"`
+	xxx_hidden_Events      *[]*GeozoneVisit_Event `protobuf:"bytes,3,rep,name=events"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GeozoneVisit) Reset() {
+	*x = GeozoneVisit{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeozoneVisit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeozoneVisit) ProtoMessage() {}
+
+func (x *GeozoneVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GeozoneVisit) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GeozoneVisit) GetGeozoneId() string {
+	if x != nil {
+		if x.xxx_hidden_GeozoneId != nil {
+			return *x.xxx_hidden_GeozoneId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GeozoneVisit) GetEvents() []*GeozoneVisit_Event {
+	if x != nil {
+		if x.xxx_hidden_Events != nil {
+			return *x.xxx_hidden_Events
+		}
+	}
+	return nil
+}
+
+func (x *GeozoneVisit) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *GeozoneVisit) SetGeozoneId(v string) {
+	x.xxx_hidden_GeozoneId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *GeozoneVisit) SetEvents(v []*GeozoneVisit_Event) {
+	x.xxx_hidden_Events = &v
+}
+
+func (x *GeozoneVisit) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GeozoneVisit) HasGeozoneId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GeozoneVisit) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *GeozoneVisit) ClearGeozoneId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_GeozoneId = nil
+}
+
+type GeozoneVisit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object that visited the geozone.
+	ObjectId *string
+	// The ID of the visited geozone.
+	GeozoneId *string
+	// The geozone enter and exit events, in chronological order.
+	Events []*GeozoneVisit_Event
+}
+
+func (b0 GeozoneVisit_builder) Build() *GeozoneVisit {
+	m0 := &GeozoneVisit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.GeozoneId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_GeozoneId = b.GeozoneId
+	}
+	x.xxx_hidden_Events = &b.Events
+	return m0
+}
+
+// A geozone enter or exit event.
+type GeozoneVisit_Event struct {
+	state                       protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Time             *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=time"`
+	xxx_hidden_Direction        GeozoneVisit_Event_Direction `protobuf:"varint,2,opt,name=direction,enum=wayplatform.connect.trusttrack.v1.GeozoneVisit_Event_Direction"`
+	xxx_hidden_UnknownDirection *string                      `protobuf:"bytes,3,opt,name=unknown_direction,json=unknownDirection"`
+	xxx_hidden_Latitude         float64                      `protobuf:"fixed64,4,opt,name=latitude"`
+	xxx_hidden_Longitude        float64                      `protobuf:"fixed64,5,opt,name=longitude"`
+	xxx_hidden_MileageKm        float64                      `protobuf:"fixed64,6,opt,name=mileage_km,json=mileageKm"`
+	xxx_hidden_FuelConsumedL    float64                      `protobuf:"fixed64,7,opt,name=fuel_consumed_l,json=fuelConsumedL"`
+	xxx_hidden_FuelLevelPercent float64                      `protobuf:"fixed64,8,opt,name=fuel_level_percent,json=fuelLevelPercent"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *GeozoneVisit_Event) Reset() {
+	*x = GeozoneVisit_Event{}
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeozoneVisit_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeozoneVisit_Event) ProtoMessage() {}
+
+func (x *GeozoneVisit_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GeozoneVisit_Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *GeozoneVisit_Event) GetDirection() GeozoneVisit_Event_Direction {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Direction
+		}
+	}
+	return GeozoneVisit_Event_DIRECTION_UNSPECIFIED
+}
+
+func (x *GeozoneVisit_Event) GetUnknownDirection() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownDirection != nil {
+			return *x.xxx_hidden_UnknownDirection
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GeozoneVisit_Event) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *GeozoneVisit_Event) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *GeozoneVisit_Event) GetMileageKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_MileageKm
+	}
+	return 0
+}
+
+func (x *GeozoneVisit_Event) GetFuelConsumedL() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelConsumedL
+	}
+	return 0
+}
+
+func (x *GeozoneVisit_Event) GetFuelLevelPercent() float64 {
+	if x != nil {
+		return x.xxx_hidden_FuelLevelPercent
+	}
+	return 0
+}
+
+func (x *GeozoneVisit_Event) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *GeozoneVisit_Event) SetDirection(v GeozoneVisit_Event_Direction) {
+	x.xxx_hidden_Direction = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *GeozoneVisit_Event) SetUnknownDirection(v string) {
+	x.xxx_hidden_UnknownDirection = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *GeozoneVisit_Event) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *GeozoneVisit_Event) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+}
+
+func (x *GeozoneVisit_Event) SetMileageKm(v float64) {
+	x.xxx_hidden_MileageKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *GeozoneVisit_Event) SetFuelConsumedL(v float64) {
+	x.xxx_hidden_FuelConsumedL = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *GeozoneVisit_Event) SetFuelLevelPercent(v float64) {
+	x.xxx_hidden_FuelLevelPercent = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *GeozoneVisit_Event) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *GeozoneVisit_Event) HasDirection() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GeozoneVisit_Event) HasUnknownDirection() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GeozoneVisit_Event) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GeozoneVisit_Event) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GeozoneVisit_Event) HasMileageKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *GeozoneVisit_Event) HasFuelConsumedL() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GeozoneVisit_Event) HasFuelLevelPercent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *GeozoneVisit_Event) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *GeozoneVisit_Event) ClearDirection() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Direction = GeozoneVisit_Event_DIRECTION_UNSPECIFIED
+}
+
+func (x *GeozoneVisit_Event) ClearUnknownDirection() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnknownDirection = nil
+}
+
+func (x *GeozoneVisit_Event) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *GeozoneVisit_Event) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Longitude = 0
+}
+
+func (x *GeozoneVisit_Event) ClearMileageKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MileageKm = 0
+}
+
+func (x *GeozoneVisit_Event) ClearFuelConsumedL() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_FuelConsumedL = 0
+}
+
+func (x *GeozoneVisit_Event) ClearFuelLevelPercent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_FuelLevelPercent = 0
+}
+
+type GeozoneVisit_Event_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The time of the event.
+	Time *timestamppb.Timestamp
+	// The direction of the event.
+	Direction *GeozoneVisit_Event_Direction
+	// The unknown direction of the event.
+	// This field is used when the direction is DIRECTION_UNKNOWN.
+	UnknownDirection *string
+	// The latitude coordinate where the event occurred.
+	Latitude *float64
+	// The longitude coordinate where the event occurred.
+	Longitude *float64
+	// The mileage of the object at the time of the event (units: km)
+	MileageKm *float64
+	// The fuel consumed by the object at the time of the event (units: l)
+	FuelConsumedL *float64
+	// The fuel level of the object at the time of the event (units: %)
+	FuelLevelPercent *float64
+}
+
+func (b0 GeozoneVisit_Event_builder) Build() *GeozoneVisit_Event {
+	m0 := &GeozoneVisit_Event{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Time = b.Time
+	if b.Direction != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Direction = *b.Direction
+	}
+	if b.UnknownDirection != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_UnknownDirection = b.UnknownDirection
+	}
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	if b.MileageKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_MileageKm = *b.MileageKm
+	}
+	if b.FuelConsumedL != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_FuelConsumedL = *b.FuelConsumedL
+	}
+	if b.FuelLevelPercent != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_FuelLevelPercent = *b.FuelLevelPercent
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_geozone_visit_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_rawDesc = "" +
+	"\n" +
+	"5wayplatform/connect/trusttrack/v1/geozone_visit.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x05\n" +
+	"\fGeozoneVisit\x12(\n" +
+	"\tobject_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\xb0\x01\x01R\bobjectId\x12%\n" +
+	"\n" +
+	"geozone_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tgeozoneId\x12M\n" +
+	"\x06events\x18\x03 \x03(\v25.wayplatform.connect.trusttrack.v1.GeozoneVisit.EventR\x06events\x1a\xcd\x04\n" +
+	"\x05Event\x12;\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\x04time\x12l\n" +
+	"\tdirection\x18\x02 \x01(\x0e2?.wayplatform.connect.trusttrack.v1.GeozoneVisit.Event.DirectionB\r\xbaH\n" +
+	"\xc8\x01\x01\x82\x01\x04\x10\x01 \x00R\tdirection\x12+\n" +
+	"\x11unknown_direction\x18\x03 \x01(\tR\x10unknownDirection\x123\n" +
+	"\blatitude\x18\x04 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x12-\n" +
+	"\n" +
+	"mileage_km\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tmileageKm\x126\n" +
+	"\x0ffuel_consumed_l\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rfuelConsumedL\x12E\n" +
+	"\x12fuel_level_percent\x18\b \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\x10fuelLevelPercent\"R\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DIRECTION_UNKNOWN\x10\x01\x12\t\n" +
+	"\x05ENTER\x10\x02\x12\b\n" +
+	"\x04EXIT\x10\x03B\xc4\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x11GeozoneVisitProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_goTypes = []any{
+	(GeozoneVisit_Event_Direction)(0), // 0: wayplatform.connect.trusttrack.v1.GeozoneVisit.Event.Direction
+	(*GeozoneVisit)(nil),              // 1: wayplatform.connect.trusttrack.v1.GeozoneVisit
+	(*GeozoneVisit_Event)(nil),        // 2: wayplatform.connect.trusttrack.v1.GeozoneVisit.Event
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_depIdxs = []int32{
+	2, // 0: wayplatform.connect.trusttrack.v1.GeozoneVisit.events:type_name -> wayplatform.connect.trusttrack.v1.GeozoneVisit.Event
+	3, // 1: wayplatform.connect.trusttrack.v1.GeozoneVisit.Event.time:type_name -> google.protobuf.Timestamp
+	0, // 2: wayplatform.connect.trusttrack.v1.GeozoneVisit.Event.direction:type_name -> wayplatform.connect.trusttrack.v1.GeozoneVisit.Event.Direction
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_geozone_visit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_geozone_visit_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_depIdxs = nil
+}
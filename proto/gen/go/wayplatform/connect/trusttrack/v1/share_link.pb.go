@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/share_link.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A share link, giving access to the live location of objects without logging in.
+type ShareLink struct {
+	state                    protoimpl.MessageState     `protogen:"opaque.v1"`
+	xxx_hidden_Id            *string                    `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Domain        *string                    `protobuf:"bytes,2,opt,name=domain"`
+	xxx_hidden_Objects       *[]*ShareLink_SharedObject `protobuf:"bytes,3,rep,name=objects"`
+	xxx_hidden_ValidFromTime *timestamppb.Timestamp     `protobuf:"bytes,4,opt,name=valid_from_time,json=validFromTime"`
+	xxx_hidden_ExpireTime    *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=expire_time,json=expireTime"`
+	xxx_hidden_CreateTime    *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=create_time,json=createTime"`
+	xxx_hidden_UpdateTime    *timestamppb.Timestamp     `protobuf:"bytes,7,opt,name=update_time,json=updateTime"`
+	xxx_hidden_CreatorUserId *string                    `protobuf:"bytes,8,opt,name=creator_user_id,json=creatorUserId"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ShareLink) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShareLink) GetDomain() string {
+	if x != nil {
+		if x.xxx_hidden_Domain != nil {
+			return *x.xxx_hidden_Domain
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShareLink) GetObjects() []*ShareLink_SharedObject {
+	if x != nil {
+		if x.xxx_hidden_Objects != nil {
+			return *x.xxx_hidden_Objects
+		}
+	}
+	return nil
+}
+
+func (x *ShareLink) GetValidFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ValidFromTime
+	}
+	return nil
+}
+
+func (x *ShareLink) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpireTime
+	}
+	return nil
+}
+
+func (x *ShareLink) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreateTime
+	}
+	return nil
+}
+
+func (x *ShareLink) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdateTime
+	}
+	return nil
+}
+
+func (x *ShareLink) GetCreatorUserId() string {
+	if x != nil {
+		if x.xxx_hidden_CreatorUserId != nil {
+			return *x.xxx_hidden_CreatorUserId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShareLink) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *ShareLink) SetDomain(v string) {
+	x.xxx_hidden_Domain = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *ShareLink) SetObjects(v []*ShareLink_SharedObject) {
+	x.xxx_hidden_Objects = &v
+}
+
+func (x *ShareLink) SetValidFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ValidFromTime = v
+}
+
+func (x *ShareLink) SetExpireTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpireTime = v
+}
+
+func (x *ShareLink) SetCreateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateTime = v
+}
+
+func (x *ShareLink) SetUpdateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdateTime = v
+}
+
+func (x *ShareLink) SetCreatorUserId(v string) {
+	x.xxx_hidden_CreatorUserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *ShareLink) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ShareLink) HasDomain() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ShareLink) HasValidFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ValidFromTime != nil
+}
+
+func (x *ShareLink) HasExpireTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpireTime != nil
+}
+
+func (x *ShareLink) HasCreateTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreateTime != nil
+}
+
+func (x *ShareLink) HasUpdateTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdateTime != nil
+}
+
+func (x *ShareLink) HasCreatorUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *ShareLink) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ShareLink) ClearDomain() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Domain = nil
+}
+
+func (x *ShareLink) ClearValidFromTime() {
+	x.xxx_hidden_ValidFromTime = nil
+}
+
+func (x *ShareLink) ClearExpireTime() {
+	x.xxx_hidden_ExpireTime = nil
+}
+
+func (x *ShareLink) ClearCreateTime() {
+	x.xxx_hidden_CreateTime = nil
+}
+
+func (x *ShareLink) ClearUpdateTime() {
+	x.xxx_hidden_UpdateTime = nil
+}
+
+func (x *ShareLink) ClearCreatorUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_CreatorUserId = nil
+}
+
+type ShareLink_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the share link.
+	Id *string
+	// The domain the share link is served from.
+	Domain *string
+	// The objects shared by the link.
+	Objects []*ShareLink_SharedObject
+	// The time the share link becomes valid.
+	ValidFromTime *timestamppb.Timestamp
+	// The time the share link expires.
+	ExpireTime *timestamppb.Timestamp
+	// The time the share link was created.
+	CreateTime *timestamppb.Timestamp
+	// The time the share link was last updated.
+	UpdateTime *timestamppb.Timestamp
+	// The ID of the user that created the share link.
+	CreatorUserId *string
+}
+
+func (b0 ShareLink_builder) Build() *ShareLink {
+	m0 := &ShareLink{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Domain != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Domain = b.Domain
+	}
+	x.xxx_hidden_Objects = &b.Objects
+	x.xxx_hidden_ValidFromTime = b.ValidFromTime
+	x.xxx_hidden_ExpireTime = b.ExpireTime
+	x.xxx_hidden_CreateTime = b.CreateTime
+	x.xxx_hidden_UpdateTime = b.UpdateTime
+	if b.CreatorUserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_CreatorUserId = b.CreatorUserId
+	}
+	return m0
+}
+
+// An object shared by a share link.
+type ShareLink_SharedObject struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ShareLink_SharedObject) Reset() {
+	*x = ShareLink_SharedObject{}
+	mi := &file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink_SharedObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink_SharedObject) ProtoMessage() {}
+
+func (x *ShareLink_SharedObject) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ShareLink_SharedObject) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShareLink_SharedObject) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ShareLink_SharedObject) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ShareLink_SharedObject) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ShareLink_SharedObject) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ShareLink_SharedObject) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ShareLink_SharedObject) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ShareLink_SharedObject) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+type ShareLink_SharedObject_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object.
+	ObjectId *string
+	// The name of the object.
+	Name *string
+}
+
+func (b0 ShareLink_SharedObject_builder) Build() *ShareLink_SharedObject {
+	m0 := &ShareLink_SharedObject{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_share_link_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_share_link_proto_rawDesc = "" +
+	"\n" +
+	"2wayplatform/connect/trusttrack/v1/share_link.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x05\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12]\n" +
+	"\aobjects\x18\x03 \x03(\v29.wayplatform.connect.trusttrack.v1.ShareLink.SharedObjectB\b\xbaH\x05\x92\x01\x02\b\x01R\aobjects\x12B\n" +
+	"\x0fvalid_from_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromTime\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12&\n" +
+	"\x0fcreator_user_id\x18\b \x01(\tR\rcreatorUserId\x1aG\n" +
+	"\fSharedObject\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name:\xab\x01\xbaH\xa7\x01\x1a\xa4\x01\n" +
+	"\x16share_link.expire_time\x12)expire_time must be after valid_from_time\x1a_!has(this.valid_from_time) || !has(this.expire_time) || this.expire_time > this.valid_from_timeB\xc1\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x0eShareLinkProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_share_link_proto_goTypes = []any{
+	(*ShareLink)(nil),              // 0: wayplatform.connect.trusttrack.v1.ShareLink
+	(*ShareLink_SharedObject)(nil), // 1: wayplatform.connect.trusttrack.v1.ShareLink.SharedObject
+	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_share_link_proto_depIdxs = []int32{
+	1, // 0: wayplatform.connect.trusttrack.v1.ShareLink.objects:type_name -> wayplatform.connect.trusttrack.v1.ShareLink.SharedObject
+	2, // 1: wayplatform.connect.trusttrack.v1.ShareLink.valid_from_time:type_name -> google.protobuf.Timestamp
+	2, // 2: wayplatform.connect.trusttrack.v1.ShareLink.expire_time:type_name -> google.protobuf.Timestamp
+	2, // 3: wayplatform.connect.trusttrack.v1.ShareLink.create_time:type_name -> google.protobuf.Timestamp
+	2, // 4: wayplatform.connect.trusttrack.v1.ShareLink.update_time:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_share_link_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_share_link_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_share_link_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_share_link_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_share_link_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_share_link_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_share_link_proto_depIdxs,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_share_link_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_share_link_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_share_link_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_share_link_proto_depIdxs = nil
+}
@@ -0,0 +1,1257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/tacho_request.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a tachograph download request type.
+type TachoRequest_Type int32
+
+const (
+	TachoRequest_TYPE_UNSPECIFIED   TachoRequest_Type = 0
+	TachoRequest_TYPE_UNKNOWN       TachoRequest_Type = 1
+	TachoRequest_TYPE_NOT_AVAILABLE TachoRequest_Type = 2
+	// A download of the vehicle unit data.
+	TachoRequest_VEHICLE TachoRequest_Type = 3
+	// A download of a driver card inserted in the vehicle unit.
+	TachoRequest_DRIVER_CARD TachoRequest_Type = 4
+)
+
+// Enum value maps for TachoRequest_Type.
+var (
+	TachoRequest_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNKNOWN",
+		2: "TYPE_NOT_AVAILABLE",
+		3: "VEHICLE",
+		4: "DRIVER_CARD",
+	}
+	TachoRequest_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"TYPE_UNKNOWN":       1,
+		"TYPE_NOT_AVAILABLE": 2,
+		"VEHICLE":            3,
+		"DRIVER_CARD":        4,
+	}
+)
+
+func (x TachoRequest_Type) Enum() *TachoRequest_Type {
+	p := new(TachoRequest_Type)
+	*p = x
+	return p
+}
+
+func (x TachoRequest_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TachoRequest_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[0].Descriptor()
+}
+
+func (TachoRequest_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[0]
+}
+
+func (x TachoRequest_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents a tachograph download request status.
+type TachoRequest_Status int32
+
+const (
+	TachoRequest_STATUS_UNSPECIFIED       TachoRequest_Status = 0
+	TachoRequest_STATUS_UNKNOWN           TachoRequest_Status = 1
+	TachoRequest_STATUS_NOT_AVAILABLE     TachoRequest_Status = 2
+	TachoRequest_PENDING                  TachoRequest_Status = 3
+	TachoRequest_AUTHENTICATING           TachoRequest_Status = 4
+	TachoRequest_AUTHENTICATION_COMPLETED TachoRequest_Status = 5
+	TachoRequest_DOWNLOADING              TachoRequest_Status = 6
+	TachoRequest_PENDING_VALIDATION       TachoRequest_Status = 7
+	// The download succeeded and the file is available.
+	TachoRequest_SUCCEEDED TachoRequest_Status = 8
+	// The download succeeded with a file that failed validation.
+	TachoRequest_SUCCEEDED_DIRTY TachoRequest_Status = 9
+	TachoRequest_FAILED          TachoRequest_Status = 10
+)
+
+// Enum value maps for TachoRequest_Status.
+var (
+	TachoRequest_Status_name = map[int32]string{
+		0:  "STATUS_UNSPECIFIED",
+		1:  "STATUS_UNKNOWN",
+		2:  "STATUS_NOT_AVAILABLE",
+		3:  "PENDING",
+		4:  "AUTHENTICATING",
+		5:  "AUTHENTICATION_COMPLETED",
+		6:  "DOWNLOADING",
+		7:  "PENDING_VALIDATION",
+		8:  "SUCCEEDED",
+		9:  "SUCCEEDED_DIRTY",
+		10: "FAILED",
+	}
+	TachoRequest_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":       0,
+		"STATUS_UNKNOWN":           1,
+		"STATUS_NOT_AVAILABLE":     2,
+		"PENDING":                  3,
+		"AUTHENTICATING":           4,
+		"AUTHENTICATION_COMPLETED": 5,
+		"DOWNLOADING":              6,
+		"PENDING_VALIDATION":       7,
+		"SUCCEEDED":                8,
+		"SUCCEEDED_DIRTY":          9,
+		"FAILED":                   10,
+	}
+)
+
+func (x TachoRequest_Status) Enum() *TachoRequest_Status {
+	p := new(TachoRequest_Status)
+	*p = x
+	return p
+}
+
+func (x TachoRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TachoRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[1].Descriptor()
+}
+
+func (TachoRequest_Status) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[1]
+}
+
+func (x TachoRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents a tachograph download request error.
+type TachoRequest_Error int32
+
+const (
+	TachoRequest_ERROR_UNSPECIFIED            TachoRequest_Error = 0
+	TachoRequest_ERROR_UNKNOWN                TachoRequest_Error = 1
+	TachoRequest_ERROR_NOT_AVAILABLE          TachoRequest_Error = 2
+	TachoRequest_NONE                         TachoRequest_Error = 3
+	TachoRequest_VALIDATION_FAILURE           TachoRequest_Error = 4
+	TachoRequest_DEVICE_COMMUNICATION_FAILURE TachoRequest_Error = 5
+	TachoRequest_SERVER_FAILURE               TachoRequest_Error = 6
+	TachoRequest_AUTHORIZATION_FAILURE        TachoRequest_Error = 7
+	TachoRequest_NO_CARD_IN_SLOT              TachoRequest_Error = 8
+	TachoRequest_UNSPECIFIED_FAILURE          TachoRequest_Error = 9
+	TachoRequest_EXPIRED                      TachoRequest_Error = 10
+	TachoRequest_DEVICE_IS_BUSY               TachoRequest_Error = 11
+	TachoRequest_DSRC_ABORTED                 TachoRequest_Error = 12
+)
+
+// Enum value maps for TachoRequest_Error.
+var (
+	TachoRequest_Error_name = map[int32]string{
+		0:  "ERROR_UNSPECIFIED",
+		1:  "ERROR_UNKNOWN",
+		2:  "ERROR_NOT_AVAILABLE",
+		3:  "NONE",
+		4:  "VALIDATION_FAILURE",
+		5:  "DEVICE_COMMUNICATION_FAILURE",
+		6:  "SERVER_FAILURE",
+		7:  "AUTHORIZATION_FAILURE",
+		8:  "NO_CARD_IN_SLOT",
+		9:  "UNSPECIFIED_FAILURE",
+		10: "EXPIRED",
+		11: "DEVICE_IS_BUSY",
+		12: "DSRC_ABORTED",
+	}
+	TachoRequest_Error_value = map[string]int32{
+		"ERROR_UNSPECIFIED":            0,
+		"ERROR_UNKNOWN":                1,
+		"ERROR_NOT_AVAILABLE":          2,
+		"NONE":                         3,
+		"VALIDATION_FAILURE":           4,
+		"DEVICE_COMMUNICATION_FAILURE": 5,
+		"SERVER_FAILURE":               6,
+		"AUTHORIZATION_FAILURE":        7,
+		"NO_CARD_IN_SLOT":              8,
+		"UNSPECIFIED_FAILURE":          9,
+		"EXPIRED":                      10,
+		"DEVICE_IS_BUSY":               11,
+		"DSRC_ABORTED":                 12,
+	}
+)
+
+func (x TachoRequest_Error) Enum() *TachoRequest_Error {
+	p := new(TachoRequest_Error)
+	*p = x
+	return p
+}
+
+func (x TachoRequest_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TachoRequest_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[2].Descriptor()
+}
+
+func (TachoRequest_Error) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[2]
+}
+
+func (x TachoRequest_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the origin of a tachograph download request.
+type TachoRequest_Origin int32
+
+const (
+	TachoRequest_ORIGIN_UNSPECIFIED   TachoRequest_Origin = 0
+	TachoRequest_ORIGIN_UNKNOWN       TachoRequest_Origin = 1
+	TachoRequest_ORIGIN_NOT_AVAILABLE TachoRequest_Origin = 2
+	TachoRequest_ON_DEMAND            TachoRequest_Origin = 3
+	TachoRequest_MANUAL_IMPORT        TachoRequest_Origin = 4
+	TachoRequest_SCHEDULER            TachoRequest_Origin = 5
+	TachoRequest_API                  TachoRequest_Origin = 6
+)
+
+// Enum value maps for TachoRequest_Origin.
+var (
+	TachoRequest_Origin_name = map[int32]string{
+		0: "ORIGIN_UNSPECIFIED",
+		1: "ORIGIN_UNKNOWN",
+		2: "ORIGIN_NOT_AVAILABLE",
+		3: "ON_DEMAND",
+		4: "MANUAL_IMPORT",
+		5: "SCHEDULER",
+		6: "API",
+	}
+	TachoRequest_Origin_value = map[string]int32{
+		"ORIGIN_UNSPECIFIED":   0,
+		"ORIGIN_UNKNOWN":       1,
+		"ORIGIN_NOT_AVAILABLE": 2,
+		"ON_DEMAND":            3,
+		"MANUAL_IMPORT":        4,
+		"SCHEDULER":            5,
+		"API":                  6,
+	}
+)
+
+func (x TachoRequest_Origin) Enum() *TachoRequest_Origin {
+	p := new(TachoRequest_Origin)
+	*p = x
+	return p
+}
+
+func (x TachoRequest_Origin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TachoRequest_Origin) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[3].Descriptor()
+}
+
+func (TachoRequest_Origin) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[3]
+}
+
+func (x TachoRequest_Origin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the status of the upload of a downloaded file to a third party.
+type TachoRequest_UploadStatus int32
+
+const (
+	TachoRequest_UPLOAD_STATUS_UNSPECIFIED    TachoRequest_UploadStatus = 0
+	TachoRequest_UPLOAD_STATUS_UNKNOWN        TachoRequest_UploadStatus = 1
+	TachoRequest_UPLOAD_STATUS_NOT_AVAILABLE  TachoRequest_UploadStatus = 2
+	TachoRequest_IN_PROGRESS                  TachoRequest_UploadStatus = 3
+	TachoRequest_UPLOADED                     TachoRequest_UploadStatus = 4
+	TachoRequest_UPLOAD_ERROR                 TachoRequest_UploadStatus = 5
+	TachoRequest_FILE_ALREADY_EXISTS_ERROR    TachoRequest_UploadStatus = 6
+	TachoRequest_ACCOUNT_NOT_VALID_DMM_ERROR  TachoRequest_UploadStatus = 7
+	TachoRequest_INVALID_ACCOUNT_ERROR        TachoRequest_UploadStatus = 8
+	TachoRequest_NOT_AUTHORIZED_FOR_ROAD_SOFT TachoRequest_UploadStatus = 9
+	TachoRequest_NOT_AUTHORIZED_FOR_VDO       TachoRequest_UploadStatus = 10
+)
+
+// Enum value maps for TachoRequest_UploadStatus.
+var (
+	TachoRequest_UploadStatus_name = map[int32]string{
+		0:  "UPLOAD_STATUS_UNSPECIFIED",
+		1:  "UPLOAD_STATUS_UNKNOWN",
+		2:  "UPLOAD_STATUS_NOT_AVAILABLE",
+		3:  "IN_PROGRESS",
+		4:  "UPLOADED",
+		5:  "UPLOAD_ERROR",
+		6:  "FILE_ALREADY_EXISTS_ERROR",
+		7:  "ACCOUNT_NOT_VALID_DMM_ERROR",
+		8:  "INVALID_ACCOUNT_ERROR",
+		9:  "NOT_AUTHORIZED_FOR_ROAD_SOFT",
+		10: "NOT_AUTHORIZED_FOR_VDO",
+	}
+	TachoRequest_UploadStatus_value = map[string]int32{
+		"UPLOAD_STATUS_UNSPECIFIED":    0,
+		"UPLOAD_STATUS_UNKNOWN":        1,
+		"UPLOAD_STATUS_NOT_AVAILABLE":  2,
+		"IN_PROGRESS":                  3,
+		"UPLOADED":                     4,
+		"UPLOAD_ERROR":                 5,
+		"FILE_ALREADY_EXISTS_ERROR":    6,
+		"ACCOUNT_NOT_VALID_DMM_ERROR":  7,
+		"INVALID_ACCOUNT_ERROR":        8,
+		"NOT_AUTHORIZED_FOR_ROAD_SOFT": 9,
+		"NOT_AUTHORIZED_FOR_VDO":       10,
+	}
+)
+
+func (x TachoRequest_UploadStatus) Enum() *TachoRequest_UploadStatus {
+	p := new(TachoRequest_UploadStatus)
+	*p = x
+	return p
+}
+
+func (x TachoRequest_UploadStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TachoRequest_UploadStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[4].Descriptor()
+}
+
+func (TachoRequest_UploadStatus) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes[4]
+}
+
+func (x TachoRequest_UploadStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// A remote tachograph download request.
+type TachoRequest struct {
+	state                           protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Id                   *string                   `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Type                 TachoRequest_Type         `protobuf:"varint,2,opt,name=type,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Type"`
+	xxx_hidden_UnknownType          *string                   `protobuf:"bytes,3,opt,name=unknown_type,json=unknownType"`
+	xxx_hidden_Status               TachoRequest_Status       `protobuf:"varint,4,opt,name=status,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Status"`
+	xxx_hidden_UnknownStatus        *string                   `protobuf:"bytes,5,opt,name=unknown_status,json=unknownStatus"`
+	xxx_hidden_Error                TachoRequest_Error        `protobuf:"varint,6,opt,name=error,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Error"`
+	xxx_hidden_UnknownError         *string                   `protobuf:"bytes,7,opt,name=unknown_error,json=unknownError"`
+	xxx_hidden_Origin               TachoRequest_Origin       `protobuf:"varint,8,opt,name=origin,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Origin"`
+	xxx_hidden_UnknownOrigin        *string                   `protobuf:"bytes,9,opt,name=unknown_origin,json=unknownOrigin"`
+	xxx_hidden_ObjectId             *string                   `protobuf:"bytes,10,opt,name=object_id,json=objectId"`
+	xxx_hidden_ObjectName           *string                   `protobuf:"bytes,11,opt,name=object_name,json=objectName"`
+	xxx_hidden_DriverCardNumber     *string                   `protobuf:"bytes,12,opt,name=driver_card_number,json=driverCardNumber"`
+	xxx_hidden_DriverFirstName      *string                   `protobuf:"bytes,13,opt,name=driver_first_name,json=driverFirstName"`
+	xxx_hidden_DriverLastName       *string                   `protobuf:"bytes,14,opt,name=driver_last_name,json=driverLastName"`
+	xxx_hidden_FileName             *string                   `protobuf:"bytes,15,opt,name=file_name,json=fileName"`
+	xxx_hidden_DataStartTime        *timestamppb.Timestamp    `protobuf:"bytes,16,opt,name=data_start_time,json=dataStartTime"`
+	xxx_hidden_DataEndTime          *timestamppb.Timestamp    `protobuf:"bytes,17,opt,name=data_end_time,json=dataEndTime"`
+	xxx_hidden_CreateTime           *timestamppb.Timestamp    `protobuf:"bytes,18,opt,name=create_time,json=createTime"`
+	xxx_hidden_UpdateTime           *timestamppb.Timestamp    `protobuf:"bytes,19,opt,name=update_time,json=updateTime"`
+	xxx_hidden_FtpUploadStatus      TachoRequest_UploadStatus `protobuf:"varint,20,opt,name=ftp_upload_status,json=ftpUploadStatus,enum=wayplatform.connect.trusttrack.v1.TachoRequest_UploadStatus"`
+	xxx_hidden_RoadsoftUploadStatus TachoRequest_UploadStatus `protobuf:"varint,21,opt,name=roadsoft_upload_status,json=roadsoftUploadStatus,enum=wayplatform.connect.trusttrack.v1.TachoRequest_UploadStatus"`
+	xxx_hidden_TiswebUploadStatus   TachoRequest_UploadStatus `protobuf:"varint,22,opt,name=tisweb_upload_status,json=tiswebUploadStatus,enum=wayplatform.connect.trusttrack.v1.TachoRequest_UploadStatus"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *TachoRequest) Reset() {
+	*x = TachoRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TachoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TachoRequest) ProtoMessage() {}
+
+func (x *TachoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_tacho_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TachoRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetType() TachoRequest_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return TachoRequest_TYPE_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetUnknownType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownType != nil {
+			return *x.xxx_hidden_UnknownType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetStatus() TachoRequest_Status {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Status
+		}
+	}
+	return TachoRequest_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetUnknownStatus() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownStatus != nil {
+			return *x.xxx_hidden_UnknownStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetError() TachoRequest_Error {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 5) {
+			return x.xxx_hidden_Error
+		}
+	}
+	return TachoRequest_ERROR_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetUnknownError() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownError != nil {
+			return *x.xxx_hidden_UnknownError
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetOrigin() TachoRequest_Origin {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 7) {
+			return x.xxx_hidden_Origin
+		}
+	}
+	return TachoRequest_ORIGIN_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetUnknownOrigin() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownOrigin != nil {
+			return *x.xxx_hidden_UnknownOrigin
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetObjectName() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectName != nil {
+			return *x.xxx_hidden_ObjectName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetDriverCardNumber() string {
+	if x != nil {
+		if x.xxx_hidden_DriverCardNumber != nil {
+			return *x.xxx_hidden_DriverCardNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetDriverFirstName() string {
+	if x != nil {
+		if x.xxx_hidden_DriverFirstName != nil {
+			return *x.xxx_hidden_DriverFirstName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetDriverLastName() string {
+	if x != nil {
+		if x.xxx_hidden_DriverLastName != nil {
+			return *x.xxx_hidden_DriverLastName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetFileName() string {
+	if x != nil {
+		if x.xxx_hidden_FileName != nil {
+			return *x.xxx_hidden_FileName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TachoRequest) GetDataStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DataStartTime
+	}
+	return nil
+}
+
+func (x *TachoRequest) GetDataEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DataEndTime
+	}
+	return nil
+}
+
+func (x *TachoRequest) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreateTime
+	}
+	return nil
+}
+
+func (x *TachoRequest) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdateTime
+	}
+	return nil
+}
+
+func (x *TachoRequest) GetFtpUploadStatus() TachoRequest_UploadStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 19) {
+			return x.xxx_hidden_FtpUploadStatus
+		}
+	}
+	return TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetRoadsoftUploadStatus() TachoRequest_UploadStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 20) {
+			return x.xxx_hidden_RoadsoftUploadStatus
+		}
+	}
+	return TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) GetTiswebUploadStatus() TachoRequest_UploadStatus {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 21) {
+			return x.xxx_hidden_TiswebUploadStatus
+		}
+	}
+	return TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 22)
+}
+
+func (x *TachoRequest) SetType(v TachoRequest_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 22)
+}
+
+func (x *TachoRequest) SetUnknownType(v string) {
+	x.xxx_hidden_UnknownType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 22)
+}
+
+func (x *TachoRequest) SetStatus(v TachoRequest_Status) {
+	x.xxx_hidden_Status = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 22)
+}
+
+func (x *TachoRequest) SetUnknownStatus(v string) {
+	x.xxx_hidden_UnknownStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 22)
+}
+
+func (x *TachoRequest) SetError(v TachoRequest_Error) {
+	x.xxx_hidden_Error = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 22)
+}
+
+func (x *TachoRequest) SetUnknownError(v string) {
+	x.xxx_hidden_UnknownError = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 22)
+}
+
+func (x *TachoRequest) SetOrigin(v TachoRequest_Origin) {
+	x.xxx_hidden_Origin = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 22)
+}
+
+func (x *TachoRequest) SetUnknownOrigin(v string) {
+	x.xxx_hidden_UnknownOrigin = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 22)
+}
+
+func (x *TachoRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 22)
+}
+
+func (x *TachoRequest) SetObjectName(v string) {
+	x.xxx_hidden_ObjectName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 22)
+}
+
+func (x *TachoRequest) SetDriverCardNumber(v string) {
+	x.xxx_hidden_DriverCardNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 22)
+}
+
+func (x *TachoRequest) SetDriverFirstName(v string) {
+	x.xxx_hidden_DriverFirstName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 22)
+}
+
+func (x *TachoRequest) SetDriverLastName(v string) {
+	x.xxx_hidden_DriverLastName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 22)
+}
+
+func (x *TachoRequest) SetFileName(v string) {
+	x.xxx_hidden_FileName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 22)
+}
+
+func (x *TachoRequest) SetDataStartTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DataStartTime = v
+}
+
+func (x *TachoRequest) SetDataEndTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DataEndTime = v
+}
+
+func (x *TachoRequest) SetCreateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateTime = v
+}
+
+func (x *TachoRequest) SetUpdateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdateTime = v
+}
+
+func (x *TachoRequest) SetFtpUploadStatus(v TachoRequest_UploadStatus) {
+	x.xxx_hidden_FtpUploadStatus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 19, 22)
+}
+
+func (x *TachoRequest) SetRoadsoftUploadStatus(v TachoRequest_UploadStatus) {
+	x.xxx_hidden_RoadsoftUploadStatus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 22)
+}
+
+func (x *TachoRequest) SetTiswebUploadStatus(v TachoRequest_UploadStatus) {
+	x.xxx_hidden_TiswebUploadStatus = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 22)
+}
+
+func (x *TachoRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TachoRequest) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TachoRequest) HasUnknownType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TachoRequest) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TachoRequest) HasUnknownStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TachoRequest) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TachoRequest) HasUnknownError() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *TachoRequest) HasOrigin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *TachoRequest) HasUnknownOrigin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *TachoRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *TachoRequest) HasObjectName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *TachoRequest) HasDriverCardNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *TachoRequest) HasDriverFirstName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *TachoRequest) HasDriverLastName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *TachoRequest) HasFileName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *TachoRequest) HasDataStartTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DataStartTime != nil
+}
+
+func (x *TachoRequest) HasDataEndTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DataEndTime != nil
+}
+
+func (x *TachoRequest) HasCreateTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreateTime != nil
+}
+
+func (x *TachoRequest) HasUpdateTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdateTime != nil
+}
+
+func (x *TachoRequest) HasFtpUploadStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 19)
+}
+
+func (x *TachoRequest) HasRoadsoftUploadStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *TachoRequest) HasTiswebUploadStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
+func (x *TachoRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TachoRequest) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = TachoRequest_TYPE_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearUnknownType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UnknownType = nil
+}
+
+func (x *TachoRequest) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Status = TachoRequest_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearUnknownStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnknownStatus = nil
+}
+
+func (x *TachoRequest) ClearError() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Error = TachoRequest_ERROR_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearUnknownError() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_UnknownError = nil
+}
+
+func (x *TachoRequest) ClearOrigin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Origin = TachoRequest_ORIGIN_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearUnknownOrigin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_UnknownOrigin = nil
+}
+
+func (x *TachoRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *TachoRequest) ClearObjectName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_ObjectName = nil
+}
+
+func (x *TachoRequest) ClearDriverCardNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_DriverCardNumber = nil
+}
+
+func (x *TachoRequest) ClearDriverFirstName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_DriverFirstName = nil
+}
+
+func (x *TachoRequest) ClearDriverLastName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_DriverLastName = nil
+}
+
+func (x *TachoRequest) ClearFileName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_FileName = nil
+}
+
+func (x *TachoRequest) ClearDataStartTime() {
+	x.xxx_hidden_DataStartTime = nil
+}
+
+func (x *TachoRequest) ClearDataEndTime() {
+	x.xxx_hidden_DataEndTime = nil
+}
+
+func (x *TachoRequest) ClearCreateTime() {
+	x.xxx_hidden_CreateTime = nil
+}
+
+func (x *TachoRequest) ClearUpdateTime() {
+	x.xxx_hidden_UpdateTime = nil
+}
+
+func (x *TachoRequest) ClearFtpUploadStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 19)
+	x.xxx_hidden_FtpUploadStatus = TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearRoadsoftUploadStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_RoadsoftUploadStatus = TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *TachoRequest) ClearTiswebUploadStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_TiswebUploadStatus = TachoRequest_UPLOAD_STATUS_UNSPECIFIED
+}
+
+type TachoRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the request.
+	Id *string
+	// The type of the request.
+	Type *TachoRequest_Type
+	// The unknown type of the request.
+	// This field is used when the type is TYPE_UNKNOWN.
+	UnknownType *string
+	// The status of the request.
+	Status *TachoRequest_Status
+	// The unknown status of the request.
+	// This field is used when the status is STATUS_UNKNOWN.
+	UnknownStatus *string
+	// The error of the request.
+	Error *TachoRequest_Error
+	// The unknown error of the request.
+	// This field is used when the error is ERROR_UNKNOWN.
+	UnknownError *string
+	// The origin of the request.
+	Origin *TachoRequest_Origin
+	// The unknown origin of the request.
+	// This field is used when the origin is ORIGIN_UNKNOWN.
+	UnknownOrigin *string
+	// The ID of the object the data is downloaded from.
+	ObjectId *string
+	// The name of the object the data is downloaded from.
+	ObjectName *string
+	// The card number of the driver, for driver card downloads.
+	DriverCardNumber *string
+	// The first name of the driver, for driver card downloads.
+	DriverFirstName *string
+	// The last name of the driver, for driver card downloads.
+	DriverLastName *string
+	// The name of the downloaded file.
+	FileName *string
+	// Start of the time period covered by the downloaded data.
+	DataStartTime *timestamppb.Timestamp
+	// End of the time period covered by the downloaded data.
+	DataEndTime *timestamppb.Timestamp
+	// The time the request was created.
+	CreateTime *timestamppb.Timestamp
+	// The time the request was last updated.
+	UpdateTime *timestamppb.Timestamp
+	// The status of the upload of the file to FTP.
+	FtpUploadStatus *TachoRequest_UploadStatus
+	// The status of the upload of the file to RoadSoft.
+	RoadsoftUploadStatus *TachoRequest_UploadStatus
+	// The status of the upload of the file to TIS-Web.
+	TiswebUploadStatus *TachoRequest_UploadStatus
+}
+
+func (b0 TachoRequest_builder) Build() *TachoRequest {
+	m0 := &TachoRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 22)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 22)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnknownType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 22)
+		x.xxx_hidden_UnknownType = b.UnknownType
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 22)
+		x.xxx_hidden_Status = *b.Status
+	}
+	if b.UnknownStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 22)
+		x.xxx_hidden_UnknownStatus = b.UnknownStatus
+	}
+	if b.Error != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 22)
+		x.xxx_hidden_Error = *b.Error
+	}
+	if b.UnknownError != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 22)
+		x.xxx_hidden_UnknownError = b.UnknownError
+	}
+	if b.Origin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 22)
+		x.xxx_hidden_Origin = *b.Origin
+	}
+	if b.UnknownOrigin != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 22)
+		x.xxx_hidden_UnknownOrigin = b.UnknownOrigin
+	}
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 22)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.ObjectName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 22)
+		x.xxx_hidden_ObjectName = b.ObjectName
+	}
+	if b.DriverCardNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 22)
+		x.xxx_hidden_DriverCardNumber = b.DriverCardNumber
+	}
+	if b.DriverFirstName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 22)
+		x.xxx_hidden_DriverFirstName = b.DriverFirstName
+	}
+	if b.DriverLastName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 22)
+		x.xxx_hidden_DriverLastName = b.DriverLastName
+	}
+	if b.FileName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 22)
+		x.xxx_hidden_FileName = b.FileName
+	}
+	x.xxx_hidden_DataStartTime = b.DataStartTime
+	x.xxx_hidden_DataEndTime = b.DataEndTime
+	x.xxx_hidden_CreateTime = b.CreateTime
+	x.xxx_hidden_UpdateTime = b.UpdateTime
+	if b.FtpUploadStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 19, 22)
+		x.xxx_hidden_FtpUploadStatus = *b.FtpUploadStatus
+	}
+	if b.RoadsoftUploadStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 22)
+		x.xxx_hidden_RoadsoftUploadStatus = *b.RoadsoftUploadStatus
+	}
+	if b.TiswebUploadStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 22)
+		x.xxx_hidden_TiswebUploadStatus = *b.TiswebUploadStatus
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_tacho_request_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_tacho_request_proto_rawDesc = "" +
+	"\n" +
+	"5wayplatform/connect/trusttrack/v1/tacho_request.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x15\n" +
+	"\fTachoRequest\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12T\n" +
+	"\x04type\x18\x02 \x01(\x0e24.wayplatform.connect.trusttrack.v1.TachoRequest.TypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12!\n" +
+	"\funknown_type\x18\x03 \x01(\tR\vunknownType\x12Z\n" +
+	"\x06status\x18\x04 \x01(\x0e26.wayplatform.connect.trusttrack.v1.TachoRequest.StatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12%\n" +
+	"\x0eunknown_status\x18\x05 \x01(\tR\runknownStatus\x12U\n" +
+	"\x05error\x18\x06 \x01(\x0e25.wayplatform.connect.trusttrack.v1.TachoRequest.ErrorB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05error\x12#\n" +
+	"\runknown_error\x18\a \x01(\tR\funknownError\x12X\n" +
+	"\x06origin\x18\b \x01(\x0e26.wayplatform.connect.trusttrack.v1.TachoRequest.OriginB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06origin\x12%\n" +
+	"\x0eunknown_origin\x18\t \x01(\tR\runknownOrigin\x12\x1b\n" +
+	"\tobject_id\x18\n" +
+	" \x01(\tR\bobjectId\x12\x1f\n" +
+	"\vobject_name\x18\v \x01(\tR\n" +
+	"objectName\x121\n" +
+	"\x12driver_card_number\x18\f \x01(\tB\x03\x80\x01\x01R\x10driverCardNumber\x12/\n" +
+	"\x11driver_first_name\x18\r \x01(\tB\x03\x80\x01\x01R\x0fdriverFirstName\x12-\n" +
+	"\x10driver_last_name\x18\x0e \x01(\tB\x03\x80\x01\x01R\x0edriverLastName\x12\x1b\n" +
+	"\tfile_name\x18\x0f \x01(\tR\bfileName\x12B\n" +
+	"\x0fdata_start_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rdataStartTime\x12>\n" +
+	"\rdata_end_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vdataEndTime\x12;\n" +
+	"\vcreate_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12h\n" +
+	"\x11ftp_upload_status\x18\x14 \x01(\x0e2<.wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatusR\x0fftpUploadStatus\x12r\n" +
+	"\x16roadsoft_upload_status\x18\x15 \x01(\x0e2<.wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatusR\x14roadsoftUploadStatus\x12n\n" +
+	"\x14tisweb_upload_status\x18\x16 \x01(\x0e2<.wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatusR\x12tiswebUploadStatus\"d\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x01\x12\x16\n" +
+	"\x12TYPE_NOT_AVAILABLE\x10\x02\x12\v\n" +
+	"\aVEHICLE\x10\x03\x12\x0f\n" +
+	"\vDRIVER_CARD\x10\x04\"\xe6\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_UNKNOWN\x10\x01\x12\x18\n" +
+	"\x14STATUS_NOT_AVAILABLE\x10\x02\x12\v\n" +
+	"\aPENDING\x10\x03\x12\x12\n" +
+	"\x0eAUTHENTICATING\x10\x04\x12\x1c\n" +
+	"\x18AUTHENTICATION_COMPLETED\x10\x05\x12\x0f\n" +
+	"\vDOWNLOADING\x10\x06\x12\x16\n" +
+	"\x12PENDING_VALIDATION\x10\a\x12\r\n" +
+	"\tSUCCEEDED\x10\b\x12\x13\n" +
+	"\x0fSUCCEEDED_DIRTY\x10\t\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\n" +
+	"\"\x9e\x02\n" +
+	"\x05Error\x12\x15\n" +
+	"\x11ERROR_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rERROR_UNKNOWN\x10\x01\x12\x17\n" +
+	"\x13ERROR_NOT_AVAILABLE\x10\x02\x12\b\n" +
+	"\x04NONE\x10\x03\x12\x16\n" +
+	"\x12VALIDATION_FAILURE\x10\x04\x12 \n" +
+	"\x1cDEVICE_COMMUNICATION_FAILURE\x10\x05\x12\x12\n" +
+	"\x0eSERVER_FAILURE\x10\x06\x12\x19\n" +
+	"\x15AUTHORIZATION_FAILURE\x10\a\x12\x13\n" +
+	"\x0fNO_CARD_IN_SLOT\x10\b\x12\x17\n" +
+	"\x13UNSPECIFIED_FAILURE\x10\t\x12\v\n" +
+	"\aEXPIRED\x10\n" +
+	"\x12\x12\n" +
+	"\x0eDEVICE_IS_BUSY\x10\v\x12\x10\n" +
+	"\fDSRC_ABORTED\x10\f\"\x88\x01\n" +
+	"\x06Origin\x12\x16\n" +
+	"\x12ORIGIN_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eORIGIN_UNKNOWN\x10\x01\x12\x18\n" +
+	"\x14ORIGIN_NOT_AVAILABLE\x10\x02\x12\r\n" +
+	"\tON_DEMAND\x10\x03\x12\x11\n" +
+	"\rMANUAL_IMPORT\x10\x04\x12\r\n" +
+	"\tSCHEDULER\x10\x05\x12\a\n" +
+	"\x03API\x10\x06\"\xb3\x02\n" +
+	"\fUploadStatus\x12\x1d\n" +
+	"\x19UPLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15UPLOAD_STATUS_UNKNOWN\x10\x01\x12\x1f\n" +
+	"\x1bUPLOAD_STATUS_NOT_AVAILABLE\x10\x02\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x03\x12\f\n" +
+	"\bUPLOADED\x10\x04\x12\x10\n" +
+	"\fUPLOAD_ERROR\x10\x05\x12\x1d\n" +
+	"\x19FILE_ALREADY_EXISTS_ERROR\x10\x06\x12\x1f\n" +
+	"\x1bACCOUNT_NOT_VALID_DMM_ERROR\x10\a\x12\x19\n" +
+	"\x15INVALID_ACCOUNT_ERROR\x10\b\x12 \n" +
+	"\x1cNOT_AUTHORIZED_FOR_ROAD_SOFT\x10\t\x12\x1a\n" +
+	"\x16NOT_AUTHORIZED_FOR_VDO\x10\n" +
+	":\xa1\x02\xbaH\x9d\x02\x1aY\n" +
+	"\x14unknown_type.warning\x12(unknown_type indicates an unhandled type\x1a\x17!has(this.unknown_type)\x1aa\n" +
+	"\x16unknown_status.warning\x12,unknown_status indicates an unhandled status\x1a\x19!has(this.unknown_status)\x1a]\n" +
+	"\x15unknown_error.warning\x12*unknown_error indicates an unhandled error\x1a\x18!has(this.unknown_error)B\xc4\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x11TachoRequestProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_wayplatform_connect_trusttrack_v1_tacho_request_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wayplatform_connect_trusttrack_v1_tacho_request_proto_goTypes = []any{
+	(TachoRequest_Type)(0),         // 0: wayplatform.connect.trusttrack.v1.TachoRequest.Type
+	(TachoRequest_Status)(0),       // 1: wayplatform.connect.trusttrack.v1.TachoRequest.Status
+	(TachoRequest_Error)(0),        // 2: wayplatform.connect.trusttrack.v1.TachoRequest.Error
+	(TachoRequest_Origin)(0),       // 3: wayplatform.connect.trusttrack.v1.TachoRequest.Origin
+	(TachoRequest_UploadStatus)(0), // 4: wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatus
+	(*TachoRequest)(nil),           // 5: wayplatform.connect.trusttrack.v1.TachoRequest
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_tacho_request_proto_depIdxs = []int32{
+	0,  // 0: wayplatform.connect.trusttrack.v1.TachoRequest.type:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Type
+	1,  // 1: wayplatform.connect.trusttrack.v1.TachoRequest.status:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Status
+	2,  // 2: wayplatform.connect.trusttrack.v1.TachoRequest.error:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Error
+	3,  // 3: wayplatform.connect.trusttrack.v1.TachoRequest.origin:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Origin
+	6,  // 4: wayplatform.connect.trusttrack.v1.TachoRequest.data_start_time:type_name -> google.protobuf.Timestamp
+	6,  // 5: wayplatform.connect.trusttrack.v1.TachoRequest.data_end_time:type_name -> google.protobuf.Timestamp
+	6,  // 6: wayplatform.connect.trusttrack.v1.TachoRequest.create_time:type_name -> google.protobuf.Timestamp
+	6,  // 7: wayplatform.connect.trusttrack.v1.TachoRequest.update_time:type_name -> google.protobuf.Timestamp
+	4,  // 8: wayplatform.connect.trusttrack.v1.TachoRequest.ftp_upload_status:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatus
+	4,  // 9: wayplatform.connect.trusttrack.v1.TachoRequest.roadsoft_upload_status:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatus
+	4,  // 10: wayplatform.connect.trusttrack.v1.TachoRequest.tisweb_upload_status:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.UploadStatus
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_tacho_request_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_tacho_request_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_tacho_request_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_tacho_request_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_tacho_request_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_tacho_request_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_tacho_request_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_tacho_request_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_tacho_request_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_tacho_request_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_tacho_request_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_tacho_request_proto_depIdxs = nil
+}
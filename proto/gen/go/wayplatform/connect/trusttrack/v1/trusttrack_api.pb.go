@@ -7,8 +7,10 @@
 package trusttrackv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
@@ -21,33 +23,133 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Request for ListDrivers.
-type ListDriversRequest struct {
+// Represents additional data to include in a vehicle unit download.
+type ScheduleVehicleTachoDownloadRequest_Option int32
+
+const (
+	ScheduleVehicleTachoDownloadRequest_OPTION_UNSPECIFIED ScheduleVehicleTachoDownloadRequest_Option = 0
+	ScheduleVehicleTachoDownloadRequest_FAULTS_AND_EVENTS  ScheduleVehicleTachoDownloadRequest_Option = 1
+	ScheduleVehicleTachoDownloadRequest_DETAILED_SPEED     ScheduleVehicleTachoDownloadRequest_Option = 2
+	ScheduleVehicleTachoDownloadRequest_TECHNICAL_DATA     ScheduleVehicleTachoDownloadRequest_Option = 3
+	// Download the data since the last download, instead of the requested time period.
+	ScheduleVehicleTachoDownloadRequest_SINCE_LAST_DOWNLOAD ScheduleVehicleTachoDownloadRequest_Option = 4
+)
+
+// Enum value maps for ScheduleVehicleTachoDownloadRequest_Option.
+var (
+	ScheduleVehicleTachoDownloadRequest_Option_name = map[int32]string{
+		0: "OPTION_UNSPECIFIED",
+		1: "FAULTS_AND_EVENTS",
+		2: "DETAILED_SPEED",
+		3: "TECHNICAL_DATA",
+		4: "SINCE_LAST_DOWNLOAD",
+	}
+	ScheduleVehicleTachoDownloadRequest_Option_value = map[string]int32{
+		"OPTION_UNSPECIFIED":  0,
+		"FAULTS_AND_EVENTS":   1,
+		"DETAILED_SPEED":      2,
+		"TECHNICAL_DATA":      3,
+		"SINCE_LAST_DOWNLOAD": 4,
+	}
+)
+
+func (x ScheduleVehicleTachoDownloadRequest_Option) Enum() *ScheduleVehicleTachoDownloadRequest_Option {
+	p := new(ScheduleVehicleTachoDownloadRequest_Option)
+	*p = x
+	return p
+}
+
+func (x ScheduleVehicleTachoDownloadRequest_Option) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleVehicleTachoDownloadRequest_Option) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleVehicleTachoDownloadRequest_Option) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes[0]
+}
+
+func (x ScheduleVehicleTachoDownloadRequest_Option) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents a tachograph driver card slot.
+type ScheduleDriverCardTachoDownloadRequest_Slot int32
+
+const (
+	ScheduleDriverCardTachoDownloadRequest_SLOT_UNSPECIFIED ScheduleDriverCardTachoDownloadRequest_Slot = 0
+	// The slot of the driver.
+	ScheduleDriverCardTachoDownloadRequest_FIRST_SLOT ScheduleDriverCardTachoDownloadRequest_Slot = 1
+	// The slot of the co-driver.
+	ScheduleDriverCardTachoDownloadRequest_SECOND_SLOT ScheduleDriverCardTachoDownloadRequest_Slot = 2
+)
+
+// Enum value maps for ScheduleDriverCardTachoDownloadRequest_Slot.
+var (
+	ScheduleDriverCardTachoDownloadRequest_Slot_name = map[int32]string{
+		0: "SLOT_UNSPECIFIED",
+		1: "FIRST_SLOT",
+		2: "SECOND_SLOT",
+	}
+	ScheduleDriverCardTachoDownloadRequest_Slot_value = map[string]int32{
+		"SLOT_UNSPECIFIED": 0,
+		"FIRST_SLOT":       1,
+		"SECOND_SLOT":      2,
+	}
+)
+
+func (x ScheduleDriverCardTachoDownloadRequest_Slot) Enum() *ScheduleDriverCardTachoDownloadRequest_Slot {
+	p := new(ScheduleDriverCardTachoDownloadRequest_Slot)
+	*p = x
+	return p
+}
+
+func (x ScheduleDriverCardTachoDownloadRequest_Slot) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleDriverCardTachoDownloadRequest_Slot) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ScheduleDriverCardTachoDownloadRequest_Slot) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes[1]
+}
+
+func (x ScheduleDriverCardTachoDownloadRequest_Slot) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Request for ListObjectCountryVisits.
+type ListObjectCountryVisitsRequest struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
-	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
-	xxx_hidden_IdentifierType    *string                `protobuf:"bytes,3,opt,name=identifier_type,json=identifierType"`
-	xxx_hidden_Identifier        *string                `protobuf:"bytes,4,opt,name=identifier"`
+	xxx_hidden_ObjectId          *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,4,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,5,opt,name=continuation_token,json=continuationToken"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListDriversRequest) Reset() {
-	*x = ListDriversRequest{}
+func (x *ListObjectCountryVisitsRequest) Reset() {
+	*x = ListObjectCountryVisitsRequest{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDriversRequest) String() string {
+func (x *ListObjectCountryVisitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDriversRequest) ProtoMessage() {}
+func (*ListObjectCountryVisitsRequest) ProtoMessage() {}
 
-func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
+func (x *ListObjectCountryVisitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -59,151 +161,168 @@ func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListDriversRequest) GetLimit() int32 {
+func (x *ListObjectCountryVisitsRequest) GetObjectId() string {
 	if x != nil {
-		return x.xxx_hidden_Limit
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDriversRequest) GetContinuationToken() string {
+func (x *ListObjectCountryVisitsRequest) GetFromTime() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_ContinuationToken != nil {
-			return *x.xxx_hidden_ContinuationToken
-		}
-		return ""
+		return x.xxx_hidden_FromTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ListDriversRequest) GetIdentifierType() string {
+func (x *ListObjectCountryVisitsRequest) GetToTime() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_IdentifierType != nil {
-			return *x.xxx_hidden_IdentifierType
-		}
-		return ""
+		return x.xxx_hidden_ToTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ListDriversRequest) GetIdentifier() string {
+func (x *ListObjectCountryVisitsRequest) GetLimit() int32 {
 	if x != nil {
-		if x.xxx_hidden_Identifier != nil {
-			return *x.xxx_hidden_Identifier
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListObjectCountryVisitsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *ListDriversRequest) SetLimit(v int32) {
-	x.xxx_hidden_Limit = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+func (x *ListObjectCountryVisitsRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
 }
 
-func (x *ListDriversRequest) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+func (x *ListObjectCountryVisitsRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
 }
 
-func (x *ListDriversRequest) SetIdentifierType(v string) {
-	x.xxx_hidden_IdentifierType = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+func (x *ListObjectCountryVisitsRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
 }
 
-func (x *ListDriversRequest) SetIdentifier(v string) {
-	x.xxx_hidden_Identifier = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+func (x *ListObjectCountryVisitsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
 }
 
-func (x *ListDriversRequest) HasLimit() bool {
+func (x *ListObjectCountryVisitsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ListObjectCountryVisitsRequest) HasObjectId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListDriversRequest) HasContinuationToken() bool {
+func (x *ListObjectCountryVisitsRequest) HasFromTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return x.xxx_hidden_FromTime != nil
 }
 
-func (x *ListDriversRequest) HasIdentifierType() bool {
+func (x *ListObjectCountryVisitsRequest) HasToTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+	return x.xxx_hidden_ToTime != nil
 }
 
-func (x *ListDriversRequest) HasIdentifier() bool {
+func (x *ListObjectCountryVisitsRequest) HasLimit() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *ListDriversRequest) ClearLimit() {
+func (x *ListObjectCountryVisitsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListObjectCountryVisitsRequest) ClearObjectId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Limit = 0
+	x.xxx_hidden_ObjectId = nil
 }
 
-func (x *ListDriversRequest) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_ContinuationToken = nil
+func (x *ListObjectCountryVisitsRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
 }
 
-func (x *ListDriversRequest) ClearIdentifierType() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_IdentifierType = nil
+func (x *ListObjectCountryVisitsRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
 }
 
-func (x *ListDriversRequest) ClearIdentifier() {
+func (x *ListObjectCountryVisitsRequest) ClearLimit() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Identifier = nil
+	x.xxx_hidden_Limit = 0
 }
 
-type ListDriversRequest_builder struct {
+func (x *ListObjectCountryVisitsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectCountryVisitsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// Max results to return (default 100, max 1000).
+	// The external object ID.
+	ObjectId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+	// Max results to return.
 	Limit *int32
 	// Continuation token from a previous response.
 	ContinuationToken *string
-	// Filter by identifier type.
-	IdentifierType *string
-	// Filter by identifier value.
-	Identifier *string
 }
 
-func (b0 ListDriversRequest_builder) Build() *ListDriversRequest {
-	m0 := &ListDriversRequest{}
+func (b0 ListObjectCountryVisitsRequest_builder) Build() *ListObjectCountryVisitsRequest {
+	m0 := &ListObjectCountryVisitsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
 	if b.Limit != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
 		x.xxx_hidden_Limit = *b.Limit
 	}
 	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
 		x.xxx_hidden_ContinuationToken = b.ContinuationToken
 	}
-	if b.IdentifierType != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
-		x.xxx_hidden_IdentifierType = b.IdentifierType
-	}
-	if b.Identifier != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
-		x.xxx_hidden_Identifier = b.Identifier
-	}
 	return m0
 }
 
-// Response for ListDrivers.
-type ListDriversResponse struct {
+// Response for ListObjectCountryVisits.
+type ListObjectCountryVisitsResponse struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Drivers           *[]*Driver             `protobuf:"bytes,1,rep,name=drivers"`
+	xxx_hidden_CountryVisits     *[]*CountryVisit       `protobuf:"bytes,1,rep,name=country_visits,json=countryVisits"`
 	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
@@ -211,20 +330,20 @@ type ListDriversResponse struct {
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListDriversResponse) Reset() {
-	*x = ListDriversResponse{}
+func (x *ListObjectCountryVisitsResponse) Reset() {
+	*x = ListObjectCountryVisitsResponse{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDriversResponse) String() string {
+func (x *ListObjectCountryVisitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDriversResponse) ProtoMessage() {}
+func (*ListObjectCountryVisitsResponse) ProtoMessage() {}
 
-func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
+func (x *ListObjectCountryVisitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,16 +355,16 @@ func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListDriversResponse) GetDrivers() []*Driver {
+func (x *ListObjectCountryVisitsResponse) GetCountryVisits() []*CountryVisit {
 	if x != nil {
-		if x.xxx_hidden_Drivers != nil {
-			return *x.xxx_hidden_Drivers
+		if x.xxx_hidden_CountryVisits != nil {
+			return *x.xxx_hidden_CountryVisits
 		}
 	}
 	return nil
 }
 
-func (x *ListDriversResponse) GetContinuationToken() string {
+func (x *ListObjectCountryVisitsResponse) GetContinuationToken() string {
 	if x != nil {
 		if x.xxx_hidden_ContinuationToken != nil {
 			return *x.xxx_hidden_ContinuationToken
@@ -255,41 +374,41 @@ func (x *ListDriversResponse) GetContinuationToken() string {
 	return ""
 }
 
-func (x *ListDriversResponse) SetDrivers(v []*Driver) {
-	x.xxx_hidden_Drivers = &v
+func (x *ListObjectCountryVisitsResponse) SetCountryVisits(v []*CountryVisit) {
+	x.xxx_hidden_CountryVisits = &v
 }
 
-func (x *ListDriversResponse) SetContinuationToken(v string) {
+func (x *ListObjectCountryVisitsResponse) SetContinuationToken(v string) {
 	x.xxx_hidden_ContinuationToken = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *ListDriversResponse) HasContinuationToken() bool {
+func (x *ListObjectCountryVisitsResponse) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *ListDriversResponse) ClearContinuationToken() {
+func (x *ListObjectCountryVisitsResponse) ClearContinuationToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_ContinuationToken = nil
 }
 
-type ListDriversResponse_builder struct {
+type ListObjectCountryVisitsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The drivers.
-	Drivers []*Driver
+	// The country visits.
+	CountryVisits []*CountryVisit
 	// Continuation token for the next page, empty when no more results.
 	ContinuationToken *string
 }
 
-func (b0 ListDriversResponse_builder) Build() *ListDriversResponse {
-	m0 := &ListDriversResponse{}
+func (b0 ListObjectCountryVisitsResponse_builder) Build() *ListObjectCountryVisitsResponse {
+	m0 := &ListObjectCountryVisitsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Drivers = &b.Drivers
+	x.xxx_hidden_CountryVisits = &b.CountryVisits
 	if b.ContinuationToken != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
 		x.xxx_hidden_ContinuationToken = b.ContinuationToken
@@ -297,10 +416,10 @@ func (b0 ListDriversResponse_builder) Build() *ListDriversResponse {
 	return m0
 }
 
-// Request for ListFuelEvents.
-type ListFuelEventsRequest struct {
+// Request for ListDriverCountryVisits.
+type ListDriverCountryVisitsRequest struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ObjectId          *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_DriverId          *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
 	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
 	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
 	xxx_hidden_Limit             int32                  `protobuf:"varint,4,opt,name=limit"`
@@ -311,20 +430,20 @@ type ListFuelEventsRequest struct {
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListFuelEventsRequest) Reset() {
-	*x = ListFuelEventsRequest{}
+func (x *ListDriverCountryVisitsRequest) Reset() {
+	*x = ListDriverCountryVisitsRequest{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFuelEventsRequest) String() string {
+func (x *ListDriverCountryVisitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFuelEventsRequest) ProtoMessage() {}
+func (*ListDriverCountryVisitsRequest) ProtoMessage() {}
 
-func (x *ListFuelEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDriverCountryVisitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,38 +455,38 @@ func (x *ListFuelEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListFuelEventsRequest) GetObjectId() string {
+func (x *ListDriverCountryVisitsRequest) GetDriverId() string {
 	if x != nil {
-		if x.xxx_hidden_ObjectId != nil {
-			return *x.xxx_hidden_ObjectId
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *ListFuelEventsRequest) GetFromTime() *timestamppb.Timestamp {
+func (x *ListDriverCountryVisitsRequest) GetFromTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_FromTime
 	}
 	return nil
 }
 
-func (x *ListFuelEventsRequest) GetToTime() *timestamppb.Timestamp {
+func (x *ListDriverCountryVisitsRequest) GetToTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_ToTime
 	}
 	return nil
 }
 
-func (x *ListFuelEventsRequest) GetLimit() int32 {
+func (x *ListDriverCountryVisitsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.xxx_hidden_Limit
 	}
 	return 0
 }
 
-func (x *ListFuelEventsRequest) GetContinuationToken() string {
+func (x *ListDriverCountryVisitsRequest) GetContinuationToken() string {
 	if x != nil {
 		if x.xxx_hidden_ContinuationToken != nil {
 			return *x.xxx_hidden_ContinuationToken
@@ -377,109 +496,109 @@ func (x *ListFuelEventsRequest) GetContinuationToken() string {
 	return ""
 }
 
-func (x *ListFuelEventsRequest) SetObjectId(v string) {
-	x.xxx_hidden_ObjectId = &v
+func (x *ListDriverCountryVisitsRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
 }
 
-func (x *ListFuelEventsRequest) SetFromTime(v *timestamppb.Timestamp) {
+func (x *ListDriverCountryVisitsRequest) SetFromTime(v *timestamppb.Timestamp) {
 	x.xxx_hidden_FromTime = v
 }
 
-func (x *ListFuelEventsRequest) SetToTime(v *timestamppb.Timestamp) {
+func (x *ListDriverCountryVisitsRequest) SetToTime(v *timestamppb.Timestamp) {
 	x.xxx_hidden_ToTime = v
 }
 
-func (x *ListFuelEventsRequest) SetLimit(v int32) {
+func (x *ListDriverCountryVisitsRequest) SetLimit(v int32) {
 	x.xxx_hidden_Limit = v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
 }
 
-func (x *ListFuelEventsRequest) SetContinuationToken(v string) {
+func (x *ListDriverCountryVisitsRequest) SetContinuationToken(v string) {
 	x.xxx_hidden_ContinuationToken = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
 }
 
-func (x *ListFuelEventsRequest) HasObjectId() bool {
+func (x *ListDriverCountryVisitsRequest) HasDriverId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListFuelEventsRequest) HasFromTime() bool {
+func (x *ListDriverCountryVisitsRequest) HasFromTime() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_FromTime != nil
 }
 
-func (x *ListFuelEventsRequest) HasToTime() bool {
+func (x *ListDriverCountryVisitsRequest) HasToTime() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_ToTime != nil
 }
 
-func (x *ListFuelEventsRequest) HasLimit() bool {
+func (x *ListDriverCountryVisitsRequest) HasLimit() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *ListFuelEventsRequest) HasContinuationToken() bool {
+func (x *ListDriverCountryVisitsRequest) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
-func (x *ListFuelEventsRequest) ClearObjectId() {
+func (x *ListDriverCountryVisitsRequest) ClearDriverId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_ObjectId = nil
+	x.xxx_hidden_DriverId = nil
 }
 
-func (x *ListFuelEventsRequest) ClearFromTime() {
+func (x *ListDriverCountryVisitsRequest) ClearFromTime() {
 	x.xxx_hidden_FromTime = nil
 }
 
-func (x *ListFuelEventsRequest) ClearToTime() {
+func (x *ListDriverCountryVisitsRequest) ClearToTime() {
 	x.xxx_hidden_ToTime = nil
 }
 
-func (x *ListFuelEventsRequest) ClearLimit() {
+func (x *ListDriverCountryVisitsRequest) ClearLimit() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
 	x.xxx_hidden_Limit = 0
 }
 
-func (x *ListFuelEventsRequest) ClearContinuationToken() {
+func (x *ListDriverCountryVisitsRequest) ClearContinuationToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
 	x.xxx_hidden_ContinuationToken = nil
 }
 
-type ListFuelEventsRequest_builder struct {
+type ListDriverCountryVisitsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The ID of the object to get fuel events for.
-	ObjectId *string
+	// The ID of the driver.
+	DriverId *string
 	// Start of the time window (inclusive).
 	FromTime *timestamppb.Timestamp
 	// End of the time window (exclusive, optional).
 	ToTime *timestamppb.Timestamp
-	// Max results to return (default 100, max 1000).
+	// Max results to return.
 	Limit *int32
 	// Continuation token from a previous response.
 	ContinuationToken *string
 }
 
-func (b0 ListFuelEventsRequest_builder) Build() *ListFuelEventsRequest {
-	m0 := &ListFuelEventsRequest{}
+func (b0 ListDriverCountryVisitsRequest_builder) Build() *ListDriverCountryVisitsRequest {
+	m0 := &ListDriverCountryVisitsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.ObjectId != nil {
+	if b.DriverId != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
-		x.xxx_hidden_ObjectId = b.ObjectId
+		x.xxx_hidden_DriverId = b.DriverId
 	}
 	x.xxx_hidden_FromTime = b.FromTime
 	x.xxx_hidden_ToTime = b.ToTime
@@ -494,10 +613,10 @@ func (b0 ListFuelEventsRequest_builder) Build() *ListFuelEventsRequest {
 	return m0
 }
 
-// Response for ListFuelEvents.
-type ListFuelEventsResponse struct {
+// Response for ListDriverCountryVisits.
+type ListDriverCountryVisitsResponse struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_FuelEvents        *[]*FuelEvent          `protobuf:"bytes,1,rep,name=fuel_events,json=fuelEvents"`
+	xxx_hidden_CountryVisits     *[]*CountryVisit       `protobuf:"bytes,1,rep,name=country_visits,json=countryVisits"`
 	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
@@ -505,20 +624,20 @@ type ListFuelEventsResponse struct {
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListFuelEventsResponse) Reset() {
-	*x = ListFuelEventsResponse{}
+func (x *ListDriverCountryVisitsResponse) Reset() {
+	*x = ListDriverCountryVisitsResponse{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFuelEventsResponse) String() string {
+func (x *ListDriverCountryVisitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFuelEventsResponse) ProtoMessage() {}
+func (*ListDriverCountryVisitsResponse) ProtoMessage() {}
 
-func (x *ListFuelEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDriverCountryVisitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -530,16 +649,16 @@ func (x *ListFuelEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListFuelEventsResponse) GetFuelEvents() []*FuelEvent {
+func (x *ListDriverCountryVisitsResponse) GetCountryVisits() []*CountryVisit {
 	if x != nil {
-		if x.xxx_hidden_FuelEvents != nil {
-			return *x.xxx_hidden_FuelEvents
+		if x.xxx_hidden_CountryVisits != nil {
+			return *x.xxx_hidden_CountryVisits
 		}
 	}
 	return nil
 }
 
-func (x *ListFuelEventsResponse) GetContinuationToken() string {
+func (x *ListDriverCountryVisitsResponse) GetContinuationToken() string {
 	if x != nil {
 		if x.xxx_hidden_ContinuationToken != nil {
 			return *x.xxx_hidden_ContinuationToken
@@ -549,41 +668,41 @@ func (x *ListFuelEventsResponse) GetContinuationToken() string {
 	return ""
 }
 
-func (x *ListFuelEventsResponse) SetFuelEvents(v []*FuelEvent) {
-	x.xxx_hidden_FuelEvents = &v
+func (x *ListDriverCountryVisitsResponse) SetCountryVisits(v []*CountryVisit) {
+	x.xxx_hidden_CountryVisits = &v
 }
 
-func (x *ListFuelEventsResponse) SetContinuationToken(v string) {
+func (x *ListDriverCountryVisitsResponse) SetContinuationToken(v string) {
 	x.xxx_hidden_ContinuationToken = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *ListFuelEventsResponse) HasContinuationToken() bool {
+func (x *ListDriverCountryVisitsResponse) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *ListFuelEventsResponse) ClearContinuationToken() {
+func (x *ListDriverCountryVisitsResponse) ClearContinuationToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_ContinuationToken = nil
 }
 
-type ListFuelEventsResponse_builder struct {
+type ListDriverCountryVisitsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The fuel events.
-	FuelEvents []*FuelEvent
+	// The country visits.
+	CountryVisits []*CountryVisit
 	// Continuation token for the next page, empty when no more results.
 	ContinuationToken *string
 }
 
-func (b0 ListFuelEventsResponse_builder) Build() *ListFuelEventsResponse {
-	m0 := &ListFuelEventsResponse{}
+func (b0 ListDriverCountryVisitsResponse_builder) Build() *ListDriverCountryVisitsResponse {
+	m0 := &ListDriverCountryVisitsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_FuelEvents = &b.FuelEvents
+	x.xxx_hidden_CountryVisits = &b.CountryVisits
 	if b.ContinuationToken != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
 		x.xxx_hidden_ContinuationToken = b.ContinuationToken
@@ -591,30 +710,35 @@ func (b0 ListFuelEventsResponse_builder) Build() *ListFuelEventsResponse {
 	return m0
 }
 
-// Request for GetObjectGroup.
-type GetObjectGroupRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ExternalId  *string                `protobuf:"bytes,1,opt,name=external_id,json=externalId"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// Request for ListDetectedEvents.
+type ListDetectedEventsRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId          *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_UserId            int64                  `protobuf:"varint,2,opt,name=user_id,json=userId"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_time,json=toTime"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,5,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,6,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *GetObjectGroupRequest) Reset() {
-	*x = GetObjectGroupRequest{}
+func (x *ListDetectedEventsRequest) Reset() {
+	*x = ListDetectedEventsRequest{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetObjectGroupRequest) String() string {
+func (x *ListDetectedEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectGroupRequest) ProtoMessage() {}
+func (*ListDetectedEventsRequest) ProtoMessage() {}
 
-func (x *GetObjectGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDetectedEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,73 +750,219 @@ func (x *GetObjectGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetObjectGroupRequest) GetExternalId() string {
+func (x *ListDetectedEventsRequest) GetObjectId() string {
 	if x != nil {
-		if x.xxx_hidden_ExternalId != nil {
-			return *x.xxx_hidden_ExternalId
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetObjectGroupRequest) SetExternalId(v string) {
-	x.xxx_hidden_ExternalId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *ListDetectedEventsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
 }
 
-func (x *GetObjectGroupRequest) HasExternalId() bool {
+func (x *ListDetectedEventsRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListDetectedEventsRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListDetectedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListDetectedEventsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDetectedEventsRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *ListDetectedEventsRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *ListDetectedEventsRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListDetectedEventsRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListDetectedEventsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *ListDetectedEventsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *ListDetectedEventsRequest) HasObjectId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetObjectGroupRequest) ClearExternalId() {
+func (x *ListDetectedEventsRequest) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListDetectedEventsRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListDetectedEventsRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListDetectedEventsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListDetectedEventsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ListDetectedEventsRequest) ClearObjectId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_ExternalId = nil
+	x.xxx_hidden_ObjectId = nil
 }
 
-type GetObjectGroupRequest_builder struct {
+func (x *ListDetectedEventsRequest) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = 0
+}
+
+func (x *ListDetectedEventsRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListDetectedEventsRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListDetectedEventsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListDetectedEventsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListDetectedEventsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The external ID of the object group.
-	ExternalId *string
+	// The ID of the object to get detected events for.
+	ObjectId *string
+	// Filter by the ID of the user the events were detected for.
+	UserId *int64
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive).
+	ToTime *timestamppb.Timestamp
+	// Max results to return (default 100).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
 }
 
-func (b0 GetObjectGroupRequest_builder) Build() *GetObjectGroupRequest {
-	m0 := &GetObjectGroupRequest{}
+func (b0 ListDetectedEventsRequest_builder) Build() *ListDetectedEventsRequest {
+	m0 := &ListDetectedEventsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.ExternalId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_ExternalId = b.ExternalId
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
 	}
 	return m0
 }
 
-// Response for GetObjectGroup.
-type GetObjectGroupResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ObjectGroup *ObjectGroup           `protobuf:"bytes,1,opt,name=object_group,json=objectGroup"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// Response for ListDetectedEvents.
+type ListDetectedEventsResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DetectedEvents    *[]*DetectedEvent      `protobuf:"bytes,1,rep,name=detected_events,json=detectedEvents"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *GetObjectGroupResponse) Reset() {
-	*x = GetObjectGroupResponse{}
+func (x *ListDetectedEventsResponse) Reset() {
+	*x = ListDetectedEventsResponse{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetObjectGroupResponse) String() string {
+func (x *ListDetectedEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectGroupResponse) ProtoMessage() {}
+func (*ListDetectedEventsResponse) ProtoMessage() {}
 
-func (x *GetObjectGroupResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDetectedEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,68 +974,94 @@ func (x *GetObjectGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetObjectGroupResponse) GetObjectGroup() *ObjectGroup {
+func (x *ListDetectedEventsResponse) GetDetectedEvents() []*DetectedEvent {
 	if x != nil {
-		return x.xxx_hidden_ObjectGroup
+		if x.xxx_hidden_DetectedEvents != nil {
+			return *x.xxx_hidden_DetectedEvents
+		}
 	}
 	return nil
 }
 
-func (x *GetObjectGroupResponse) SetObjectGroup(v *ObjectGroup) {
-	x.xxx_hidden_ObjectGroup = v
+func (x *ListDetectedEventsResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *GetObjectGroupResponse) HasObjectGroup() bool {
+func (x *ListDetectedEventsResponse) SetDetectedEvents(v []*DetectedEvent) {
+	x.xxx_hidden_DetectedEvents = &v
+}
+
+func (x *ListDetectedEventsResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListDetectedEventsResponse) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_ObjectGroup != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *GetObjectGroupResponse) ClearObjectGroup() {
-	x.xxx_hidden_ObjectGroup = nil
+func (x *ListDetectedEventsResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
 }
 
-type GetObjectGroupResponse_builder struct {
+type ListDetectedEventsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The object group.
-	ObjectGroup *ObjectGroup
+	// The detected events.
+	DetectedEvents []*DetectedEvent
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
 }
 
-func (b0 GetObjectGroupResponse_builder) Build() *GetObjectGroupResponse {
-	m0 := &GetObjectGroupResponse{}
+func (b0 ListDetectedEventsResponse_builder) Build() *ListDetectedEventsResponse {
+	m0 := &ListDetectedEventsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_ObjectGroup = b.ObjectGroup
+	x.xxx_hidden_DetectedEvents = &b.DetectedEvents
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
 	return m0
 }
 
-// Request for ListObjectGroups.
-type ListObjectGroupsRequest struct {
+// Request for ListDrivers.
+type ListDriversRequest struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
 	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	xxx_hidden_IdentifierType    *string                `protobuf:"bytes,3,opt,name=identifier_type,json=identifierType"`
+	xxx_hidden_Identifier        *string                `protobuf:"bytes,4,opt,name=identifier"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListObjectGroupsRequest) Reset() {
-	*x = ListObjectGroupsRequest{}
+func (x *ListDriversRequest) Reset() {
+	*x = ListDriversRequest{}
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectGroupsRequest) String() string {
+func (x *ListDriversRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectGroupsRequest) ProtoMessage() {}
+func (*ListDriversRequest) ProtoMessage() {}
 
-func (x *ListObjectGroupsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,107 +1073,6733 @@ func (x *ListObjectGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectGroupsRequest) GetLimit() int32 {
+func (x *ListDriversRequest) GetLimit() int32 {
 	if x != nil {
 		return x.xxx_hidden_Limit
 	}
 	return 0
 }
 
-func (x *ListObjectGroupsRequest) GetContinuationToken() string {
+func (x *ListDriversRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetIdentifierType() string {
+	if x != nil {
+		if x.xxx_hidden_IdentifierType != nil {
+			return *x.xxx_hidden_IdentifierType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetIdentifier() string {
+	if x != nil {
+		if x.xxx_hidden_Identifier != nil {
+			return *x.xxx_hidden_Identifier
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *ListDriversRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *ListDriversRequest) SetIdentifierType(v string) {
+	x.xxx_hidden_IdentifierType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *ListDriversRequest) SetIdentifier(v string) {
+	x.xxx_hidden_Identifier = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *ListDriversRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListDriversRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListDriversRequest) HasIdentifierType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ListDriversRequest) HasIdentifier() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ListDriversRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListDriversRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+func (x *ListDriversRequest) ClearIdentifierType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IdentifierType = nil
+}
+
+func (x *ListDriversRequest) ClearIdentifier() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Identifier = nil
+}
+
+type ListDriversRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 100, max 1000).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+	// Filter by identifier type.
+	IdentifierType *string
+	// Filter by identifier value.
+	Identifier *string
+}
+
+func (b0 ListDriversRequest_builder) Build() *ListDriversRequest {
+	m0 := &ListDriversRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	if b.IdentifierType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_IdentifierType = b.IdentifierType
+	}
+	if b.Identifier != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Identifier = b.Identifier
+	}
+	return m0
+}
+
+// Response for ListDrivers.
+type ListDriversResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Drivers           *[]*Driver             `protobuf:"bytes,1,rep,name=drivers"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListDriversResponse) Reset() {
+	*x = ListDriversResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversResponse) ProtoMessage() {}
+
+func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriversResponse) GetDrivers() []*Driver {
+	if x != nil {
+		if x.xxx_hidden_Drivers != nil {
+			return *x.xxx_hidden_Drivers
+		}
+	}
+	return nil
+}
+
+func (x *ListDriversResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriversResponse) SetDrivers(v []*Driver) {
+	x.xxx_hidden_Drivers = &v
+}
+
+func (x *ListDriversResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListDriversResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListDriversResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListDriversResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The drivers.
+	Drivers []*Driver
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListDriversResponse_builder) Build() *ListDriversResponse {
+	m0 := &ListDriversResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Drivers = &b.Drivers
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for CreateDriver.
+type CreateDriverRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Driver *Driver                `protobuf:"bytes,1,opt,name=driver"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateDriverRequest) Reset() {
+	*x = CreateDriverRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverRequest) ProtoMessage() {}
+
+func (x *CreateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDriverRequest) GetDriver() *Driver {
+	if x != nil {
+		return x.xxx_hidden_Driver
+	}
+	return nil
+}
+
+func (x *CreateDriverRequest) SetDriver(v *Driver) {
+	x.xxx_hidden_Driver = v
+}
+
+func (x *CreateDriverRequest) HasDriver() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driver != nil
+}
+
+func (x *CreateDriverRequest) ClearDriver() {
+	x.xxx_hidden_Driver = nil
+}
+
+type CreateDriverRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver to create.
+	// The ID of the driver is assigned by TrustTrack when not set.
+	Driver *Driver
+}
+
+func (b0 CreateDriverRequest_builder) Build() *CreateDriverRequest {
+	m0 := &CreateDriverRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driver = b.Driver
+	return m0
+}
+
+// Response for CreateDriver.
+type CreateDriverResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Driver *Driver                `protobuf:"bytes,1,opt,name=driver"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateDriverResponse) Reset() {
+	*x = CreateDriverResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverResponse) ProtoMessage() {}
+
+func (x *CreateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.xxx_hidden_Driver
+	}
+	return nil
+}
+
+func (x *CreateDriverResponse) SetDriver(v *Driver) {
+	x.xxx_hidden_Driver = v
+}
+
+func (x *CreateDriverResponse) HasDriver() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driver != nil
+}
+
+func (x *CreateDriverResponse) ClearDriver() {
+	x.xxx_hidden_Driver = nil
+}
+
+type CreateDriverResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The created driver.
+	Driver *Driver
+}
+
+func (b0 CreateDriverResponse_builder) Build() *CreateDriverResponse {
+	m0 := &CreateDriverResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driver = b.Driver
+	return m0
+}
+
+// Request for UpdateDriver.
+type UpdateDriverRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Driver     *Driver                `protobuf:"bytes,1,opt,name=driver"`
+	xxx_hidden_UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateDriverRequest) Reset() {
+	*x = UpdateDriverRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverRequest) ProtoMessage() {}
+
+func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateDriverRequest) GetDriver() *Driver {
+	if x != nil {
+		return x.xxx_hidden_Driver
+	}
+	return nil
+}
+
+func (x *UpdateDriverRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.xxx_hidden_UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateDriverRequest) SetDriver(v *Driver) {
+	x.xxx_hidden_Driver = v
+}
+
+func (x *UpdateDriverRequest) SetUpdateMask(v *fieldmaskpb.FieldMask) {
+	x.xxx_hidden_UpdateMask = v
+}
+
+func (x *UpdateDriverRequest) HasDriver() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driver != nil
+}
+
+func (x *UpdateDriverRequest) HasUpdateMask() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdateMask != nil
+}
+
+func (x *UpdateDriverRequest) ClearDriver() {
+	x.xxx_hidden_Driver = nil
+}
+
+func (x *UpdateDriverRequest) ClearUpdateMask() {
+	x.xxx_hidden_UpdateMask = nil
+}
+
+type UpdateDriverRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver to update, identified by its ID.
+	Driver *Driver
+	// The fields to update.
+	// All fields are replaced when the mask is empty.
+	UpdateMask *fieldmaskpb.FieldMask
+}
+
+func (b0 UpdateDriverRequest_builder) Build() *UpdateDriverRequest {
+	m0 := &UpdateDriverRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driver = b.Driver
+	x.xxx_hidden_UpdateMask = b.UpdateMask
+	return m0
+}
+
+// Response for UpdateDriver.
+type UpdateDriverResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Driver *Driver                `protobuf:"bytes,1,opt,name=driver"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateDriverResponse) Reset() {
+	*x = UpdateDriverResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverResponse) ProtoMessage() {}
+
+func (x *UpdateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.xxx_hidden_Driver
+	}
+	return nil
+}
+
+func (x *UpdateDriverResponse) SetDriver(v *Driver) {
+	x.xxx_hidden_Driver = v
+}
+
+func (x *UpdateDriverResponse) HasDriver() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driver != nil
+}
+
+func (x *UpdateDriverResponse) ClearDriver() {
+	x.xxx_hidden_Driver = nil
+}
+
+type UpdateDriverResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The updated driver.
+	Driver *Driver
+}
+
+func (b0 UpdateDriverResponse_builder) Build() *UpdateDriverResponse {
+	m0 := &UpdateDriverResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Driver = b.Driver
+	return m0
+}
+
+// Request for DeleteDriver.
+type DeleteDriverRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteDriverRequest) Reset() {
+	*x = DeleteDriverRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDriverRequest) ProtoMessage() {}
+
+func (x *DeleteDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteDriverRequest) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteDriverRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteDriverRequest) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteDriverRequest) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+type DeleteDriverRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver to delete.
+	DriverId *string
+}
+
+func (b0 DeleteDriverRequest_builder) Build() *DeleteDriverRequest {
+	m0 := &DeleteDriverRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	return m0
+}
+
+// Response for DeleteDriver.
+type DeleteDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDriverResponse) Reset() {
+	*x = DeleteDriverResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDriverResponse) ProtoMessage() {}
+
+func (x *DeleteDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteDriverResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteDriverResponse_builder) Build() *DeleteDriverResponse {
+	m0 := &DeleteDriverResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Request for GetLastDriverAssignation.
+type GetLastDriverAssignationRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,2,opt,name=object_id,json=objectId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetLastDriverAssignationRequest) Reset() {
+	*x = GetLastDriverAssignationRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastDriverAssignationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastDriverAssignationRequest) ProtoMessage() {}
+
+func (x *GetLastDriverAssignationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetLastDriverAssignationRequest) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetLastDriverAssignationRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetLastDriverAssignationRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *GetLastDriverAssignationRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetLastDriverAssignationRequest) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetLastDriverAssignationRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetLastDriverAssignationRequest) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *GetLastDriverAssignationRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ObjectId = nil
+}
+
+type GetLastDriverAssignationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver to get the last assignation for.
+	// Exactly one of driver_id and object_id should be set.
+	DriverId *string
+	// The ID of the object to get the last assignation for.
+	// Exactly one of driver_id and object_id should be set.
+	ObjectId *string
+}
+
+func (b0 GetLastDriverAssignationRequest_builder) Build() *GetLastDriverAssignationRequest {
+	m0 := &GetLastDriverAssignationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	return m0
+}
+
+// Response for GetLastDriverAssignation.
+type GetLastDriverAssignationResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverAssignation *DriverAssignation     `protobuf:"bytes,1,opt,name=driver_assignation,json=driverAssignation"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *GetLastDriverAssignationResponse) Reset() {
+	*x = GetLastDriverAssignationResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastDriverAssignationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastDriverAssignationResponse) ProtoMessage() {}
+
+func (x *GetLastDriverAssignationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetLastDriverAssignationResponse) GetDriverAssignation() *DriverAssignation {
+	if x != nil {
+		return x.xxx_hidden_DriverAssignation
+	}
+	return nil
+}
+
+func (x *GetLastDriverAssignationResponse) SetDriverAssignation(v *DriverAssignation) {
+	x.xxx_hidden_DriverAssignation = v
+}
+
+func (x *GetLastDriverAssignationResponse) HasDriverAssignation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverAssignation != nil
+}
+
+func (x *GetLastDriverAssignationResponse) ClearDriverAssignation() {
+	x.xxx_hidden_DriverAssignation = nil
+}
+
+type GetLastDriverAssignationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The last driver assignation.
+	DriverAssignation *DriverAssignation
+}
+
+func (b0 GetLastDriverAssignationResponse_builder) Build() *GetLastDriverAssignationResponse {
+	m0 := &GetLastDriverAssignationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverAssignation = b.DriverAssignation
+	return m0
+}
+
+// Request for CreateDriverAssignation.
+type CreateDriverAssignationRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverAssignation *DriverAssignation     `protobuf:"bytes,1,opt,name=driver_assignation,json=driverAssignation"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CreateDriverAssignationRequest) Reset() {
+	*x = CreateDriverAssignationRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverAssignationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverAssignationRequest) ProtoMessage() {}
+
+func (x *CreateDriverAssignationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDriverAssignationRequest) GetDriverAssignation() *DriverAssignation {
+	if x != nil {
+		return x.xxx_hidden_DriverAssignation
+	}
+	return nil
+}
+
+func (x *CreateDriverAssignationRequest) SetDriverAssignation(v *DriverAssignation) {
+	x.xxx_hidden_DriverAssignation = v
+}
+
+func (x *CreateDriverAssignationRequest) HasDriverAssignation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverAssignation != nil
+}
+
+func (x *CreateDriverAssignationRequest) ClearDriverAssignation() {
+	x.xxx_hidden_DriverAssignation = nil
+}
+
+type CreateDriverAssignationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver assignation to create.
+	DriverAssignation *DriverAssignation
+}
+
+func (b0 CreateDriverAssignationRequest_builder) Build() *CreateDriverAssignationRequest {
+	m0 := &CreateDriverAssignationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverAssignation = b.DriverAssignation
+	return m0
+}
+
+// Response for CreateDriverAssignation.
+type CreateDriverAssignationResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverAssignation *DriverAssignation     `protobuf:"bytes,1,opt,name=driver_assignation,json=driverAssignation"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CreateDriverAssignationResponse) Reset() {
+	*x = CreateDriverAssignationResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverAssignationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverAssignationResponse) ProtoMessage() {}
+
+func (x *CreateDriverAssignationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDriverAssignationResponse) GetDriverAssignation() *DriverAssignation {
+	if x != nil {
+		return x.xxx_hidden_DriverAssignation
+	}
+	return nil
+}
+
+func (x *CreateDriverAssignationResponse) SetDriverAssignation(v *DriverAssignation) {
+	x.xxx_hidden_DriverAssignation = v
+}
+
+func (x *CreateDriverAssignationResponse) HasDriverAssignation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverAssignation != nil
+}
+
+func (x *CreateDriverAssignationResponse) ClearDriverAssignation() {
+	x.xxx_hidden_DriverAssignation = nil
+}
+
+type CreateDriverAssignationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The created driver assignation.
+	DriverAssignation *DriverAssignation
+}
+
+func (b0 CreateDriverAssignationResponse_builder) Build() *CreateDriverAssignationResponse {
+	m0 := &CreateDriverAssignationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverAssignation = b.DriverAssignation
+	return m0
+}
+
+// Request for GetDriverTimeAnalysis.
+type GetDriverTimeAnalysisRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetDriverTimeAnalysisRequest) Reset() {
+	*x = GetDriverTimeAnalysisRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverTimeAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverTimeAnalysisRequest) ProtoMessage() {}
+
+func (x *GetDriverTimeAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDriverTimeAnalysisRequest) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetDriverTimeAnalysisRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetDriverTimeAnalysisRequest) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetDriverTimeAnalysisRequest) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+type GetDriverTimeAnalysisRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver.
+	DriverId *string
+}
+
+func (b0 GetDriverTimeAnalysisRequest_builder) Build() *GetDriverTimeAnalysisRequest {
+	m0 := &GetDriverTimeAnalysisRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	return m0
+}
+
+// Response for GetDriverTimeAnalysis.
+type GetDriverTimeAnalysisResponse struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverTimeAnalysis *DriverTimeAnalysis    `protobuf:"bytes,1,opt,name=driver_time_analysis,json=driverTimeAnalysis"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *GetDriverTimeAnalysisResponse) Reset() {
+	*x = GetDriverTimeAnalysisResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverTimeAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverTimeAnalysisResponse) ProtoMessage() {}
+
+func (x *GetDriverTimeAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDriverTimeAnalysisResponse) GetDriverTimeAnalysis() *DriverTimeAnalysis {
+	if x != nil {
+		return x.xxx_hidden_DriverTimeAnalysis
+	}
+	return nil
+}
+
+func (x *GetDriverTimeAnalysisResponse) SetDriverTimeAnalysis(v *DriverTimeAnalysis) {
+	x.xxx_hidden_DriverTimeAnalysis = v
+}
+
+func (x *GetDriverTimeAnalysisResponse) HasDriverTimeAnalysis() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverTimeAnalysis != nil
+}
+
+func (x *GetDriverTimeAnalysisResponse) ClearDriverTimeAnalysis() {
+	x.xxx_hidden_DriverTimeAnalysis = nil
+}
+
+type GetDriverTimeAnalysisResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver time analysis.
+	DriverTimeAnalysis *DriverTimeAnalysis
+}
+
+func (b0 GetDriverTimeAnalysisResponse_builder) Build() *GetDriverTimeAnalysisResponse {
+	m0 := &GetDriverTimeAnalysisResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverTimeAnalysis = b.DriverTimeAnalysis
+	return m0
+}
+
+// Request for GetDriverTimeTable.
+type GetDriverTimeTableRequest struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverIdentifier       *DriverIdentifier      `protobuf:"bytes,1,opt,name=driver_identifier,json=driverIdentifier"`
+	xxx_hidden_TimeZone               *string                `protobuf:"bytes,2,opt,name=time_zone,json=timeZone"`
+	xxx_hidden_Month                  int32                  `protobuf:"varint,3,opt,name=month"`
+	xxx_hidden_Year                   int32                  `protobuf:"varint,4,opt,name=year"`
+	xxx_hidden_BusinessHoursStart     *string                `protobuf:"bytes,5,opt,name=business_hours_start,json=businessHoursStart"`
+	xxx_hidden_BusinessHoursEnd       *string                `protobuf:"bytes,6,opt,name=business_hours_end,json=businessHoursEnd"`
+	xxx_hidden_AvailableAsWorkingTime bool                   `protobuf:"varint,7,opt,name=available_as_working_time,json=availableAsWorkingTime"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *GetDriverTimeTableRequest) Reset() {
+	*x = GetDriverTimeTableRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverTimeTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverTimeTableRequest) ProtoMessage() {}
+
+func (x *GetDriverTimeTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDriverTimeTableRequest) GetDriverIdentifier() *DriverIdentifier {
+	if x != nil {
+		return x.xxx_hidden_DriverIdentifier
+	}
+	return nil
+}
+
+func (x *GetDriverTimeTableRequest) GetTimeZone() string {
+	if x != nil {
+		if x.xxx_hidden_TimeZone != nil {
+			return *x.xxx_hidden_TimeZone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetDriverTimeTableRequest) GetMonth() int32 {
+	if x != nil {
+		return x.xxx_hidden_Month
+	}
+	return 0
+}
+
+func (x *GetDriverTimeTableRequest) GetYear() int32 {
+	if x != nil {
+		return x.xxx_hidden_Year
+	}
+	return 0
+}
+
+func (x *GetDriverTimeTableRequest) GetBusinessHoursStart() string {
+	if x != nil {
+		if x.xxx_hidden_BusinessHoursStart != nil {
+			return *x.xxx_hidden_BusinessHoursStart
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetDriverTimeTableRequest) GetBusinessHoursEnd() string {
+	if x != nil {
+		if x.xxx_hidden_BusinessHoursEnd != nil {
+			return *x.xxx_hidden_BusinessHoursEnd
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetDriverTimeTableRequest) GetAvailableAsWorkingTime() bool {
+	if x != nil {
+		return x.xxx_hidden_AvailableAsWorkingTime
+	}
+	return false
+}
+
+func (x *GetDriverTimeTableRequest) SetDriverIdentifier(v *DriverIdentifier) {
+	x.xxx_hidden_DriverIdentifier = v
+}
+
+func (x *GetDriverTimeTableRequest) SetTimeZone(v string) {
+	x.xxx_hidden_TimeZone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *GetDriverTimeTableRequest) SetMonth(v int32) {
+	x.xxx_hidden_Month = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *GetDriverTimeTableRequest) SetYear(v int32) {
+	x.xxx_hidden_Year = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *GetDriverTimeTableRequest) SetBusinessHoursStart(v string) {
+	x.xxx_hidden_BusinessHoursStart = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *GetDriverTimeTableRequest) SetBusinessHoursEnd(v string) {
+	x.xxx_hidden_BusinessHoursEnd = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *GetDriverTimeTableRequest) SetAvailableAsWorkingTime(v bool) {
+	x.xxx_hidden_AvailableAsWorkingTime = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *GetDriverTimeTableRequest) HasDriverIdentifier() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverIdentifier != nil
+}
+
+func (x *GetDriverTimeTableRequest) HasTimeZone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetDriverTimeTableRequest) HasMonth() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetDriverTimeTableRequest) HasYear() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetDriverTimeTableRequest) HasBusinessHoursStart() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GetDriverTimeTableRequest) HasBusinessHoursEnd() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *GetDriverTimeTableRequest) HasAvailableAsWorkingTime() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GetDriverTimeTableRequest) ClearDriverIdentifier() {
+	x.xxx_hidden_DriverIdentifier = nil
+}
+
+func (x *GetDriverTimeTableRequest) ClearTimeZone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TimeZone = nil
+}
+
+func (x *GetDriverTimeTableRequest) ClearMonth() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Month = 0
+}
+
+func (x *GetDriverTimeTableRequest) ClearYear() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Year = 0
+}
+
+func (x *GetDriverTimeTableRequest) ClearBusinessHoursStart() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_BusinessHoursStart = nil
+}
+
+func (x *GetDriverTimeTableRequest) ClearBusinessHoursEnd() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_BusinessHoursEnd = nil
+}
+
+func (x *GetDriverTimeTableRequest) ClearAvailableAsWorkingTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_AvailableAsWorkingTime = false
+}
+
+type GetDriverTimeTableRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The identifier of the driver.
+	DriverIdentifier *DriverIdentifier
+	// The IANA time zone used to calculate the time table, e.g. "Europe/Vilnius".
+	TimeZone *string
+	// The month of the time table (1-12).
+	Month *int32
+	// The year of the time table.
+	Year *int32
+	// The start of business hours in HH:MM format.
+	BusinessHoursStart *string
+	// The end of business hours in HH:MM format.
+	BusinessHoursEnd *string
+	// Whether time with the available status counts as working time.
+	AvailableAsWorkingTime *bool
+}
+
+func (b0 GetDriverTimeTableRequest_builder) Build() *GetDriverTimeTableRequest {
+	m0 := &GetDriverTimeTableRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverIdentifier = b.DriverIdentifier
+	if b.TimeZone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_TimeZone = b.TimeZone
+	}
+	if b.Month != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Month = *b.Month
+	}
+	if b.Year != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Year = *b.Year
+	}
+	if b.BusinessHoursStart != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_BusinessHoursStart = b.BusinessHoursStart
+	}
+	if b.BusinessHoursEnd != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_BusinessHoursEnd = b.BusinessHoursEnd
+	}
+	if b.AvailableAsWorkingTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_AvailableAsWorkingTime = *b.AvailableAsWorkingTime
+	}
+	return m0
+}
+
+// Response for GetDriverTimeTable.
+type GetDriverTimeTableResponse struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverTimeTable *DriverTimeTable       `protobuf:"bytes,1,opt,name=driver_time_table,json=driverTimeTable"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *GetDriverTimeTableResponse) Reset() {
+	*x = GetDriverTimeTableResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverTimeTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverTimeTableResponse) ProtoMessage() {}
+
+func (x *GetDriverTimeTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDriverTimeTableResponse) GetDriverTimeTable() *DriverTimeTable {
+	if x != nil {
+		return x.xxx_hidden_DriverTimeTable
+	}
+	return nil
+}
+
+func (x *GetDriverTimeTableResponse) SetDriverTimeTable(v *DriverTimeTable) {
+	x.xxx_hidden_DriverTimeTable = v
+}
+
+func (x *GetDriverTimeTableResponse) HasDriverTimeTable() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DriverTimeTable != nil
+}
+
+func (x *GetDriverTimeTableResponse) ClearDriverTimeTable() {
+	x.xxx_hidden_DriverTimeTable = nil
+}
+
+type GetDriverTimeTableResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver time table.
+	DriverTimeTable *DriverTimeTable
+}
+
+func (b0 GetDriverTimeTableResponse_builder) Build() *GetDriverTimeTableResponse {
+	m0 := &GetDriverTimeTableResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverTimeTable = b.DriverTimeTable
+	return m0
+}
+
+// Request for ListDriverStates.
+type ListDriverStatesRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId          *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,4,opt,name=continuation_token,json=continuationToken"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,5,opt,name=limit"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListDriverStatesRequest) Reset() {
+	*x = ListDriverStatesRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverStatesRequest) ProtoMessage() {}
+
+func (x *ListDriverStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverStatesRequest) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverStatesRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListDriverStatesRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListDriverStatesRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverStatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListDriverStatesRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ListDriverStatesRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListDriverStatesRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListDriverStatesRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *ListDriverStatesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ListDriverStatesRequest) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListDriverStatesRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListDriverStatesRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListDriverStatesRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ListDriverStatesRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListDriverStatesRequest) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *ListDriverStatesRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListDriverStatesRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListDriverStatesRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+func (x *ListDriverStatesRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Limit = 0
+}
+
+type ListDriverStatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver.
+	DriverId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive).
+	ToTime *timestamppb.Timestamp
+	// Continuation token from a previous response.
+	ContinuationToken *string
+	// Max results to return (default 100).
+	Limit *int32
+}
+
+func (b0 ListDriverStatesRequest_builder) Build() *ListDriverStatesRequest {
+	m0 := &ListDriverStatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	return m0
+}
+
+// Response for ListDriverStates.
+type ListDriverStatesResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverStates      *[]*DriverState        `protobuf:"bytes,1,rep,name=driver_states,json=driverStates"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListDriverStatesResponse) Reset() {
+	*x = ListDriverStatesResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverStatesResponse) ProtoMessage() {}
+
+func (x *ListDriverStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverStatesResponse) GetDriverStates() []*DriverState {
+	if x != nil {
+		if x.xxx_hidden_DriverStates != nil {
+			return *x.xxx_hidden_DriverStates
+		}
+	}
+	return nil
+}
+
+func (x *ListDriverStatesResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverStatesResponse) SetDriverStates(v []*DriverState) {
+	x.xxx_hidden_DriverStates = &v
+}
+
+func (x *ListDriverStatesResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListDriverStatesResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListDriverStatesResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListDriverStatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The driver states.
+	DriverStates []*DriverState
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListDriverStatesResponse_builder) Build() *ListDriverStatesResponse {
+	m0 := &ListDriverStatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_DriverStates = &b.DriverStates
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for ListDriverViolations.
+type ListDriverViolationsRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CardNumbers       []string               `protobuf:"bytes,1,rep,name=card_numbers,json=cardNumbers"`
+	xxx_hidden_Countries         []string               `protobuf:"bytes,2,rep,name=countries"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_time,json=toTime"`
+	xxx_hidden_Severities        []Violation_Severity   `protobuf:"varint,5,rep,packed,name=severities,enum=wayplatform.connect.trusttrack.v1.Violation_Severity"`
+	xxx_hidden_Types             []Violation_Type       `protobuf:"varint,6,rep,packed,name=types,enum=wayplatform.connect.trusttrack.v1.Violation_Type"`
+	xxx_hidden_Vehicles          []string               `protobuf:"bytes,7,rep,name=vehicles"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,8,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,9,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListDriverViolationsRequest) Reset() {
+	*x = ListDriverViolationsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverViolationsRequest) ProtoMessage() {}
+
+func (x *ListDriverViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverViolationsRequest) GetCardNumbers() []string {
+	if x != nil {
+		return x.xxx_hidden_CardNumbers
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetCountries() []string {
+	if x != nil {
+		return x.xxx_hidden_Countries
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetSeverities() []Violation_Severity {
+	if x != nil {
+		return x.xxx_hidden_Severities
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetTypes() []Violation_Type {
+	if x != nil {
+		return x.xxx_hidden_Types
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetVehicles() []string {
+	if x != nil {
+		return x.xxx_hidden_Vehicles
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListDriverViolationsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverViolationsRequest) SetCardNumbers(v []string) {
+	x.xxx_hidden_CardNumbers = v
+}
+
+func (x *ListDriverViolationsRequest) SetCountries(v []string) {
+	x.xxx_hidden_Countries = v
+}
+
+func (x *ListDriverViolationsRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListDriverViolationsRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListDriverViolationsRequest) SetSeverities(v []Violation_Severity) {
+	x.xxx_hidden_Severities = v
+}
+
+func (x *ListDriverViolationsRequest) SetTypes(v []Violation_Type) {
+	x.xxx_hidden_Types = v
+}
+
+func (x *ListDriverViolationsRequest) SetVehicles(v []string) {
+	x.xxx_hidden_Vehicles = v
+}
+
+func (x *ListDriverViolationsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *ListDriverViolationsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *ListDriverViolationsRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListDriverViolationsRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListDriverViolationsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *ListDriverViolationsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *ListDriverViolationsRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListDriverViolationsRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListDriverViolationsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListDriverViolationsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListDriverViolationsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Filter by driver tachograph card numbers.
+	CardNumbers []string
+	// Filter by country codes of the countries where the violations occurred.
+	Countries []string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive).
+	ToTime *timestamppb.Timestamp
+	// Filter by violation severities.
+	Severities []Violation_Severity
+	// Filter by violation types.
+	Types []Violation_Type
+	// Filter by vehicles.
+	Vehicles []string
+	// Max results to return (default 50).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListDriverViolationsRequest_builder) Build() *ListDriverViolationsRequest {
+	m0 := &ListDriverViolationsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_CardNumbers = b.CardNumbers
+	x.xxx_hidden_Countries = b.Countries
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	x.xxx_hidden_Severities = b.Severities
+	x.xxx_hidden_Types = b.Types
+	x.xxx_hidden_Vehicles = b.Vehicles
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListDriverViolations.
+type ListDriverViolationsResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Violations        *[]*Violation          `protobuf:"bytes,1,rep,name=violations"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListDriverViolationsResponse) Reset() {
+	*x = ListDriverViolationsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverViolationsResponse) ProtoMessage() {}
+
+func (x *ListDriverViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverViolationsResponse) GetViolations() []*Violation {
+	if x != nil {
+		if x.xxx_hidden_Violations != nil {
+			return *x.xxx_hidden_Violations
+		}
+	}
+	return nil
+}
+
+func (x *ListDriverViolationsResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverViolationsResponse) SetViolations(v []*Violation) {
+	x.xxx_hidden_Violations = &v
+}
+
+func (x *ListDriverViolationsResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListDriverViolationsResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListDriverViolationsResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListDriverViolationsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The violations.
+	Violations []*Violation
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListDriverViolationsResponse_builder) Build() *ListDriverViolationsResponse {
+	m0 := &ListDriverViolationsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Violations = &b.Violations
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for ListObjectEcodriving.
+type ListObjectEcodrivingRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_FromTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListObjectEcodrivingRequest) Reset() {
+	*x = ListObjectEcodrivingRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectEcodrivingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectEcodrivingRequest) ProtoMessage() {}
+
+func (x *ListObjectEcodrivingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectEcodrivingRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectEcodrivingRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListObjectEcodrivingRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListObjectEcodrivingRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ListObjectEcodrivingRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListObjectEcodrivingRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListObjectEcodrivingRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListObjectEcodrivingRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListObjectEcodrivingRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListObjectEcodrivingRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ListObjectEcodrivingRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListObjectEcodrivingRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+type ListObjectEcodrivingRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external object ID.
+	ObjectId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+}
+
+func (b0 ListObjectEcodrivingRequest_builder) Build() *ListObjectEcodrivingRequest {
+	m0 := &ListObjectEcodrivingRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	return m0
+}
+
+// Response for ListObjectEcodriving.
+type ListObjectEcodrivingResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ecodriving *Ecodriving            `protobuf:"bytes,1,opt,name=ecodriving"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListObjectEcodrivingResponse) Reset() {
+	*x = ListObjectEcodrivingResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectEcodrivingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectEcodrivingResponse) ProtoMessage() {}
+
+func (x *ListObjectEcodrivingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectEcodrivingResponse) GetEcodriving() *Ecodriving {
+	if x != nil {
+		return x.xxx_hidden_Ecodriving
+	}
+	return nil
+}
+
+func (x *ListObjectEcodrivingResponse) SetEcodriving(v *Ecodriving) {
+	x.xxx_hidden_Ecodriving = v
+}
+
+func (x *ListObjectEcodrivingResponse) HasEcodriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Ecodriving != nil
+}
+
+func (x *ListObjectEcodrivingResponse) ClearEcodriving() {
+	x.xxx_hidden_Ecodriving = nil
+}
+
+type ListObjectEcodrivingResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ecodriving parameters of the object.
+	Ecodriving *Ecodriving
+}
+
+func (b0 ListObjectEcodrivingResponse_builder) Build() *ListObjectEcodrivingResponse {
+	m0 := &ListObjectEcodrivingResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ecodriving = b.Ecodriving
+	return m0
+}
+
+// Request for ListDriverEcodriving.
+type ListDriverEcodrivingRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DriverId    *string                `protobuf:"bytes,1,opt,name=driver_id,json=driverId"`
+	xxx_hidden_FromTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListDriverEcodrivingRequest) Reset() {
+	*x = ListDriverEcodrivingRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverEcodrivingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverEcodrivingRequest) ProtoMessage() {}
+
+func (x *ListDriverEcodrivingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverEcodrivingRequest) GetDriverId() string {
+	if x != nil {
+		if x.xxx_hidden_DriverId != nil {
+			return *x.xxx_hidden_DriverId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListDriverEcodrivingRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListDriverEcodrivingRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListDriverEcodrivingRequest) SetDriverId(v string) {
+	x.xxx_hidden_DriverId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ListDriverEcodrivingRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListDriverEcodrivingRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListDriverEcodrivingRequest) HasDriverId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListDriverEcodrivingRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListDriverEcodrivingRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListDriverEcodrivingRequest) ClearDriverId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DriverId = nil
+}
+
+func (x *ListDriverEcodrivingRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListDriverEcodrivingRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+type ListDriverEcodrivingRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the driver.
+	DriverId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+}
+
+func (b0 ListDriverEcodrivingRequest_builder) Build() *ListDriverEcodrivingRequest {
+	m0 := &ListDriverEcodrivingRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DriverId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_DriverId = b.DriverId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	return m0
+}
+
+// Response for ListDriverEcodriving.
+type ListDriverEcodrivingResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ecodriving *Ecodriving            `protobuf:"bytes,1,opt,name=ecodriving"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListDriverEcodrivingResponse) Reset() {
+	*x = ListDriverEcodrivingResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverEcodrivingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverEcodrivingResponse) ProtoMessage() {}
+
+func (x *ListDriverEcodrivingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListDriverEcodrivingResponse) GetEcodriving() *Ecodriving {
+	if x != nil {
+		return x.xxx_hidden_Ecodriving
+	}
+	return nil
+}
+
+func (x *ListDriverEcodrivingResponse) SetEcodriving(v *Ecodriving) {
+	x.xxx_hidden_Ecodriving = v
+}
+
+func (x *ListDriverEcodrivingResponse) HasEcodriving() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Ecodriving != nil
+}
+
+func (x *ListDriverEcodrivingResponse) ClearEcodriving() {
+	x.xxx_hidden_Ecodriving = nil
+}
+
+type ListDriverEcodrivingResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ecodriving parameters of the driver.
+	Ecodriving *Ecodriving
+}
+
+func (b0 ListDriverEcodrivingResponse_builder) Build() *ListDriverEcodrivingResponse {
+	m0 := &ListDriverEcodrivingResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ecodriving = b.Ecodriving
+	return m0
+}
+
+// Request for ListFuelEvents.
+type ListFuelEventsRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId          *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,4,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,5,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListFuelEventsRequest) Reset() {
+	*x = ListFuelEventsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFuelEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFuelEventsRequest) ProtoMessage() {}
+
+func (x *ListFuelEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListFuelEventsRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListFuelEventsRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListFuelEventsRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListFuelEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListFuelEventsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListFuelEventsRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ListFuelEventsRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListFuelEventsRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListFuelEventsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *ListFuelEventsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *ListFuelEventsRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListFuelEventsRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListFuelEventsRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListFuelEventsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ListFuelEventsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListFuelEventsRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ListFuelEventsRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListFuelEventsRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListFuelEventsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListFuelEventsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListFuelEventsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object to get fuel events for.
+	ObjectId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+	// Max results to return (default 100, max 1000).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListFuelEventsRequest_builder) Build() *ListFuelEventsRequest {
+	m0 := &ListFuelEventsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListFuelEvents.
+type ListFuelEventsResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FuelEvents        *[]*FuelEvent          `protobuf:"bytes,1,rep,name=fuel_events,json=fuelEvents"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListFuelEventsResponse) Reset() {
+	*x = ListFuelEventsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFuelEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFuelEventsResponse) ProtoMessage() {}
+
+func (x *ListFuelEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListFuelEventsResponse) GetFuelEvents() []*FuelEvent {
+	if x != nil {
+		if x.xxx_hidden_FuelEvents != nil {
+			return *x.xxx_hidden_FuelEvents
+		}
+	}
+	return nil
+}
+
+func (x *ListFuelEventsResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListFuelEventsResponse) SetFuelEvents(v []*FuelEvent) {
+	x.xxx_hidden_FuelEvents = &v
+}
+
+func (x *ListFuelEventsResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListFuelEventsResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListFuelEventsResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListFuelEventsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The fuel events.
+	FuelEvents []*FuelEvent
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListFuelEventsResponse_builder) Build() *ListFuelEventsResponse {
+	m0 := &ListFuelEventsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_FuelEvents = &b.FuelEvents
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for ListGeozones.
+type ListGeozonesRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	xxx_hidden_IncludeGeometry   bool                   `protobuf:"varint,3,opt,name=include_geometry,json=includeGeometry"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListGeozonesRequest) Reset() {
+	*x = ListGeozonesRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeozonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeozonesRequest) ProtoMessage() {}
+
+func (x *ListGeozonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGeozonesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListGeozonesRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListGeozonesRequest) GetIncludeGeometry() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeGeometry
+	}
+	return false
+}
+
+func (x *ListGeozonesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ListGeozonesRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ListGeozonesRequest) SetIncludeGeometry(v bool) {
+	x.xxx_hidden_IncludeGeometry = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ListGeozonesRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListGeozonesRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListGeozonesRequest) HasIncludeGeometry() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ListGeozonesRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListGeozonesRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+func (x *ListGeozonesRequest) ClearIncludeGeometry() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IncludeGeometry = false
+}
+
+type ListGeozonesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 100).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+	// Whether to include the geometry (circle or polygon) of each geozone.
+	IncludeGeometry *bool
+}
+
+func (b0 ListGeozonesRequest_builder) Build() *ListGeozonesRequest {
+	m0 := &ListGeozonesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	if b.IncludeGeometry != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_IncludeGeometry = *b.IncludeGeometry
+	}
+	return m0
+}
+
+// Response for ListGeozones.
+type ListGeozonesResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Geozones          *[]*Geozone            `protobuf:"bytes,1,rep,name=geozones"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListGeozonesResponse) Reset() {
+	*x = ListGeozonesResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeozonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeozonesResponse) ProtoMessage() {}
+
+func (x *ListGeozonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGeozonesResponse) GetGeozones() []*Geozone {
+	if x != nil {
+		if x.xxx_hidden_Geozones != nil {
+			return *x.xxx_hidden_Geozones
+		}
+	}
+	return nil
+}
+
+func (x *ListGeozonesResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListGeozonesResponse) SetGeozones(v []*Geozone) {
+	x.xxx_hidden_Geozones = &v
+}
+
+func (x *ListGeozonesResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListGeozonesResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListGeozonesResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListGeozonesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The geozones.
+	Geozones []*Geozone
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListGeozonesResponse_builder) Build() *ListGeozonesResponse {
+	m0 := &ListGeozonesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Geozones = &b.Geozones
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for ListGeozoneVisits.
+type ListGeozoneVisitsRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectIds         []string               `protobuf:"bytes,1,rep,name=object_ids,json=objectIds"`
+	xxx_hidden_GeozoneIds        []string               `protobuf:"bytes,2,rep,name=geozone_ids,json=geozoneIds"`
+	xxx_hidden_FromTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_time,json=toTime"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,5,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,6,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListGeozoneVisitsRequest) Reset() {
+	*x = ListGeozoneVisitsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeozoneVisitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeozoneVisitsRequest) ProtoMessage() {}
+
+func (x *ListGeozoneVisitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGeozoneVisitsRequest) GetObjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ObjectIds
+	}
+	return nil
+}
+
+func (x *ListGeozoneVisitsRequest) GetGeozoneIds() []string {
+	if x != nil {
+		return x.xxx_hidden_GeozoneIds
+	}
+	return nil
+}
+
+func (x *ListGeozoneVisitsRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListGeozoneVisitsRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListGeozoneVisitsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListGeozoneVisitsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListGeozoneVisitsRequest) SetObjectIds(v []string) {
+	x.xxx_hidden_ObjectIds = v
+}
+
+func (x *ListGeozoneVisitsRequest) SetGeozoneIds(v []string) {
+	x.xxx_hidden_GeozoneIds = v
+}
+
+func (x *ListGeozoneVisitsRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListGeozoneVisitsRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListGeozoneVisitsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *ListGeozoneVisitsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *ListGeozoneVisitsRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListGeozoneVisitsRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListGeozoneVisitsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListGeozoneVisitsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ListGeozoneVisitsRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListGeozoneVisitsRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListGeozoneVisitsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListGeozoneVisitsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListGeozoneVisitsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The IDs of the objects to get geozone visits for.
+	ObjectIds []string
+	// The IDs of the geozones to get visits for.
+	GeozoneIds []string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+	// Max results to return.
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListGeozoneVisitsRequest_builder) Build() *ListGeozoneVisitsRequest {
+	m0 := &ListGeozoneVisitsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ObjectIds = b.ObjectIds
+	x.xxx_hidden_GeozoneIds = b.GeozoneIds
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListGeozoneVisits.
+type ListGeozoneVisitsResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GeozoneVisits     *[]*GeozoneVisit       `protobuf:"bytes,1,rep,name=geozone_visits,json=geozoneVisits"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListGeozoneVisitsResponse) Reset() {
+	*x = ListGeozoneVisitsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeozoneVisitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeozoneVisitsResponse) ProtoMessage() {}
+
+func (x *ListGeozoneVisitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListGeozoneVisitsResponse) GetGeozoneVisits() []*GeozoneVisit {
+	if x != nil {
+		if x.xxx_hidden_GeozoneVisits != nil {
+			return *x.xxx_hidden_GeozoneVisits
+		}
+	}
+	return nil
+}
+
+func (x *ListGeozoneVisitsResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListGeozoneVisitsResponse) SetGeozoneVisits(v []*GeozoneVisit) {
+	x.xxx_hidden_GeozoneVisits = &v
+}
+
+func (x *ListGeozoneVisitsResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListGeozoneVisitsResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListGeozoneVisitsResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListGeozoneVisitsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The geozone visits, grouped by object and geozone.
+	GeozoneVisits []*GeozoneVisit
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListGeozoneVisitsResponse_builder) Build() *ListGeozoneVisitsResponse {
+	m0 := &ListGeozoneVisitsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_GeozoneVisits = &b.GeozoneVisits
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for GetObjectGroup.
+type GetObjectGroupRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ExternalId  *string                `protobuf:"bytes,1,opt,name=external_id,json=externalId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetObjectGroupRequest) Reset() {
+	*x = GetObjectGroupRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectGroupRequest) ProtoMessage() {}
+
+func (x *GetObjectGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectGroupRequest) GetExternalId() string {
+	if x != nil {
+		if x.xxx_hidden_ExternalId != nil {
+			return *x.xxx_hidden_ExternalId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetObjectGroupRequest) SetExternalId(v string) {
+	x.xxx_hidden_ExternalId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetObjectGroupRequest) HasExternalId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetObjectGroupRequest) ClearExternalId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ExternalId = nil
+}
+
+type GetObjectGroupRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external ID of the object group.
+	ExternalId *string
+}
+
+func (b0 GetObjectGroupRequest_builder) Build() *GetObjectGroupRequest {
+	m0 := &GetObjectGroupRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ExternalId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ExternalId = b.ExternalId
+	}
+	return m0
+}
+
+// Response for GetObjectGroup.
+type GetObjectGroupResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectGroup *ObjectGroup           `protobuf:"bytes,1,opt,name=object_group,json=objectGroup"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetObjectGroupResponse) Reset() {
+	*x = GetObjectGroupResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectGroupResponse) ProtoMessage() {}
+
+func (x *GetObjectGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectGroupResponse) GetObjectGroup() *ObjectGroup {
+	if x != nil {
+		return x.xxx_hidden_ObjectGroup
+	}
+	return nil
+}
+
+func (x *GetObjectGroupResponse) SetObjectGroup(v *ObjectGroup) {
+	x.xxx_hidden_ObjectGroup = v
+}
+
+func (x *GetObjectGroupResponse) HasObjectGroup() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ObjectGroup != nil
+}
+
+func (x *GetObjectGroupResponse) ClearObjectGroup() {
+	x.xxx_hidden_ObjectGroup = nil
+}
+
+type GetObjectGroupResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The object group.
+	ObjectGroup *ObjectGroup
+}
+
+func (b0 GetObjectGroupResponse_builder) Build() *GetObjectGroupResponse {
+	m0 := &GetObjectGroupResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ObjectGroup = b.ObjectGroup
+	return m0
+}
+
+// Request for UpdateObjectGroup.
+type UpdateObjectGroupRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ExternalId      *string                `protobuf:"bytes,1,opt,name=external_id,json=externalId"`
+	xxx_hidden_AddObjectIds    []string               `protobuf:"bytes,2,rep,name=add_object_ids,json=addObjectIds"`
+	xxx_hidden_RemoveObjectIds []string               `protobuf:"bytes,3,rep,name=remove_object_ids,json=removeObjectIds"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UpdateObjectGroupRequest) Reset() {
+	*x = UpdateObjectGroupRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateObjectGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateObjectGroupRequest) ProtoMessage() {}
+
+func (x *UpdateObjectGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateObjectGroupRequest) GetExternalId() string {
+	if x != nil {
+		if x.xxx_hidden_ExternalId != nil {
+			return *x.xxx_hidden_ExternalId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateObjectGroupRequest) GetAddObjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_AddObjectIds
+	}
+	return nil
+}
+
+func (x *UpdateObjectGroupRequest) GetRemoveObjectIds() []string {
+	if x != nil {
+		return x.xxx_hidden_RemoveObjectIds
+	}
+	return nil
+}
+
+func (x *UpdateObjectGroupRequest) SetExternalId(v string) {
+	x.xxx_hidden_ExternalId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *UpdateObjectGroupRequest) SetAddObjectIds(v []string) {
+	x.xxx_hidden_AddObjectIds = v
+}
+
+func (x *UpdateObjectGroupRequest) SetRemoveObjectIds(v []string) {
+	x.xxx_hidden_RemoveObjectIds = v
+}
+
+func (x *UpdateObjectGroupRequest) HasExternalId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UpdateObjectGroupRequest) ClearExternalId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ExternalId = nil
+}
+
+type UpdateObjectGroupRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external ID of the object group.
+	ExternalId *string
+	// The IDs of the objects to add to the group.
+	AddObjectIds []string
+	// The IDs of the objects to remove from the group.
+	RemoveObjectIds []string
+}
+
+func (b0 UpdateObjectGroupRequest_builder) Build() *UpdateObjectGroupRequest {
+	m0 := &UpdateObjectGroupRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ExternalId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ExternalId = b.ExternalId
+	}
+	x.xxx_hidden_AddObjectIds = b.AddObjectIds
+	x.xxx_hidden_RemoveObjectIds = b.RemoveObjectIds
+	return m0
+}
+
+// Response for UpdateObjectGroup.
+type UpdateObjectGroupResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectGroup *ObjectGroup           `protobuf:"bytes,1,opt,name=object_group,json=objectGroup"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateObjectGroupResponse) Reset() {
+	*x = UpdateObjectGroupResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateObjectGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateObjectGroupResponse) ProtoMessage() {}
+
+func (x *UpdateObjectGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateObjectGroupResponse) GetObjectGroup() *ObjectGroup {
+	if x != nil {
+		return x.xxx_hidden_ObjectGroup
+	}
+	return nil
+}
+
+func (x *UpdateObjectGroupResponse) SetObjectGroup(v *ObjectGroup) {
+	x.xxx_hidden_ObjectGroup = v
+}
+
+func (x *UpdateObjectGroupResponse) HasObjectGroup() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ObjectGroup != nil
+}
+
+func (x *UpdateObjectGroupResponse) ClearObjectGroup() {
+	x.xxx_hidden_ObjectGroup = nil
+}
+
+type UpdateObjectGroupResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The updated object group.
+	ObjectGroup *ObjectGroup
+}
+
+func (b0 UpdateObjectGroupResponse_builder) Build() *UpdateObjectGroupResponse {
+	m0 := &UpdateObjectGroupResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ObjectGroup = b.ObjectGroup
+	return m0
+}
+
+// Request for ListObjectGroups.
+type ListObjectGroupsRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListObjectGroupsRequest) Reset() {
+	*x = ListObjectGroupsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectGroupsRequest) ProtoMessage() {}
+
+func (x *ListObjectGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectGroupsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListObjectGroupsRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectGroupsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListObjectGroupsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListObjectGroupsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListObjectGroupsRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListObjectGroupsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListObjectGroupsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectGroupsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 100, max 1000).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListObjectGroupsRequest_builder) Build() *ListObjectGroupsRequest {
+	m0 := &ListObjectGroupsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListObjectGroups.
+type ListObjectGroupsResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectGroups      *[]*ObjectGroup        `protobuf:"bytes,1,rep,name=object_groups,json=objectGroups"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListObjectGroupsResponse) Reset() {
+	*x = ListObjectGroupsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectGroupsResponse) ProtoMessage() {}
+
+func (x *ListObjectGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectGroupsResponse) GetObjectGroups() []*ObjectGroup {
+	if x != nil {
+		if x.xxx_hidden_ObjectGroups != nil {
+			return *x.xxx_hidden_ObjectGroups
+		}
+	}
+	return nil
+}
+
+func (x *ListObjectGroupsResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectGroupsResponse) SetObjectGroups(v []*ObjectGroup) {
+	x.xxx_hidden_ObjectGroups = &v
+}
+
+func (x *ListObjectGroupsResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListObjectGroupsResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListObjectGroupsResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectGroupsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The object groups.
+	ObjectGroups []*ObjectGroup
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListObjectGroupsResponse_builder) Build() *ListObjectGroupsResponse {
+	m0 := &ListObjectGroupsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ObjectGroups = &b.ObjectGroups
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for ListObjectCoordinates.
+type ListObjectCoordinatesRequest struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId              *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_FromTime              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
+	xxx_hidden_ContinuationToken     *string                `protobuf:"bytes,4,opt,name=continuation_token,json=continuationToken"`
+	xxx_hidden_Limit                 int32                  `protobuf:"varint,5,opt,name=limit"`
+	xxx_hidden_IncludeGeozones       bool                   `protobuf:"varint,6,opt,name=include_geozones,json=includeGeozones"`
+	xxx_hidden_IncludeTireParameters bool                   `protobuf:"varint,7,opt,name=include_tire_parameters,json=includeTireParameters"`
+	xxx_hidden_ApiVersion            int32                  `protobuf:"varint,8,opt,name=api_version,json=apiVersion"`
+	xxx_hidden_IncludeNearestGeozone bool                   `protobuf:"varint,9,opt,name=include_nearest_geozone,json=includeNearestGeozone"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ListObjectCoordinatesRequest) Reset() {
+	*x = ListObjectCoordinatesRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectCoordinatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectCoordinatesRequest) ProtoMessage() {}
+
+func (x *ListObjectCoordinatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectCoordinatesRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectCoordinatesRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ListObjectCoordinatesRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ListObjectCoordinatesRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectCoordinatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListObjectCoordinatesRequest) GetIncludeGeozones() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeGeozones
+	}
+	return false
+}
+
+func (x *ListObjectCoordinatesRequest) GetIncludeTireParameters() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeTireParameters
+	}
+	return false
+}
+
+func (x *ListObjectCoordinatesRequest) GetApiVersion() int32 {
+	if x != nil {
+		return x.xxx_hidden_ApiVersion
+	}
+	return 0
+}
+
+func (x *ListObjectCoordinatesRequest) GetIncludeNearestGeozone() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeNearestGeozone
+	}
+	return false
+}
+
+func (x *ListObjectCoordinatesRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ListObjectCoordinatesRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ListObjectCoordinatesRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetIncludeGeozones(v bool) {
+	x.xxx_hidden_IncludeGeozones = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetIncludeTireParameters(v bool) {
+	x.xxx_hidden_IncludeTireParameters = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetApiVersion(v int32) {
+	x.xxx_hidden_ApiVersion = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) SetIncludeNearestGeozone(v bool) {
+	x.xxx_hidden_IncludeNearestGeozone = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *ListObjectCoordinatesRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListObjectCoordinatesRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ListObjectCoordinatesRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ListObjectCoordinatesRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ListObjectCoordinatesRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ListObjectCoordinatesRequest) HasIncludeGeozones() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ListObjectCoordinatesRequest) HasIncludeTireParameters() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *ListObjectCoordinatesRequest) HasApiVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *ListObjectCoordinatesRequest) HasIncludeNearestGeozone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *ListObjectCoordinatesRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ListObjectCoordinatesRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ListObjectCoordinatesRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+func (x *ListObjectCoordinatesRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+func (x *ListObjectCoordinatesRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListObjectCoordinatesRequest) ClearIncludeGeozones() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_IncludeGeozones = false
+}
+
+func (x *ListObjectCoordinatesRequest) ClearIncludeTireParameters() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_IncludeTireParameters = false
+}
+
+func (x *ListObjectCoordinatesRequest) ClearApiVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_ApiVersion = 0
+}
+
+func (x *ListObjectCoordinatesRequest) ClearIncludeNearestGeozone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_IncludeNearestGeozone = false
+}
+
+type ListObjectCoordinatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external object ID.
+	ObjectId *string
+	// Start of the time window (inclusive).
+	FromTime *timestamppb.Timestamp
+	// End of the time window (exclusive, optional).
+	ToTime *timestamppb.Timestamp
+	// Continuation token from a previous response.
+	ContinuationToken *string
+	// Max results to return (default 1000, max 1000).
+	Limit *int32
+	// Whether to include geozone information.
+	IncludeGeozones *bool
+	// Whether to include tire pressure information.
+	IncludeTireParameters *bool
+	// The version of the coordinates history API to use.
+	// Defaults to 3 when the nearest geozone is requested, otherwise to 2.
+	ApiVersion *int32
+	// Whether to include the nearest geozone.
+	// Requires API version 3.
+	IncludeNearestGeozone *bool
+}
+
+func (b0 ListObjectCoordinatesRequest_builder) Build() *ListObjectCoordinatesRequest {
+	m0 := &ListObjectCoordinatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.IncludeGeozones != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_IncludeGeozones = *b.IncludeGeozones
+	}
+	if b.IncludeTireParameters != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_IncludeTireParameters = *b.IncludeTireParameters
+	}
+	if b.ApiVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_ApiVersion = *b.ApiVersion
+	}
+	if b.IncludeNearestGeozone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_IncludeNearestGeozone = *b.IncludeNearestGeozone
+	}
+	return m0
+}
+
+// Response for ListObjectCoordinates.
+type ListObjectCoordinatesResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Coordinates       *[]*Coordinate         `protobuf:"bytes,1,rep,name=coordinates"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListObjectCoordinatesResponse) Reset() {
+	*x = ListObjectCoordinatesResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectCoordinatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectCoordinatesResponse) ProtoMessage() {}
+
+func (x *ListObjectCoordinatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectCoordinatesResponse) GetCoordinates() []*Coordinate {
+	if x != nil {
+		if x.xxx_hidden_Coordinates != nil {
+			return *x.xxx_hidden_Coordinates
+		}
+	}
+	return nil
+}
+
+func (x *ListObjectCoordinatesResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectCoordinatesResponse) SetCoordinates(v []*Coordinate) {
+	x.xxx_hidden_Coordinates = &v
+}
+
+func (x *ListObjectCoordinatesResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListObjectCoordinatesResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListObjectCoordinatesResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectCoordinatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The coordinates.
+	Coordinates []*Coordinate
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListObjectCoordinatesResponse_builder) Build() *ListObjectCoordinatesResponse {
+	m0 := &ListObjectCoordinatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Coordinates = &b.Coordinates
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for GetObjectCoordinate.
+type GetObjectCoordinateRequest struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId              *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_Time                  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time"`
+	xxx_hidden_IncludeGeozones       bool                   `protobuf:"varint,3,opt,name=include_geozones,json=includeGeozones"`
+	xxx_hidden_IncludeTireParameters bool                   `protobuf:"varint,4,opt,name=include_tire_parameters,json=includeTireParameters"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *GetObjectCoordinateRequest) Reset() {
+	*x = GetObjectCoordinateRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectCoordinateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectCoordinateRequest) ProtoMessage() {}
+
+func (x *GetObjectCoordinateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectCoordinateRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetObjectCoordinateRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *GetObjectCoordinateRequest) GetIncludeGeozones() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeGeozones
+	}
+	return false
+}
+
+func (x *GetObjectCoordinateRequest) GetIncludeTireParameters() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeTireParameters
+	}
+	return false
+}
+
+func (x *GetObjectCoordinateRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *GetObjectCoordinateRequest) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *GetObjectCoordinateRequest) SetIncludeGeozones(v bool) {
+	x.xxx_hidden_IncludeGeozones = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GetObjectCoordinateRequest) SetIncludeTireParameters(v bool) {
+	x.xxx_hidden_IncludeTireParameters = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *GetObjectCoordinateRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetObjectCoordinateRequest) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *GetObjectCoordinateRequest) HasIncludeGeozones() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetObjectCoordinateRequest) HasIncludeTireParameters() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetObjectCoordinateRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *GetObjectCoordinateRequest) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *GetObjectCoordinateRequest) ClearIncludeGeozones() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IncludeGeozones = false
+}
+
+func (x *GetObjectCoordinateRequest) ClearIncludeTireParameters() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_IncludeTireParameters = false
+}
+
+type GetObjectCoordinateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external object ID.
+	ObjectId *string
+	// The time the coordinate was recorded at.
+	Time *timestamppb.Timestamp
+	// Whether to include geozone information.
+	IncludeGeozones *bool
+	// Whether to include tire pressure information.
+	IncludeTireParameters *bool
+}
+
+func (b0 GetObjectCoordinateRequest_builder) Build() *GetObjectCoordinateRequest {
+	m0 := &GetObjectCoordinateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	x.xxx_hidden_Time = b.Time
+	if b.IncludeGeozones != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_IncludeGeozones = *b.IncludeGeozones
+	}
+	if b.IncludeTireParameters != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_IncludeTireParameters = *b.IncludeTireParameters
+	}
+	return m0
+}
+
+// Response for GetObjectCoordinate.
+type GetObjectCoordinateResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Coordinate *Coordinate            `protobuf:"bytes,1,opt,name=coordinate"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetObjectCoordinateResponse) Reset() {
+	*x = GetObjectCoordinateResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectCoordinateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectCoordinateResponse) ProtoMessage() {}
+
+func (x *GetObjectCoordinateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectCoordinateResponse) GetCoordinate() *Coordinate {
+	if x != nil {
+		return x.xxx_hidden_Coordinate
+	}
+	return nil
+}
+
+func (x *GetObjectCoordinateResponse) SetCoordinate(v *Coordinate) {
+	x.xxx_hidden_Coordinate = v
+}
+
+func (x *GetObjectCoordinateResponse) HasCoordinate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Coordinate != nil
+}
+
+func (x *GetObjectCoordinateResponse) ClearCoordinate() {
+	x.xxx_hidden_Coordinate = nil
+}
+
+type GetObjectCoordinateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The coordinate.
+	Coordinate *Coordinate
+}
+
+func (b0 GetObjectCoordinateResponse_builder) Build() *GetObjectCoordinateResponse {
+	m0 := &GetObjectCoordinateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Coordinate = b.Coordinate
+	return m0
+}
+
+// Request for StreamObjectCoordinates.
+type StreamObjectCoordinatesRequest struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId              *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_IncludeGeozones       bool                   `protobuf:"varint,2,opt,name=include_geozones,json=includeGeozones"`
+	xxx_hidden_IncludeTireParameters bool                   `protobuf:"varint,3,opt,name=include_tire_parameters,json=includeTireParameters"`
+	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
+	XXX_presence                     [1]uint32
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *StreamObjectCoordinatesRequest) Reset() {
+	*x = StreamObjectCoordinatesRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamObjectCoordinatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamObjectCoordinatesRequest) ProtoMessage() {}
+
+func (x *StreamObjectCoordinatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StreamObjectCoordinatesRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StreamObjectCoordinatesRequest) GetIncludeGeozones() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeGeozones
+	}
+	return false
+}
+
+func (x *StreamObjectCoordinatesRequest) GetIncludeTireParameters() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeTireParameters
+	}
+	return false
+}
+
+func (x *StreamObjectCoordinatesRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *StreamObjectCoordinatesRequest) SetIncludeGeozones(v bool) {
+	x.xxx_hidden_IncludeGeozones = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *StreamObjectCoordinatesRequest) SetIncludeTireParameters(v bool) {
+	x.xxx_hidden_IncludeTireParameters = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *StreamObjectCoordinatesRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *StreamObjectCoordinatesRequest) HasIncludeGeozones() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *StreamObjectCoordinatesRequest) HasIncludeTireParameters() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *StreamObjectCoordinatesRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *StreamObjectCoordinatesRequest) ClearIncludeGeozones() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_IncludeGeozones = false
+}
+
+func (x *StreamObjectCoordinatesRequest) ClearIncludeTireParameters() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_IncludeTireParameters = false
+}
+
+type StreamObjectCoordinatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The external object ID.
+	// Coordinates of all objects are streamed when not set.
+	ObjectId *string
+	// Whether to include geozone information.
+	IncludeGeozones *bool
+	// Whether to include tire pressure information.
+	IncludeTireParameters *bool
+}
+
+func (b0 StreamObjectCoordinatesRequest_builder) Build() *StreamObjectCoordinatesRequest {
+	m0 := &StreamObjectCoordinatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.IncludeGeozones != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_IncludeGeozones = *b.IncludeGeozones
+	}
+	if b.IncludeTireParameters != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_IncludeTireParameters = *b.IncludeTireParameters
+	}
+	return m0
+}
+
+// Response for StreamObjectCoordinates.
+type StreamObjectCoordinatesResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Coordinate *Coordinate            `protobuf:"bytes,1,opt,name=coordinate"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StreamObjectCoordinatesResponse) Reset() {
+	*x = StreamObjectCoordinatesResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamObjectCoordinatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamObjectCoordinatesResponse) ProtoMessage() {}
+
+func (x *StreamObjectCoordinatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StreamObjectCoordinatesResponse) GetCoordinate() *Coordinate {
+	if x != nil {
+		return x.xxx_hidden_Coordinate
+	}
+	return nil
+}
+
+func (x *StreamObjectCoordinatesResponse) SetCoordinate(v *Coordinate) {
+	x.xxx_hidden_Coordinate = v
+}
+
+func (x *StreamObjectCoordinatesResponse) HasCoordinate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Coordinate != nil
+}
+
+func (x *StreamObjectCoordinatesResponse) ClearCoordinate() {
+	x.xxx_hidden_Coordinate = nil
+}
+
+type StreamObjectCoordinatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The coordinate.
+	Coordinate *Coordinate
+}
+
+func (b0 StreamObjectCoordinatesResponse_builder) Build() *StreamObjectCoordinatesResponse {
+	m0 := &StreamObjectCoordinatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Coordinate = b.Coordinate
+	return m0
+}
+
+// Request for GetObject.
+type GetObjectRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetObjectRequest) Reset() {
+	*x = GetObjectRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRequest) ProtoMessage() {}
+
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetObjectRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetObjectRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+type GetObjectRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object.
+	ObjectId *string
+}
+
+func (b0 GetObjectRequest_builder) Build() *GetObjectRequest {
+	m0 := &GetObjectRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	return m0
+}
+
+// Response for GetObject.
+type GetObjectResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object *Object                `protobuf:"bytes,1,opt,name=object"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectResponse) ProtoMessage() {}
+
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectResponse) GetObject() *Object {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *GetObjectResponse) SetObject(v *Object) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *GetObjectResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *GetObjectResponse) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+type GetObjectResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The object.
+	Object *Object
+}
+
+func (b0 GetObjectResponse_builder) Build() *GetObjectResponse {
+	m0 := &GetObjectResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	return m0
+}
+
+// Request for ListObjects.
+type ListObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListObjectsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListObjectsRequest_builder) Build() *ListObjectsRequest {
+	m0 := &ListObjectsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Response for ListObjects.
+type ListObjectsResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Objects *[]*Object             `protobuf:"bytes,1,rep,name=objects"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListObjectsResponse) Reset() {
+	*x = ListObjectsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsResponse) ProtoMessage() {}
+
+func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectsResponse) GetObjects() []*Object {
+	if x != nil {
+		if x.xxx_hidden_Objects != nil {
+			return *x.xxx_hidden_Objects
+		}
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) SetObjects(v []*Object) {
+	x.xxx_hidden_Objects = &v
+}
+
+type ListObjectsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The objects.
+	Objects []*Object
+}
+
+func (b0 ListObjectsResponse_builder) Build() *ListObjectsResponse {
+	m0 := &ListObjectsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Objects = &b.Objects
+	return m0
+}
+
+// Request for GetObjectLastPosition.
+type GetObjectLastPositionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetObjectLastPositionRequest) Reset() {
+	*x = GetObjectLastPositionRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLastPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLastPositionRequest) ProtoMessage() {}
+
+func (x *GetObjectLastPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectLastPositionRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetObjectLastPositionRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetObjectLastPositionRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetObjectLastPositionRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+type GetObjectLastPositionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object.
+	ObjectId *string
+}
+
+func (b0 GetObjectLastPositionRequest_builder) Build() *GetObjectLastPositionRequest {
+	m0 := &GetObjectLastPositionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	return m0
+}
+
+// Response for GetObjectLastPosition.
+type GetObjectLastPositionResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Object *Object                `protobuf:"bytes,1,opt,name=object"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetObjectLastPositionResponse) Reset() {
+	*x = GetObjectLastPositionResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectLastPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLastPositionResponse) ProtoMessage() {}
+
+func (x *GetObjectLastPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetObjectLastPositionResponse) GetObject() *Object {
+	if x != nil {
+		return x.xxx_hidden_Object
+	}
+	return nil
+}
+
+func (x *GetObjectLastPositionResponse) SetObject(v *Object) {
+	x.xxx_hidden_Object = v
+}
+
+func (x *GetObjectLastPositionResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Object != nil
+}
+
+func (x *GetObjectLastPositionResponse) ClearObject() {
+	x.xxx_hidden_Object = nil
+}
+
+type GetObjectLastPositionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The object with its last position.
+	Object *Object
+}
+
+func (b0 GetObjectLastPositionResponse_builder) Build() *GetObjectLastPositionResponse {
+	m0 := &GetObjectLastPositionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Object = b.Object
+	return m0
+}
+
+// Request for ListObjectsLastPosition.
+type ListObjectsLastPositionRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListObjectsLastPositionRequest) Reset() {
+	*x = ListObjectsLastPositionRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsLastPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsLastPositionRequest) ProtoMessage() {}
+
+func (x *ListObjectsLastPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectsLastPositionRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListObjectsLastPositionRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectsLastPositionRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListObjectsLastPositionRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListObjectsLastPositionRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListObjectsLastPositionRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListObjectsLastPositionRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListObjectsLastPositionRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectsLastPositionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 1000, max 1000).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListObjectsLastPositionRequest_builder) Build() *ListObjectsLastPositionRequest {
+	m0 := &ListObjectsLastPositionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListObjectsLastPosition.
+type ListObjectsLastPositionResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Objects           *[]*Object             `protobuf:"bytes,1,rep,name=objects"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListObjectsLastPositionResponse) Reset() {
+	*x = ListObjectsLastPositionResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsLastPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsLastPositionResponse) ProtoMessage() {}
+
+func (x *ListObjectsLastPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListObjectsLastPositionResponse) GetObjects() []*Object {
+	if x != nil {
+		if x.xxx_hidden_Objects != nil {
+			return *x.xxx_hidden_Objects
+		}
+	}
+	return nil
+}
+
+func (x *ListObjectsLastPositionResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListObjectsLastPositionResponse) SetObjects(v []*Object) {
+	x.xxx_hidden_Objects = &v
+}
+
+func (x *ListObjectsLastPositionResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListObjectsLastPositionResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListObjectsLastPositionResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListObjectsLastPositionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The objects with their last position.
+	Objects []*Object
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListObjectsLastPositionResponse_builder) Build() *ListObjectsLastPositionResponse {
+	m0 := &ListObjectsLastPositionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Objects = &b.Objects
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for CreateShareLink.
+type CreateShareLinkRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLink *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateShareLinkRequest) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.xxx_hidden_ShareLink
+	}
+	return nil
+}
+
+func (x *CreateShareLinkRequest) SetShareLink(v *ShareLink) {
+	x.xxx_hidden_ShareLink = v
+}
+
+func (x *CreateShareLinkRequest) HasShareLink() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ShareLink != nil
+}
+
+func (x *CreateShareLinkRequest) ClearShareLink() {
+	x.xxx_hidden_ShareLink = nil
+}
+
+type CreateShareLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The share link to create.
+	// Only the objects and validity period are used, the ID is assigned by TrustTrack.
+	ShareLink *ShareLink
+}
+
+func (b0 CreateShareLinkRequest_builder) Build() *CreateShareLinkRequest {
+	m0 := &CreateShareLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLink = b.ShareLink
+	return m0
+}
+
+// Response for CreateShareLink.
+type CreateShareLinkResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLink *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkResponse) Reset() {
+	*x = CreateShareLinkResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkResponse) ProtoMessage() {}
+
+func (x *CreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateShareLinkResponse) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.xxx_hidden_ShareLink
+	}
+	return nil
+}
+
+func (x *CreateShareLinkResponse) SetShareLink(v *ShareLink) {
+	x.xxx_hidden_ShareLink = v
+}
+
+func (x *CreateShareLinkResponse) HasShareLink() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ShareLink != nil
+}
+
+func (x *CreateShareLinkResponse) ClearShareLink() {
+	x.xxx_hidden_ShareLink = nil
+}
+
+type CreateShareLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The created share link.
+	ShareLink *ShareLink
+}
+
+func (b0 CreateShareLinkResponse_builder) Build() *CreateShareLinkResponse {
+	m0 := &CreateShareLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLink = b.ShareLink
+	return m0
+}
+
+// Request for GetShareLink.
+type GetShareLinkRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLinkId *string                `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetShareLinkRequest) Reset() {
+	*x = GetShareLinkRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinkRequest) ProtoMessage() {}
+
+func (x *GetShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetShareLinkRequest) GetShareLinkId() string {
+	if x != nil {
+		if x.xxx_hidden_ShareLinkId != nil {
+			return *x.xxx_hidden_ShareLinkId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetShareLinkRequest) SetShareLinkId(v string) {
+	x.xxx_hidden_ShareLinkId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetShareLinkRequest) HasShareLinkId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetShareLinkRequest) ClearShareLinkId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ShareLinkId = nil
+}
+
+type GetShareLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the share link.
+	ShareLinkId *string
+}
+
+func (b0 GetShareLinkRequest_builder) Build() *GetShareLinkRequest {
+	m0 := &GetShareLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ShareLinkId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ShareLinkId = b.ShareLinkId
+	}
+	return m0
+}
+
+// Response for GetShareLink.
+type GetShareLinkResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLink *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetShareLinkResponse) Reset() {
+	*x = GetShareLinkResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinkResponse) ProtoMessage() {}
+
+func (x *GetShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetShareLinkResponse) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.xxx_hidden_ShareLink
+	}
+	return nil
+}
+
+func (x *GetShareLinkResponse) SetShareLink(v *ShareLink) {
+	x.xxx_hidden_ShareLink = v
+}
+
+func (x *GetShareLinkResponse) HasShareLink() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ShareLink != nil
+}
+
+func (x *GetShareLinkResponse) ClearShareLink() {
+	x.xxx_hidden_ShareLink = nil
+}
+
+type GetShareLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The share link.
+	ShareLink *ShareLink
+}
+
+func (b0 GetShareLinkResponse_builder) Build() *GetShareLinkResponse {
+	m0 := &GetShareLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLink = b.ShareLink
+	return m0
+}
+
+// Request for ListShareLinks.
+type ListShareLinksRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksRequest) ProtoMessage() {}
+
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListShareLinksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListShareLinksRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListShareLinksRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListShareLinksRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListShareLinksRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListShareLinksRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListShareLinksRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListShareLinksRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListShareLinksRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 20).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListShareLinksRequest_builder) Build() *ListShareLinksRequest {
+	m0 := &ListShareLinksRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListShareLinks.
+type ListShareLinksResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLinks        *[]*ShareLink          `protobuf:"bytes,1,rep,name=share_links,json=shareLinks"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListShareLinksResponse) GetShareLinks() []*ShareLink {
+	if x != nil {
+		if x.xxx_hidden_ShareLinks != nil {
+			return *x.xxx_hidden_ShareLinks
+		}
+	}
+	return nil
+}
+
+func (x *ListShareLinksResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListShareLinksResponse) SetShareLinks(v []*ShareLink) {
+	x.xxx_hidden_ShareLinks = &v
+}
+
+func (x *ListShareLinksResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListShareLinksResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListShareLinksResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListShareLinksResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The share links.
+	ShareLinks []*ShareLink
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListShareLinksResponse_builder) Build() *ListShareLinksResponse {
+	m0 := &ListShareLinksResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLinks = &b.ShareLinks
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Request for UpdateShareLink.
+type UpdateShareLinkRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLink  *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink"`
+	xxx_hidden_UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateShareLinkRequest) Reset() {
+	*x = UpdateShareLinkRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShareLinkRequest) ProtoMessage() {}
+
+func (x *UpdateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateShareLinkRequest) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.xxx_hidden_ShareLink
+	}
+	return nil
+}
+
+func (x *UpdateShareLinkRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.xxx_hidden_UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateShareLinkRequest) SetShareLink(v *ShareLink) {
+	x.xxx_hidden_ShareLink = v
+}
+
+func (x *UpdateShareLinkRequest) SetUpdateMask(v *fieldmaskpb.FieldMask) {
+	x.xxx_hidden_UpdateMask = v
+}
+
+func (x *UpdateShareLinkRequest) HasShareLink() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ShareLink != nil
+}
+
+func (x *UpdateShareLinkRequest) HasUpdateMask() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdateMask != nil
+}
+
+func (x *UpdateShareLinkRequest) ClearShareLink() {
+	x.xxx_hidden_ShareLink = nil
+}
+
+func (x *UpdateShareLinkRequest) ClearUpdateMask() {
+	x.xxx_hidden_UpdateMask = nil
+}
+
+type UpdateShareLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The share link to update, identified by its ID.
+	// Only the validity period can be updated.
+	ShareLink *ShareLink
+	// The fields to update, valid_from_time and/or expire_time.
+	// Both fields are replaced when the mask is empty.
+	UpdateMask *fieldmaskpb.FieldMask
+}
+
+func (b0 UpdateShareLinkRequest_builder) Build() *UpdateShareLinkRequest {
+	m0 := &UpdateShareLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLink = b.ShareLink
+	x.xxx_hidden_UpdateMask = b.UpdateMask
+	return m0
+}
+
+// Response for UpdateShareLink.
+type UpdateShareLinkResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLink *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateShareLinkResponse) Reset() {
+	*x = UpdateShareLinkResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShareLinkResponse) ProtoMessage() {}
+
+func (x *UpdateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateShareLinkResponse) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.xxx_hidden_ShareLink
+	}
+	return nil
+}
+
+func (x *UpdateShareLinkResponse) SetShareLink(v *ShareLink) {
+	x.xxx_hidden_ShareLink = v
+}
+
+func (x *UpdateShareLinkResponse) HasShareLink() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ShareLink != nil
+}
+
+func (x *UpdateShareLinkResponse) ClearShareLink() {
+	x.xxx_hidden_ShareLink = nil
+}
+
+type UpdateShareLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The updated share link.
+	ShareLink *ShareLink
+}
+
+func (b0 UpdateShareLinkResponse_builder) Build() *UpdateShareLinkResponse {
+	m0 := &UpdateShareLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ShareLink = b.ShareLink
+	return m0
+}
+
+// Request for DeleteShareLink.
+type DeleteShareLinkRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ShareLinkId *string                `protobuf:"bytes,1,opt,name=share_link_id,json=shareLinkId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteShareLinkRequest) Reset() {
+	*x = DeleteShareLinkRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareLinkRequest) ProtoMessage() {}
+
+func (x *DeleteShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteShareLinkRequest) GetShareLinkId() string {
+	if x != nil {
+		if x.xxx_hidden_ShareLinkId != nil {
+			return *x.xxx_hidden_ShareLinkId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteShareLinkRequest) SetShareLinkId(v string) {
+	x.xxx_hidden_ShareLinkId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteShareLinkRequest) HasShareLinkId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteShareLinkRequest) ClearShareLinkId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ShareLinkId = nil
+}
+
+type DeleteShareLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the share link to delete.
+	ShareLinkId *string
+}
+
+func (b0 DeleteShareLinkRequest_builder) Build() *DeleteShareLinkRequest {
+	m0 := &DeleteShareLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ShareLinkId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ShareLinkId = b.ShareLinkId
+	}
+	return m0
+}
+
+// Response for DeleteShareLink.
+type DeleteShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareLinkResponse) Reset() {
+	*x = DeleteShareLinkResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareLinkResponse) ProtoMessage() {}
+
+func (x *DeleteShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteShareLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteShareLinkResponse_builder) Build() *DeleteShareLinkResponse {
+	m0 := &DeleteShareLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Request for ScheduleVehicleTachoDownload.
+type ScheduleVehicleTachoDownloadRequest struct {
+	state                  protoimpl.MessageState                       `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                                      `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_RequestName *string                                      `protobuf:"bytes,2,opt,name=request_name,json=requestName"`
+	xxx_hidden_FromTime    *timestamppb.Timestamp                       `protobuf:"bytes,3,opt,name=from_time,json=fromTime"`
+	xxx_hidden_ToTime      *timestamppb.Timestamp                       `protobuf:"bytes,4,opt,name=to_time,json=toTime"`
+	xxx_hidden_Options     []ScheduleVehicleTachoDownloadRequest_Option `protobuf:"varint,5,rep,packed,name=options,enum=wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest_Option"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) Reset() {
+	*x = ScheduleVehicleTachoDownloadRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVehicleTachoDownloadRequest) ProtoMessage() {}
+
+func (x *ScheduleVehicleTachoDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) GetRequestName() string {
+	if x != nil {
+		if x.xxx_hidden_RequestName != nil {
+			return *x.xxx_hidden_RequestName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FromTime
+	}
+	return nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ToTime
+	}
+	return nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) GetOptions() []ScheduleVehicleTachoDownloadRequest_Option {
+	if x != nil {
+		return x.xxx_hidden_Options
+	}
+	return nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) SetRequestName(v string) {
+	x.xxx_hidden_RequestName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) SetFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FromTime = v
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) SetToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ToTime = v
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) SetOptions(v []ScheduleVehicleTachoDownloadRequest_Option) {
+	x.xxx_hidden_Options = v
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) HasRequestName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) HasFromTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromTime != nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) HasToTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToTime != nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) ClearRequestName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_RequestName = nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) ClearFromTime() {
+	x.xxx_hidden_FromTime = nil
+}
+
+func (x *ScheduleVehicleTachoDownloadRequest) ClearToTime() {
+	x.xxx_hidden_ToTime = nil
+}
+
+type ScheduleVehicleTachoDownloadRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object to download the data from.
+	ObjectId *string
+	// The name of the request.
+	RequestName *string
+	// Start of the time period to download.
+	FromTime *timestamppb.Timestamp
+	// End of the time period to download.
+	ToTime *timestamppb.Timestamp
+	// Additional data to download.
+	Options []ScheduleVehicleTachoDownloadRequest_Option
+}
+
+func (b0 ScheduleVehicleTachoDownloadRequest_builder) Build() *ScheduleVehicleTachoDownloadRequest {
+	m0 := &ScheduleVehicleTachoDownloadRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.RequestName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_RequestName = b.RequestName
+	}
+	x.xxx_hidden_FromTime = b.FromTime
+	x.xxx_hidden_ToTime = b.ToTime
+	x.xxx_hidden_Options = b.Options
+	return m0
+}
+
+// Response for ScheduleVehicleTachoDownload.
+type ScheduleVehicleTachoDownloadResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RequestId   *string                `protobuf:"bytes,1,opt,name=request_id,json=requestId"`
+	xxx_hidden_CreateTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) Reset() {
+	*x = ScheduleVehicleTachoDownloadResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVehicleTachoDownloadResponse) ProtoMessage() {}
+
+func (x *ScheduleVehicleTachoDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) GetRequestId() string {
+	if x != nil {
+		if x.xxx_hidden_RequestId != nil {
+			return *x.xxx_hidden_RequestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreateTime
+	}
+	return nil
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) SetRequestId(v string) {
+	x.xxx_hidden_RequestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) SetCreateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateTime = v
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) HasRequestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) HasCreateTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreateTime != nil
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) ClearRequestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RequestId = nil
+}
+
+func (x *ScheduleVehicleTachoDownloadResponse) ClearCreateTime() {
+	x.xxx_hidden_CreateTime = nil
+}
+
+type ScheduleVehicleTachoDownloadResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the scheduled request.
+	RequestId *string
+	// The time the request was created.
+	CreateTime *timestamppb.Timestamp
+}
+
+func (b0 ScheduleVehicleTachoDownloadResponse_builder) Build() *ScheduleVehicleTachoDownloadResponse {
+	m0 := &ScheduleVehicleTachoDownloadResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.RequestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_RequestId = b.RequestId
+	}
+	x.xxx_hidden_CreateTime = b.CreateTime
+	return m0
+}
+
+// Request for ScheduleDriverCardTachoDownload.
+type ScheduleDriverCardTachoDownloadRequest struct {
+	state                  protoimpl.MessageState                      `protogen:"opaque.v1"`
+	xxx_hidden_ObjectId    *string                                     `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
+	xxx_hidden_RequestName *string                                     `protobuf:"bytes,2,opt,name=request_name,json=requestName"`
+	xxx_hidden_Slot        ScheduleDriverCardTachoDownloadRequest_Slot `protobuf:"varint,3,opt,name=slot,enum=wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest_Slot"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) Reset() {
+	*x = ScheduleDriverCardTachoDownloadRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDriverCardTachoDownloadRequest) ProtoMessage() {}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) GetObjectId() string {
+	if x != nil {
+		if x.xxx_hidden_ObjectId != nil {
+			return *x.xxx_hidden_ObjectId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) GetRequestName() string {
+	if x != nil {
+		if x.xxx_hidden_RequestName != nil {
+			return *x.xxx_hidden_RequestName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) GetSlot() ScheduleDriverCardTachoDownloadRequest_Slot {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Slot
+		}
+	}
+	return ScheduleDriverCardTachoDownloadRequest_SLOT_UNSPECIFIED
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) SetObjectId(v string) {
+	x.xxx_hidden_ObjectId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) SetRequestName(v string) {
+	x.xxx_hidden_RequestName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) SetSlot(v ScheduleDriverCardTachoDownloadRequest_Slot) {
+	x.xxx_hidden_Slot = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) HasObjectId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) HasRequestName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) HasSlot() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) ClearObjectId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ObjectId = nil
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) ClearRequestName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_RequestName = nil
+}
+
+func (x *ScheduleDriverCardTachoDownloadRequest) ClearSlot() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Slot = ScheduleDriverCardTachoDownloadRequest_SLOT_UNSPECIFIED
+}
+
+type ScheduleDriverCardTachoDownloadRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the object with the driver card inserted.
+	ObjectId *string
+	// The name of the request.
+	RequestName *string
+	// The tachograph slot of the driver card.
+	Slot *ScheduleDriverCardTachoDownloadRequest_Slot
+}
+
+func (b0 ScheduleDriverCardTachoDownloadRequest_builder) Build() *ScheduleDriverCardTachoDownloadRequest {
+	m0 := &ScheduleDriverCardTachoDownloadRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ObjectId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_ObjectId = b.ObjectId
+	}
+	if b.RequestName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_RequestName = b.RequestName
+	}
+	if b.Slot != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Slot = *b.Slot
+	}
+	return m0
+}
+
+// Response for ScheduleDriverCardTachoDownload.
+type ScheduleDriverCardTachoDownloadResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RequestId   *string                `protobuf:"bytes,1,opt,name=request_id,json=requestId"`
+	xxx_hidden_CreateTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScheduleDriverCardTachoDownloadResponse) Reset() {
+	*x = ScheduleDriverCardTachoDownloadResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDriverCardTachoDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDriverCardTachoDownloadResponse) ProtoMessage() {}
+
+func (x *ScheduleDriverCardTachoDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScheduleDriverCardTachoDownloadResponse) GetRequestId() string {
 	if x != nil {
-		if x.xxx_hidden_ContinuationToken != nil {
-			return *x.xxx_hidden_ContinuationToken
+		if x.xxx_hidden_RequestId != nil {
+			return *x.xxx_hidden_RequestId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *ListObjectGroupsRequest) SetLimit(v int32) {
-	x.xxx_hidden_Limit = v
+func (x *ScheduleDriverCardTachoDownloadResponse) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreateTime
+	}
+	return nil
+}
+
+func (x *ScheduleDriverCardTachoDownloadResponse) SetRequestId(v string) {
+	x.xxx_hidden_RequestId = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *ListObjectGroupsRequest) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *ScheduleDriverCardTachoDownloadResponse) SetCreateTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateTime = v
 }
 
-func (x *ListObjectGroupsRequest) HasLimit() bool {
+func (x *ScheduleDriverCardTachoDownloadResponse) HasRequestId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListObjectGroupsRequest) HasContinuationToken() bool {
+func (x *ScheduleDriverCardTachoDownloadResponse) HasCreateTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return x.xxx_hidden_CreateTime != nil
 }
 
-func (x *ListObjectGroupsRequest) ClearLimit() {
+func (x *ScheduleDriverCardTachoDownloadResponse) ClearRequestId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Limit = 0
+	x.xxx_hidden_RequestId = nil
 }
 
-func (x *ListObjectGroupsRequest) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_ContinuationToken = nil
+func (x *ScheduleDriverCardTachoDownloadResponse) ClearCreateTime() {
+	x.xxx_hidden_CreateTime = nil
 }
 
-type ListObjectGroupsRequest_builder struct {
+type ScheduleDriverCardTachoDownloadResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// Max results to return (default 100, max 1000).
-	Limit *int32
-	// Continuation token from a previous response.
-	ContinuationToken *string
+	// The ID of the scheduled request.
+	RequestId *string
+	// The time the request was created.
+	CreateTime *timestamppb.Timestamp
 }
 
-func (b0 ListObjectGroupsRequest_builder) Build() *ListObjectGroupsRequest {
-	m0 := &ListObjectGroupsRequest{}
+func (b0 ScheduleDriverCardTachoDownloadResponse_builder) Build() *ScheduleDriverCardTachoDownloadResponse {
+	m0 := &ScheduleDriverCardTachoDownloadResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Limit != nil {
+	if b.RequestId != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_Limit = *b.Limit
-	}
-	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+		x.xxx_hidden_RequestId = b.RequestId
 	}
+	x.xxx_hidden_CreateTime = b.CreateTime
 	return m0
 }
 
-// Response for ListObjectGroups.
-type ListObjectGroupsResponse struct {
+// Request for ListTachoRequests.
+type ListTachoRequestsRequest struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ObjectGroups      *[]*ObjectGroup        `protobuf:"bytes,1,rep,name=object_groups,json=objectGroups"`
-	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	xxx_hidden_RequestIds        []string               `protobuf:"bytes,1,rep,name=request_ids,json=requestIds"`
+	xxx_hidden_ObjectIds         []string               `protobuf:"bytes,2,rep,name=object_ids,json=objectIds"`
+	xxx_hidden_DriverCardNumbers []string               `protobuf:"bytes,3,rep,name=driver_card_numbers,json=driverCardNumbers"`
+	xxx_hidden_Types             []TachoRequest_Type    `protobuf:"varint,4,rep,packed,name=types,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Type"`
+	xxx_hidden_Statuses          []TachoRequest_Status  `protobuf:"varint,5,rep,packed,name=statuses,enum=wayplatform.connect.trusttrack.v1.TachoRequest_Status"`
+	xxx_hidden_CreateFromTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_from_time,json=createFromTime"`
+	xxx_hidden_CreateToTime      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_to_time,json=createToTime"`
+	xxx_hidden_UpdateFromTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_from_time,json=updateFromTime"`
+	xxx_hidden_UpdateToTime      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_to_time,json=updateToTime"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,10,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,11,opt,name=continuation_token,json=continuationToken"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListObjectGroupsResponse) Reset() {
-	*x = ListObjectGroupsResponse{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[7]
+func (x *ListTachoRequestsRequest) Reset() {
+	*x = ListTachoRequestsRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectGroupsResponse) String() string {
+func (x *ListTachoRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectGroupsResponse) ProtoMessage() {}
+func (*ListTachoRequestsRequest) ProtoMessage() {}
 
-func (x *ListObjectGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[7]
+func (x *ListTachoRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,133 +7810,77 @@ func (x *ListObjectGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectGroupsResponse) GetObjectGroups() []*ObjectGroup {
+func (x *ListTachoRequestsRequest) GetRequestIds() []string {
 	if x != nil {
-		if x.xxx_hidden_ObjectGroups != nil {
-			return *x.xxx_hidden_ObjectGroups
-		}
+		return x.xxx_hidden_RequestIds
 	}
 	return nil
 }
 
-func (x *ListObjectGroupsResponse) GetContinuationToken() string {
+func (x *ListTachoRequestsRequest) GetObjectIds() []string {
 	if x != nil {
-		if x.xxx_hidden_ContinuationToken != nil {
-			return *x.xxx_hidden_ContinuationToken
-		}
-		return ""
+		return x.xxx_hidden_ObjectIds
 	}
-	return ""
-}
-
-func (x *ListObjectGroupsResponse) SetObjectGroups(v []*ObjectGroup) {
-	x.xxx_hidden_ObjectGroups = &v
-}
-
-func (x *ListObjectGroupsResponse) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	return nil
 }
 
-func (x *ListObjectGroupsResponse) HasContinuationToken() bool {
-	if x == nil {
-		return false
+func (x *ListTachoRequestsRequest) GetDriverCardNumbers() []string {
+	if x != nil {
+		return x.xxx_hidden_DriverCardNumbers
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
-}
-
-func (x *ListObjectGroupsResponse) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_ContinuationToken = nil
-}
-
-type ListObjectGroupsResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	// The object groups.
-	ObjectGroups []*ObjectGroup
-	// Continuation token for the next page, empty when no more results.
-	ContinuationToken *string
+	return nil
 }
 
-func (b0 ListObjectGroupsResponse_builder) Build() *ListObjectGroupsResponse {
-	m0 := &ListObjectGroupsResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_ObjectGroups = &b.ObjectGroups
-	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+func (x *ListTachoRequestsRequest) GetTypes() []TachoRequest_Type {
+	if x != nil {
+		return x.xxx_hidden_Types
 	}
-	return m0
-}
-
-// Request for ListObjectCoordinates.
-type ListObjectCoordinatesRequest struct {
-	state                            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ObjectId              *string                `protobuf:"bytes,1,opt,name=object_id,json=objectId"`
-	xxx_hidden_FromTime              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime"`
-	xxx_hidden_ToTime                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime"`
-	xxx_hidden_ContinuationToken     *string                `protobuf:"bytes,4,opt,name=continuation_token,json=continuationToken"`
-	xxx_hidden_Limit                 int32                  `protobuf:"varint,5,opt,name=limit"`
-	xxx_hidden_IncludeGeozones       bool                   `protobuf:"varint,6,opt,name=include_geozones,json=includeGeozones"`
-	xxx_hidden_IncludeTireParameters bool                   `protobuf:"varint,7,opt,name=include_tire_parameters,json=includeTireParameters"`
-	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
-	XXX_presence                     [1]uint32
-	unknownFields                    protoimpl.UnknownFields
-	sizeCache                        protoimpl.SizeCache
+	return nil
 }
 
-func (x *ListObjectCoordinatesRequest) Reset() {
-	*x = ListObjectCoordinatesRequest{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListTachoRequestsRequest) GetStatuses() []TachoRequest_Status {
+	if x != nil {
+		return x.xxx_hidden_Statuses
+	}
+	return nil
 }
 
-func (x *ListObjectCoordinatesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListTachoRequestsRequest) GetCreateFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreateFromTime
+	}
+	return nil
 }
 
-func (*ListObjectCoordinatesRequest) ProtoMessage() {}
-
-func (x *ListObjectCoordinatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[8]
+func (x *ListTachoRequestsRequest) GetCreateToTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.xxx_hidden_CreateToTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-func (x *ListObjectCoordinatesRequest) GetObjectId() string {
+func (x *ListTachoRequestsRequest) GetUpdateFromTime() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_ObjectId != nil {
-			return *x.xxx_hidden_ObjectId
-		}
-		return ""
+		return x.xxx_hidden_UpdateFromTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ListObjectCoordinatesRequest) GetFromTime() *timestamppb.Timestamp {
+func (x *ListTachoRequestsRequest) GetUpdateToTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.xxx_hidden_FromTime
+		return x.xxx_hidden_UpdateToTime
 	}
 	return nil
 }
 
-func (x *ListObjectCoordinatesRequest) GetToTime() *timestamppb.Timestamp {
+func (x *ListTachoRequestsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.xxx_hidden_ToTime
+		return x.xxx_hidden_Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *ListObjectCoordinatesRequest) GetContinuationToken() string {
+func (x *ListTachoRequestsRequest) GetContinuationToken() string {
 	if x != nil {
 		if x.xxx_hidden_ContinuationToken != nil {
 			return *x.xxx_hidden_ContinuationToken
@@ -1024,194 +7890,175 @@ func (x *ListObjectCoordinatesRequest) GetContinuationToken() string {
 	return ""
 }
 
-func (x *ListObjectCoordinatesRequest) GetLimit() int32 {
-	if x != nil {
-		return x.xxx_hidden_Limit
-	}
-	return 0
+func (x *ListTachoRequestsRequest) SetRequestIds(v []string) {
+	x.xxx_hidden_RequestIds = v
 }
 
-func (x *ListObjectCoordinatesRequest) GetIncludeGeozones() bool {
-	if x != nil {
-		return x.xxx_hidden_IncludeGeozones
-	}
-	return false
+func (x *ListTachoRequestsRequest) SetObjectIds(v []string) {
+	x.xxx_hidden_ObjectIds = v
 }
 
-func (x *ListObjectCoordinatesRequest) GetIncludeTireParameters() bool {
-	if x != nil {
-		return x.xxx_hidden_IncludeTireParameters
-	}
-	return false
+func (x *ListTachoRequestsRequest) SetDriverCardNumbers(v []string) {
+	x.xxx_hidden_DriverCardNumbers = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetObjectId(v string) {
-	x.xxx_hidden_ObjectId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+func (x *ListTachoRequestsRequest) SetTypes(v []TachoRequest_Type) {
+	x.xxx_hidden_Types = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetFromTime(v *timestamppb.Timestamp) {
-	x.xxx_hidden_FromTime = v
+func (x *ListTachoRequestsRequest) SetStatuses(v []TachoRequest_Status) {
+	x.xxx_hidden_Statuses = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetToTime(v *timestamppb.Timestamp) {
-	x.xxx_hidden_ToTime = v
+func (x *ListTachoRequestsRequest) SetCreateFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateFromTime = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+func (x *ListTachoRequestsRequest) SetCreateToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreateToTime = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetLimit(v int32) {
-	x.xxx_hidden_Limit = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+func (x *ListTachoRequestsRequest) SetUpdateFromTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdateFromTime = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetIncludeGeozones(v bool) {
-	x.xxx_hidden_IncludeGeozones = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+func (x *ListTachoRequestsRequest) SetUpdateToTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdateToTime = v
 }
 
-func (x *ListObjectCoordinatesRequest) SetIncludeTireParameters(v bool) {
-	x.xxx_hidden_IncludeTireParameters = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+func (x *ListTachoRequestsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
 }
 
-func (x *ListObjectCoordinatesRequest) HasObjectId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+func (x *ListTachoRequestsRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 11)
 }
 
-func (x *ListObjectCoordinatesRequest) HasFromTime() bool {
+func (x *ListTachoRequestsRequest) HasCreateFromTime() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_FromTime != nil
+	return x.xxx_hidden_CreateFromTime != nil
 }
 
-func (x *ListObjectCoordinatesRequest) HasToTime() bool {
+func (x *ListTachoRequestsRequest) HasCreateToTime() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_ToTime != nil
+	return x.xxx_hidden_CreateToTime != nil
 }
 
-func (x *ListObjectCoordinatesRequest) HasContinuationToken() bool {
+func (x *ListTachoRequestsRequest) HasUpdateFromTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+	return x.xxx_hidden_UpdateFromTime != nil
 }
 
-func (x *ListObjectCoordinatesRequest) HasLimit() bool {
+func (x *ListTachoRequestsRequest) HasUpdateToTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return x.xxx_hidden_UpdateToTime != nil
 }
 
-func (x *ListObjectCoordinatesRequest) HasIncludeGeozones() bool {
+func (x *ListTachoRequestsRequest) HasLimit() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
 }
 
-func (x *ListObjectCoordinatesRequest) HasIncludeTireParameters() bool {
+func (x *ListTachoRequestsRequest) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
 }
 
-func (x *ListObjectCoordinatesRequest) ClearObjectId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_ObjectId = nil
+func (x *ListTachoRequestsRequest) ClearCreateFromTime() {
+	x.xxx_hidden_CreateFromTime = nil
 }
 
-func (x *ListObjectCoordinatesRequest) ClearFromTime() {
-	x.xxx_hidden_FromTime = nil
+func (x *ListTachoRequestsRequest) ClearCreateToTime() {
+	x.xxx_hidden_CreateToTime = nil
 }
 
-func (x *ListObjectCoordinatesRequest) ClearToTime() {
-	x.xxx_hidden_ToTime = nil
+func (x *ListTachoRequestsRequest) ClearUpdateFromTime() {
+	x.xxx_hidden_UpdateFromTime = nil
 }
 
-func (x *ListObjectCoordinatesRequest) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_ContinuationToken = nil
+func (x *ListTachoRequestsRequest) ClearUpdateToTime() {
+	x.xxx_hidden_UpdateToTime = nil
 }
 
-func (x *ListObjectCoordinatesRequest) ClearLimit() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+func (x *ListTachoRequestsRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
 	x.xxx_hidden_Limit = 0
 }
 
-func (x *ListObjectCoordinatesRequest) ClearIncludeGeozones() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_IncludeGeozones = false
-}
-
-func (x *ListObjectCoordinatesRequest) ClearIncludeTireParameters() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_IncludeTireParameters = false
+func (x *ListTachoRequestsRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_ContinuationToken = nil
 }
 
-type ListObjectCoordinatesRequest_builder struct {
+type ListTachoRequestsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The external object ID.
-	ObjectId *string
-	// Start of the time window (inclusive).
-	FromTime *timestamppb.Timestamp
-	// End of the time window (exclusive, optional).
-	ToTime *timestamppb.Timestamp
+	// Filter by request IDs.
+	RequestIds []string
+	// Filter by object IDs.
+	ObjectIds []string
+	// Filter by driver card numbers.
+	DriverCardNumbers []string
+	// Filter by request types.
+	Types []TachoRequest_Type
+	// Filter by request statuses.
+	Statuses []TachoRequest_Status
+	// Start of the creation time window (inclusive).
+	CreateFromTime *timestamppb.Timestamp
+	// End of the creation time window.
+	CreateToTime *timestamppb.Timestamp
+	// Start of the update time window (inclusive).
+	UpdateFromTime *timestamppb.Timestamp
+	// End of the update time window.
+	UpdateToTime *timestamppb.Timestamp
+	// Max results to return.
+	Limit *int32
 	// Continuation token from a previous response.
 	ContinuationToken *string
-	// Max results to return (default 1000, max 1000).
-	Limit *int32
-	// Whether to include geozone information.
-	IncludeGeozones *bool
-	// Whether to include tire pressure information.
-	IncludeTireParameters *bool
 }
 
-func (b0 ListObjectCoordinatesRequest_builder) Build() *ListObjectCoordinatesRequest {
-	m0 := &ListObjectCoordinatesRequest{}
+func (b0 ListTachoRequestsRequest_builder) Build() *ListTachoRequestsRequest {
+	m0 := &ListTachoRequestsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.ObjectId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
-		x.xxx_hidden_ObjectId = b.ObjectId
-	}
-	x.xxx_hidden_FromTime = b.FromTime
-	x.xxx_hidden_ToTime = b.ToTime
-	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
-		x.xxx_hidden_ContinuationToken = b.ContinuationToken
-	}
+	x.xxx_hidden_RequestIds = b.RequestIds
+	x.xxx_hidden_ObjectIds = b.ObjectIds
+	x.xxx_hidden_DriverCardNumbers = b.DriverCardNumbers
+	x.xxx_hidden_Types = b.Types
+	x.xxx_hidden_Statuses = b.Statuses
+	x.xxx_hidden_CreateFromTime = b.CreateFromTime
+	x.xxx_hidden_CreateToTime = b.CreateToTime
+	x.xxx_hidden_UpdateFromTime = b.UpdateFromTime
+	x.xxx_hidden_UpdateToTime = b.UpdateToTime
 	if b.Limit != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
 		x.xxx_hidden_Limit = *b.Limit
 	}
-	if b.IncludeGeozones != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
-		x.xxx_hidden_IncludeGeozones = *b.IncludeGeozones
-	}
-	if b.IncludeTireParameters != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
-		x.xxx_hidden_IncludeTireParameters = *b.IncludeTireParameters
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 11)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
 	}
 	return m0
 }
 
-// Response for ListObjectCoordinates.
-type ListObjectCoordinatesResponse struct {
+// Response for ListTachoRequests.
+type ListTachoRequestsResponse struct {
 	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Coordinates       *[]*Coordinate         `protobuf:"bytes,1,rep,name=coordinates"`
+	xxx_hidden_TachoRequests     *[]*TachoRequest       `protobuf:"bytes,1,rep,name=tacho_requests,json=tachoRequests"`
 	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
@@ -1219,21 +8066,21 @@ type ListObjectCoordinatesResponse struct {
 	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *ListObjectCoordinatesResponse) Reset() {
-	*x = ListObjectCoordinatesResponse{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[9]
+func (x *ListTachoRequestsResponse) Reset() {
+	*x = ListTachoRequestsResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectCoordinatesResponse) String() string {
+func (x *ListTachoRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectCoordinatesResponse) ProtoMessage() {}
+func (*ListTachoRequestsResponse) ProtoMessage() {}
 
-func (x *ListObjectCoordinatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[9]
+func (x *ListTachoRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,16 +8091,16 @@ func (x *ListObjectCoordinatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectCoordinatesResponse) GetCoordinates() []*Coordinate {
+func (x *ListTachoRequestsResponse) GetTachoRequests() []*TachoRequest {
 	if x != nil {
-		if x.xxx_hidden_Coordinates != nil {
-			return *x.xxx_hidden_Coordinates
+		if x.xxx_hidden_TachoRequests != nil {
+			return *x.xxx_hidden_TachoRequests
 		}
 	}
 	return nil
 }
 
-func (x *ListObjectCoordinatesResponse) GetContinuationToken() string {
+func (x *ListTachoRequestsResponse) GetContinuationToken() string {
 	if x != nil {
 		if x.xxx_hidden_ContinuationToken != nil {
 			return *x.xxx_hidden_ContinuationToken
@@ -1263,41 +8110,41 @@ func (x *ListObjectCoordinatesResponse) GetContinuationToken() string {
 	return ""
 }
 
-func (x *ListObjectCoordinatesResponse) SetCoordinates(v []*Coordinate) {
-	x.xxx_hidden_Coordinates = &v
+func (x *ListTachoRequestsResponse) SetTachoRequests(v []*TachoRequest) {
+	x.xxx_hidden_TachoRequests = &v
 }
 
-func (x *ListObjectCoordinatesResponse) SetContinuationToken(v string) {
+func (x *ListTachoRequestsResponse) SetContinuationToken(v string) {
 	x.xxx_hidden_ContinuationToken = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *ListObjectCoordinatesResponse) HasContinuationToken() bool {
+func (x *ListTachoRequestsResponse) HasContinuationToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *ListObjectCoordinatesResponse) ClearContinuationToken() {
+func (x *ListTachoRequestsResponse) ClearContinuationToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_ContinuationToken = nil
 }
 
-type ListObjectCoordinatesResponse_builder struct {
+type ListTachoRequestsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The coordinates.
-	Coordinates []*Coordinate
+	// The tachograph download requests.
+	TachoRequests []*TachoRequest
 	// Continuation token for the next page, empty when no more results.
 	ContinuationToken *string
 }
 
-func (b0 ListObjectCoordinatesResponse_builder) Build() *ListObjectCoordinatesResponse {
-	m0 := &ListObjectCoordinatesResponse{}
+func (b0 ListTachoRequestsResponse_builder) Build() *ListTachoRequestsResponse {
+	m0 := &ListTachoRequestsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Coordinates = &b.Coordinates
+	x.xxx_hidden_TachoRequests = &b.TachoRequests
 	if b.ContinuationToken != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
 		x.xxx_hidden_ContinuationToken = b.ContinuationToken
@@ -1305,28 +8152,31 @@ func (b0 ListObjectCoordinatesResponse_builder) Build() *ListObjectCoordinatesRe
 	return m0
 }
 
-// Request for ListObjects.
-type ListObjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request for GetTachoRequest.
+type GetTachoRequestRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RequestId   *string                `protobuf:"bytes,1,opt,name=request_id,json=requestId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListObjectsRequest) Reset() {
-	*x = ListObjectsRequest{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[10]
+func (x *GetTachoRequestRequest) Reset() {
+	*x = GetTachoRequestRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectsRequest) String() string {
+func (x *GetTachoRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectsRequest) ProtoMessage() {}
+func (*GetTachoRequestRequest) ProtoMessage() {}
 
-func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[10]
+func (x *GetTachoRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,41 +8187,74 @@ func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type ListObjectsRequest_builder struct {
+func (x *GetTachoRequestRequest) GetRequestId() string {
+	if x != nil {
+		if x.xxx_hidden_RequestId != nil {
+			return *x.xxx_hidden_RequestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetTachoRequestRequest) SetRequestId(v string) {
+	x.xxx_hidden_RequestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetTachoRequestRequest) HasRequestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetTachoRequestRequest) ClearRequestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RequestId = nil
+}
+
+type GetTachoRequestRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// The ID of the request.
+	RequestId *string
 }
 
-func (b0 ListObjectsRequest_builder) Build() *ListObjectsRequest {
-	m0 := &ListObjectsRequest{}
+func (b0 GetTachoRequestRequest_builder) Build() *GetTachoRequestRequest {
+	m0 := &GetTachoRequestRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.RequestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_RequestId = b.RequestId
+	}
 	return m0
 }
 
-// Response for ListObjects.
-type ListObjectsResponse struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Objects *[]*Object             `protobuf:"bytes,1,rep,name=objects"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// Response for GetTachoRequest.
+type GetTachoRequestResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TachoRequest *TachoRequest          `protobuf:"bytes,1,opt,name=tacho_request,json=tachoRequest"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ListObjectsResponse) Reset() {
-	*x = ListObjectsResponse{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[11]
+func (x *GetTachoRequestResponse) Reset() {
+	*x = GetTachoRequestResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectsResponse) String() string {
+func (x *GetTachoRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectsResponse) ProtoMessage() {}
+func (*GetTachoRequestResponse) ProtoMessage() {}
 
-func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[11]
+func (x *GetTachoRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,60 +8265,68 @@ func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectsResponse) GetObjects() []*Object {
+func (x *GetTachoRequestResponse) GetTachoRequest() *TachoRequest {
 	if x != nil {
-		if x.xxx_hidden_Objects != nil {
-			return *x.xxx_hidden_Objects
-		}
+		return x.xxx_hidden_TachoRequest
 	}
 	return nil
 }
 
-func (x *ListObjectsResponse) SetObjects(v []*Object) {
-	x.xxx_hidden_Objects = &v
+func (x *GetTachoRequestResponse) SetTachoRequest(v *TachoRequest) {
+	x.xxx_hidden_TachoRequest = v
 }
 
-type ListObjectsResponse_builder struct {
+func (x *GetTachoRequestResponse) HasTachoRequest() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TachoRequest != nil
+}
+
+func (x *GetTachoRequestResponse) ClearTachoRequest() {
+	x.xxx_hidden_TachoRequest = nil
+}
+
+type GetTachoRequestResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The objects.
-	Objects []*Object
+	// The tachograph download request.
+	TachoRequest *TachoRequest
 }
 
-func (b0 ListObjectsResponse_builder) Build() *ListObjectsResponse {
-	m0 := &ListObjectsResponse{}
+func (b0 GetTachoRequestResponse_builder) Build() *GetTachoRequestResponse {
+	m0 := &GetTachoRequestResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Objects = &b.Objects
+	x.xxx_hidden_TachoRequest = b.TachoRequest
 	return m0
 }
 
-// Request for ListObjectsLastPosition.
-type ListObjectsLastPositionRequest struct {
-	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
-	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
-	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
-	XXX_presence                 [1]uint32
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+// Request for DeleteTachoRequest.
+type DeleteTachoRequestRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RequestId   *string                `protobuf:"bytes,1,opt,name=request_id,json=requestId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListObjectsLastPositionRequest) Reset() {
-	*x = ListObjectsLastPositionRequest{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[12]
+func (x *DeleteTachoRequestRequest) Reset() {
+	*x = DeleteTachoRequestRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectsLastPositionRequest) String() string {
+func (x *DeleteTachoRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectsLastPositionRequest) ProtoMessage() {}
+func (*DeleteTachoRequestRequest) ProtoMessage() {}
 
-func (x *ListObjectsLastPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[12]
+func (x *DeleteTachoRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,107 +8337,200 @@ func (x *ListObjectsLastPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectsLastPositionRequest) GetLimit() int32 {
-	if x != nil {
-		return x.xxx_hidden_Limit
-	}
-	return 0
-}
-
-func (x *ListObjectsLastPositionRequest) GetContinuationToken() string {
+func (x *DeleteTachoRequestRequest) GetRequestId() string {
 	if x != nil {
-		if x.xxx_hidden_ContinuationToken != nil {
-			return *x.xxx_hidden_ContinuationToken
+		if x.xxx_hidden_RequestId != nil {
+			return *x.xxx_hidden_RequestId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *ListObjectsLastPositionRequest) SetLimit(v int32) {
-	x.xxx_hidden_Limit = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
-}
-
-func (x *ListObjectsLastPositionRequest) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *DeleteTachoRequestRequest) SetRequestId(v string) {
+	x.xxx_hidden_RequestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *ListObjectsLastPositionRequest) HasLimit() bool {
+func (x *DeleteTachoRequestRequest) HasRequestId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListObjectsLastPositionRequest) HasContinuationToken() bool {
-	if x == nil {
-		return false
+func (x *DeleteTachoRequestRequest) ClearRequestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RequestId = nil
+}
+
+type DeleteTachoRequestRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the request to delete.
+	RequestId *string
+}
+
+func (b0 DeleteTachoRequestRequest_builder) Build() *DeleteTachoRequestRequest {
+	m0 := &DeleteTachoRequestRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.RequestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_RequestId = b.RequestId
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return m0
 }
 
-func (x *ListObjectsLastPositionRequest) ClearLimit() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Limit = 0
+// Response for DeleteTachoRequest.
+type DeleteTachoRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListObjectsLastPositionRequest) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_ContinuationToken = nil
+func (x *DeleteTachoRequestResponse) Reset() {
+	*x = DeleteTachoRequestResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ListObjectsLastPositionRequest_builder struct {
+func (x *DeleteTachoRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTachoRequestResponse) ProtoMessage() {}
+
+func (x *DeleteTachoRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteTachoRequestResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// Max results to return (default 1000, max 1000).
-	Limit *int32
-	// Continuation token from a previous response.
-	ContinuationToken *string
 }
 
-func (b0 ListObjectsLastPositionRequest_builder) Build() *ListObjectsLastPositionRequest {
-	m0 := &ListObjectsLastPositionRequest{}
+func (b0 DeleteTachoRequestResponse_builder) Build() *DeleteTachoRequestResponse {
+	m0 := &DeleteTachoRequestResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Limit != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_Limit = *b.Limit
+	return m0
+}
+
+// Request for GetTachoFile.
+type GetTachoFileRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RequestId   *string                `protobuf:"bytes,1,opt,name=request_id,json=requestId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTachoFileRequest) Reset() {
+	*x = GetTachoFileRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTachoFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTachoFileRequest) ProtoMessage() {}
+
+func (x *GetTachoFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	return mi.MessageOf(x)
+}
+
+func (x *GetTachoFileRequest) GetRequestId() string {
+	if x != nil {
+		if x.xxx_hidden_RequestId != nil {
+			return *x.xxx_hidden_RequestId
+		}
+		return ""
 	}
-	return m0
+	return ""
 }
 
-// Response for ListObjectsLastPosition.
-type ListObjectsLastPositionResponse struct {
-	state                        protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Objects           *[]*Object             `protobuf:"bytes,1,rep,name=objects"`
-	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
-	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
-	XXX_presence                 [1]uint32
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+func (x *GetTachoFileRequest) SetRequestId(v string) {
+	x.xxx_hidden_RequestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetTachoFileRequest) HasRequestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetTachoFileRequest) ClearRequestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_RequestId = nil
+}
+
+type GetTachoFileRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The ID of the completed request.
+	RequestId *string
+}
+
+func (b0 GetTachoFileRequest_builder) Build() *GetTachoFileRequest {
+	m0 := &GetTachoFileRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.RequestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_RequestId = b.RequestId
+	}
+	return m0
 }
 
-func (x *ListObjectsLastPositionResponse) Reset() {
-	*x = ListObjectsLastPositionResponse{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[13]
+// Response for GetTachoFile.
+type GetTachoFileResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,1,opt,name=data"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTachoFileResponse) Reset() {
+	*x = GetTachoFileResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectsLastPositionResponse) String() string {
+func (x *GetTachoFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectsLastPositionResponse) ProtoMessage() {}
+func (*GetTachoFileResponse) ProtoMessage() {}
 
-func (x *ListObjectsLastPositionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[13]
+func (x *GetTachoFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,63 +8541,47 @@ func (x *ListObjectsLastPositionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListObjectsLastPositionResponse) GetObjects() []*Object {
+func (x *GetTachoFileResponse) GetData() []byte {
 	if x != nil {
-		if x.xxx_hidden_Objects != nil {
-			return *x.xxx_hidden_Objects
-		}
+		return x.xxx_hidden_Data
 	}
 	return nil
 }
 
-func (x *ListObjectsLastPositionResponse) GetContinuationToken() string {
-	if x != nil {
-		if x.xxx_hidden_ContinuationToken != nil {
-			return *x.xxx_hidden_ContinuationToken
-		}
-		return ""
+func (x *GetTachoFileResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
 	}
-	return ""
-}
-
-func (x *ListObjectsLastPositionResponse) SetObjects(v []*Object) {
-	x.xxx_hidden_Objects = &v
-}
-
-func (x *ListObjectsLastPositionResponse) SetContinuationToken(v string) {
-	x.xxx_hidden_ContinuationToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *ListObjectsLastPositionResponse) HasContinuationToken() bool {
+func (x *GetTachoFileResponse) HasData() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *ListObjectsLastPositionResponse) ClearContinuationToken() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_ContinuationToken = nil
+func (x *GetTachoFileResponse) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data = nil
 }
 
-type ListObjectsLastPositionResponse_builder struct {
+type GetTachoFileResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The objects with their last position.
-	Objects []*Object
-	// Continuation token for the next page, empty when no more results.
-	ContinuationToken *string
+	// The contents of the downloaded file.
+	Data []byte
 }
 
-func (b0 ListObjectsLastPositionResponse_builder) Build() *ListObjectsLastPositionResponse {
-	m0 := &ListObjectsLastPositionResponse{}
+func (b0 GetTachoFileResponse_builder) Build() *GetTachoFileResponse {
+	m0 := &GetTachoFileResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Objects = &b.Objects
-	if b.ContinuationToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Data = b.Data
 	}
 	return m0
 }
@@ -1634,7 +8602,7 @@ type ListTripsRequest struct {
 
 func (x *ListTripsRequest) Reset() {
 	*x = ListTripsRequest{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[14]
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +8614,7 @@ func (x *ListTripsRequest) String() string {
 func (*ListTripsRequest) ProtoMessage() {}
 
 func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[14]
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +8796,7 @@ type ListTripsResponse struct {
 
 func (x *ListTripsResponse) Reset() {
 	*x = ListTripsResponse{}
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[15]
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1840,7 +8808,7 @@ func (x *ListTripsResponse) String() string {
 func (*ListTripsResponse) ProtoMessage() {}
 
 func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[15]
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,11 +8880,247 @@ func (b0 ListTripsResponse_builder) Build() *ListTripsResponse {
 	return m0
 }
 
+// Request for ListUsers.
+type ListUsersRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,1,opt,name=limit"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListUsersRequest) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListUsersRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListUsersRequest) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListUsersRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *ListUsersRequest) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListUsersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Max results to return (default 100).
+	Limit *int32
+	// Continuation token from a previous response.
+	ContinuationToken *string
+}
+
+func (b0 ListUsersRequest_builder) Build() *ListUsersRequest {
+	m0 := &ListUsersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
+// Response for ListUsers.
+type ListUsersResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Users             *[]*User               `protobuf:"bytes,1,rep,name=users"`
+	xxx_hidden_ContinuationToken *string                `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		if x.xxx_hidden_Users != nil {
+			return *x.xxx_hidden_Users
+		}
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetContinuationToken() string {
+	if x != nil {
+		if x.xxx_hidden_ContinuationToken != nil {
+			return *x.xxx_hidden_ContinuationToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) SetUsers(v []*User) {
+	x.xxx_hidden_Users = &v
+}
+
+func (x *ListUsersResponse) SetContinuationToken(v string) {
+	x.xxx_hidden_ContinuationToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListUsersResponse) HasContinuationToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListUsersResponse) ClearContinuationToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContinuationToken = nil
+}
+
+type ListUsersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The users.
+	Users []*User
+	// Continuation token for the next page, empty when no more results.
+	ContinuationToken *string
+}
+
+func (b0 ListUsersResponse_builder) Build() *ListUsersResponse {
+	m0 := &ListUsersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Users = &b.Users
+	if b.ContinuationToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContinuationToken = b.ContinuationToken
+	}
+	return m0
+}
+
 var File_wayplatform_connect_trusttrack_v1_trusttrack_api_proto protoreflect.FileDescriptor
 
 const file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc = "" +
 	"\n" +
-	"6wayplatform/connect/trusttrack/v1/trusttrack_api.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a2wayplatform/connect/trusttrack/v1/coordinate.proto\x1a.wayplatform/connect/trusttrack/v1/driver.proto\x1a2wayplatform/connect/trusttrack/v1/fuel_event.proto\x1a.wayplatform/connect/trusttrack/v1/object.proto\x1a4wayplatform/connect/trusttrack/v1/object_group.proto\x1a,wayplatform/connect/trusttrack/v1/trip.proto\"\xa2\x01\n" +
+	"6wayplatform/connect/trusttrack/v1/trusttrack_api.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a2wayplatform/connect/trusttrack/v1/coordinate.proto\x1a5wayplatform/connect/trusttrack/v1/country_visit.proto\x1a6wayplatform/connect/trusttrack/v1/detected_event.proto\x1a.wayplatform/connect/trusttrack/v1/driver.proto\x1a:wayplatform/connect/trusttrack/v1/driver_assignation.proto\x1a4wayplatform/connect/trusttrack/v1/driver_state.proto\x1a<wayplatform/connect/trusttrack/v1/driver_time_analysis.proto\x1a9wayplatform/connect/trusttrack/v1/driver_time_table.proto\x1a2wayplatform/connect/trusttrack/v1/ecodriving.proto\x1a2wayplatform/connect/trusttrack/v1/fuel_event.proto\x1a/wayplatform/connect/trusttrack/v1/geozone.proto\x1a5wayplatform/connect/trusttrack/v1/geozone_visit.proto\x1a.wayplatform/connect/trusttrack/v1/object.proto\x1a4wayplatform/connect/trusttrack/v1/object_group.proto\x1a2wayplatform/connect/trusttrack/v1/share_link.proto\x1a5wayplatform/connect/trusttrack/v1/tacho_request.proto\x1a,wayplatform/connect/trusttrack/v1/trip.proto\x1a,wayplatform/connect/trusttrack/v1/user.proto\x1a1wayplatform/connect/trusttrack/v1/violation.proto\"\xf0\x01\n" +
+	"\x1eListObjectCountryVisitsRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x05 \x01(\tR\x11continuationToken\"\xa8\x01\n" +
+	"\x1fListObjectCountryVisitsResponse\x12V\n" +
+	"\x0ecountry_visits\x18\x01 \x03(\v2/.wayplatform.connect.trusttrack.v1.CountryVisitR\rcountryVisits\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xf0\x01\n" +
+	"\x1eListDriverCountryVisitsRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x05 \x01(\tR\x11continuationToken\"\xa8\x01\n" +
+	"\x1fListDriverCountryVisitsResponse\x12V\n" +
+	"\x0ecountry_visits\x18\x01 \x03(\v2/.wayplatform.connect.trusttrack.v1.CountryVisitR\rcountryVisits\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x84\x02\n" +
+	"\x19ListDetectedEventsRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x127\n" +
+	"\tfrom_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x06 \x01(\tR\x11continuationToken\"\xa6\x01\n" +
+	"\x1aListDetectedEventsResponse\x12Y\n" +
+	"\x0fdetected_events\x18\x01 \x03(\v20.wayplatform.connect.trusttrack.v1.DetectedEventR\x0edetectedEvents\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xa2\x01\n" +
 	"\x12ListDriversRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
 	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\x12'\n" +
@@ -1926,7 +9130,87 @@ const file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc = "" +
 	"identifier\"\x89\x01\n" +
 	"\x13ListDriversResponse\x12C\n" +
 	"\adrivers\x18\x01 \x03(\v2).wayplatform.connect.trusttrack.v1.DriverR\adrivers\x12-\n" +
-	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xe7\x01\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"X\n" +
+	"\x13CreateDriverRequest\x12A\n" +
+	"\x06driver\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.DriverR\x06driver\"Y\n" +
+	"\x14CreateDriverResponse\x12A\n" +
+	"\x06driver\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.DriverR\x06driver\"\x95\x01\n" +
+	"\x13UpdateDriverRequest\x12A\n" +
+	"\x06driver\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.DriverR\x06driver\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"Y\n" +
+	"\x14UpdateDriverResponse\x12A\n" +
+	"\x06driver\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.DriverR\x06driver\"2\n" +
+	"\x13DeleteDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\"\x16\n" +
+	"\x14DeleteDriverResponse\"[\n" +
+	"\x1fGetLastDriverAssignationRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\tR\bobjectId\"\x87\x01\n" +
+	" GetLastDriverAssignationResponse\x12c\n" +
+	"\x12driver_assignation\x18\x01 \x01(\v24.wayplatform.connect.trusttrack.v1.DriverAssignationR\x11driverAssignation\"\x85\x01\n" +
+	"\x1eCreateDriverAssignationRequest\x12c\n" +
+	"\x12driver_assignation\x18\x01 \x01(\v24.wayplatform.connect.trusttrack.v1.DriverAssignationR\x11driverAssignation\"\x86\x01\n" +
+	"\x1fCreateDriverAssignationResponse\x12c\n" +
+	"\x12driver_assignation\x18\x01 \x01(\v24.wayplatform.connect.trusttrack.v1.DriverAssignationR\x11driverAssignation\";\n" +
+	"\x1cGetDriverTimeAnalysisRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\"\x88\x01\n" +
+	"\x1dGetDriverTimeAnalysisResponse\x12g\n" +
+	"\x14driver_time_analysis\x18\x01 \x01(\v25.wayplatform.connect.trusttrack.v1.DriverTimeAnalysisR\x12driverTimeAnalysis\"\x81\x05\n" +
+	"\x19GetDriverTimeTableRequest\x12\x90\x02\n" +
+	"\x11driver_identifier\x18\x01 \x01(\v23.wayplatform.connect.trusttrack.v1.DriverIdentifierB\xad\x01\xbaH\xa9\x01\xba\x01S\n" +
+	"\x1cdriver_identifier.identifier\x12\x1cidentifier must not be empty\x1a\x15this.identifier != ''\xba\x01M\n" +
+	"\x16driver_identifier.type\x12$type must be a known identifier type\x1a\rthis.type > 1\xc8\x01\x01R\x10driverIdentifier\x12$\n" +
+	"\ttime_zone\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\btimeZone\x12\x1f\n" +
+	"\x05month\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\f(\x01R\x05month\x12\x1f\n" +
+	"\x04year\x18\x04 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x8fN(\xb2\x0fR\x04year\x12X\n" +
+	"\x14business_hours_start\x18\x05 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x12businessHoursStart\x12T\n" +
+	"\x12business_hours_end\x18\x06 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x10businessHoursEnd\x129\n" +
+	"\x19available_as_working_time\x18\a \x01(\bR\x16availableAsWorkingTime\"|\n" +
+	"\x1aGetDriverTimeTableResponse\x12^\n" +
+	"\x11driver_time_table\x18\x01 \x01(\v22.wayplatform.connect.trusttrack.v1.DriverTimeTableR\x0fdriverTimeTable\"\xe9\x01\n" +
+	"\x17ListDriverStatesRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12-\n" +
+	"\x12continuation_token\x18\x04 \x01(\tR\x11continuationToken\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x9e\x01\n" +
+	"\x18ListDriverStatesResponse\x12S\n" +
+	"\rdriver_states\x18\x01 \x03(\v2..wayplatform.connect.trusttrack.v1.DriverStateR\fdriverStates\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xd2\x03\n" +
+	"\x1bListDriverViolationsRequest\x12&\n" +
+	"\fcard_numbers\x18\x01 \x03(\tB\x03\x80\x01\x01R\vcardNumbers\x12\x1c\n" +
+	"\tcountries\x18\x02 \x03(\tR\tcountries\x127\n" +
+	"\tfrom_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12U\n" +
+	"\n" +
+	"severities\x18\x05 \x03(\x0e25.wayplatform.connect.trusttrack.v1.Violation.SeverityR\n" +
+	"severities\x12G\n" +
+	"\x05types\x18\x06 \x03(\x0e21.wayplatform.connect.trusttrack.v1.Violation.TypeR\x05types\x12\x1a\n" +
+	"\bvehicles\x18\a \x03(\tR\bvehicles\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\t \x01(\tR\x11continuationToken\"\x9b\x01\n" +
+	"\x1cListDriverViolationsResponse\x12L\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2,.wayplatform.connect.trusttrack.v1.ViolationR\n" +
+	"violations\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xa8\x01\n" +
+	"\x1bListObjectEcodrivingRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\"m\n" +
+	"\x1cListObjectEcodrivingResponse\x12M\n" +
+	"\n" +
+	"ecodriving\x18\x01 \x01(\v2-.wayplatform.connect.trusttrack.v1.EcodrivingR\n" +
+	"ecodriving\"\xa8\x01\n" +
+	"\x1bListDriverEcodrivingRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\"m\n" +
+	"\x1cListDriverEcodrivingResponse\x12M\n" +
+	"\n" +
+	"ecodriving\x18\x01 \x01(\v2-.wayplatform.connect.trusttrack.v1.EcodrivingR\n" +
+	"ecodriving\"\xe7\x01\n" +
 	"\x15ListFuelEventsRequest\x12\x1b\n" +
 	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x127\n" +
 	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
@@ -1936,18 +9220,44 @@ const file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc = "" +
 	"\x16ListFuelEventsResponse\x12M\n" +
 	"\vfuel_events\x18\x01 \x03(\v2,.wayplatform.connect.trusttrack.v1.FuelEventR\n" +
 	"fuelEvents\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x85\x01\n" +
+	"\x13ListGeozonesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\x12)\n" +
+	"\x10include_geometry\x18\x03 \x01(\bR\x0fincludeGeometry\"\x8d\x01\n" +
+	"\x14ListGeozonesResponse\x12F\n" +
+	"\bgeozones\x18\x01 \x03(\v2*.wayplatform.connect.trusttrack.v1.GeozoneR\bgeozones\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x8d\x02\n" +
+	"\x18ListGeozoneVisitsRequest\x12\x1d\n" +
+	"\n" +
+	"object_ids\x18\x01 \x03(\tR\tobjectIds\x12\x1f\n" +
+	"\vgeozone_ids\x18\x02 \x03(\tR\n" +
+	"geozoneIds\x127\n" +
+	"\tfrom_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x06 \x01(\tR\x11continuationToken\"\xa2\x01\n" +
+	"\x19ListGeozoneVisitsResponse\x12V\n" +
+	"\x0egeozone_visits\x18\x01 \x03(\v2/.wayplatform.connect.trusttrack.v1.GeozoneVisitR\rgeozoneVisits\x12-\n" +
 	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"8\n" +
 	"\x15GetObjectGroupRequest\x12\x1f\n" +
 	"\vexternal_id\x18\x01 \x01(\tR\n" +
 	"externalId\"k\n" +
 	"\x16GetObjectGroupResponse\x12Q\n" +
+	"\fobject_group\x18\x01 \x01(\v2..wayplatform.connect.trusttrack.v1.ObjectGroupR\vobjectGroup\"\x8d\x01\n" +
+	"\x18UpdateObjectGroupRequest\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12$\n" +
+	"\x0eadd_object_ids\x18\x02 \x03(\tR\faddObjectIds\x12*\n" +
+	"\x11remove_object_ids\x18\x03 \x03(\tR\x0fremoveObjectIds\"n\n" +
+	"\x19UpdateObjectGroupResponse\x12Q\n" +
 	"\fobject_group\x18\x01 \x01(\v2..wayplatform.connect.trusttrack.v1.ObjectGroupR\vobjectGroup\"^\n" +
 	"\x17ListObjectGroupsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
 	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x9e\x01\n" +
 	"\x18ListObjectGroupsResponse\x12S\n" +
 	"\robject_groups\x18\x01 \x03(\v2..wayplatform.connect.trusttrack.v1.ObjectGroupR\fobjectGroups\x12-\n" +
-	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xd1\x02\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xb7\x03\n" +
 	"\x1cListObjectCoordinatesRequest\x12\x1b\n" +
 	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x127\n" +
 	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
@@ -1955,19 +9265,140 @@ const file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc = "" +
 	"\x12continuation_token\x18\x04 \x01(\tR\x11continuationToken\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12)\n" +
 	"\x10include_geozones\x18\x06 \x01(\bR\x0fincludeGeozones\x126\n" +
-	"\x17include_tire_parameters\x18\a \x01(\bR\x15includeTireParameters\"\x9f\x01\n" +
+	"\x17include_tire_parameters\x18\a \x01(\bR\x15includeTireParameters\x12,\n" +
+	"\vapi_version\x18\b \x01(\x05B\v\xbaH\b\x1a\x060\x000\x020\x03R\n" +
+	"apiVersion\x126\n" +
+	"\x17include_nearest_geozone\x18\t \x01(\bR\x15includeNearestGeozone\"\x9f\x01\n" +
 	"\x1dListObjectCoordinatesResponse\x12O\n" +
 	"\vcoordinates\x18\x01 \x03(\v2-.wayplatform.connect.trusttrack.v1.CoordinateR\vcoordinates\x12-\n" +
-	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x14\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xdc\x01\n" +
+	"\x1aGetObjectCoordinateRequest\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\x126\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04time\x12)\n" +
+	"\x10include_geozones\x18\x03 \x01(\bR\x0fincludeGeozones\x126\n" +
+	"\x17include_tire_parameters\x18\x04 \x01(\bR\x15includeTireParameters\"l\n" +
+	"\x1bGetObjectCoordinateResponse\x12M\n" +
+	"\n" +
+	"coordinate\x18\x01 \x01(\v2-.wayplatform.connect.trusttrack.v1.CoordinateR\n" +
+	"coordinate\"\xa0\x01\n" +
+	"\x1eStreamObjectCoordinatesRequest\x12\x1b\n" +
+	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x12)\n" +
+	"\x10include_geozones\x18\x02 \x01(\bR\x0fincludeGeozones\x126\n" +
+	"\x17include_tire_parameters\x18\x03 \x01(\bR\x15includeTireParameters\"p\n" +
+	"\x1fStreamObjectCoordinatesResponse\x12M\n" +
+	"\n" +
+	"coordinate\x18\x01 \x01(\v2-.wayplatform.connect.trusttrack.v1.CoordinateR\n" +
+	"coordinate\"7\n" +
+	"\x10GetObjectRequest\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\"V\n" +
+	"\x11GetObjectResponse\x12A\n" +
+	"\x06object\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.ObjectR\x06object\"\x14\n" +
 	"\x12ListObjectsRequest\"Z\n" +
 	"\x13ListObjectsResponse\x12C\n" +
-	"\aobjects\x18\x01 \x03(\v2).wayplatform.connect.trusttrack.v1.ObjectR\aobjects\"e\n" +
+	"\aobjects\x18\x01 \x03(\v2).wayplatform.connect.trusttrack.v1.ObjectR\aobjects\"C\n" +
+	"\x1cGetObjectLastPositionRequest\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\"b\n" +
+	"\x1dGetObjectLastPositionResponse\x12A\n" +
+	"\x06object\x18\x01 \x01(\v2).wayplatform.connect.trusttrack.v1.ObjectR\x06object\"e\n" +
 	"\x1eListObjectsLastPositionRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
 	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x95\x01\n" +
 	"\x1fListObjectsLastPositionResponse\x12C\n" +
 	"\aobjects\x18\x01 \x03(\v2).wayplatform.connect.trusttrack.v1.ObjectR\aobjects\x12-\n" +
-	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xe2\x01\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"e\n" +
+	"\x16CreateShareLinkRequest\x12K\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\tshareLink\"f\n" +
+	"\x17CreateShareLinkResponse\x12K\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\tshareLink\"9\n" +
+	"\x13GetShareLinkRequest\x12\"\n" +
+	"\rshare_link_id\x18\x01 \x01(\tR\vshareLinkId\"c\n" +
+	"\x14GetShareLinkResponse\x12K\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\tshareLink\"\\\n" +
+	"\x15ListShareLinksRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x96\x01\n" +
+	"\x16ListShareLinksResponse\x12M\n" +
+	"\vshare_links\x18\x01 \x03(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\n" +
+	"shareLinks\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\xa2\x01\n" +
+	"\x16UpdateShareLinkRequest\x12K\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\tshareLink\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"f\n" +
+	"\x17UpdateShareLinkResponse\x12K\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2,.wayplatform.connect.trusttrack.v1.ShareLinkR\tshareLink\"<\n" +
+	"\x16DeleteShareLinkRequest\x12\"\n" +
+	"\rshare_link_id\x18\x01 \x01(\tR\vshareLinkId\"\x19\n" +
+	"\x17DeleteShareLinkResponse\"\xe7\x03\n" +
+	"#ScheduleVehicleTachoDownloadRequest\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\x12)\n" +
+	"\frequest_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vrequestName\x12?\n" +
+	"\tfrom_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bfromTime\x12;\n" +
+	"\ato_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06toTime\x12x\n" +
+	"\aoptions\x18\x05 \x03(\x0e2M.wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.OptionB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\aoptions\"x\n" +
+	"\x06Option\x12\x16\n" +
+	"\x12OPTION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11FAULTS_AND_EVENTS\x10\x01\x12\x12\n" +
+	"\x0eDETAILED_SPEED\x10\x02\x12\x12\n" +
+	"\x0eTECHNICAL_DATA\x10\x03\x12\x17\n" +
+	"\x13SINCE_LAST_DOWNLOAD\x10\x04\"\x82\x01\n" +
+	"$ScheduleVehicleTachoDownloadResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xa7\x02\n" +
+	"&ScheduleDriverCardTachoDownloadRequest\x12#\n" +
+	"\tobject_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bobjectId\x12)\n" +
+	"\frequest_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vrequestName\x12n\n" +
+	"\x04slot\x18\x03 \x01(\x0e2N.wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest.SlotB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04slot\"=\n" +
+	"\x04Slot\x12\x14\n" +
+	"\x10SLOT_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FIRST_SLOT\x10\x01\x12\x0f\n" +
+	"\vSECOND_SLOT\x10\x02\"\x85\x01\n" +
+	"'ScheduleDriverCardTachoDownloadResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x84\x05\n" +
+	"\x18ListTachoRequestsRequest\x12\x1f\n" +
+	"\vrequest_ids\x18\x01 \x03(\tR\n" +
+	"requestIds\x12\x1d\n" +
+	"\n" +
+	"object_ids\x18\x02 \x03(\tR\tobjectIds\x123\n" +
+	"\x13driver_card_numbers\x18\x03 \x03(\tB\x03\x80\x01\x01R\x11driverCardNumbers\x12J\n" +
+	"\x05types\x18\x04 \x03(\x0e24.wayplatform.connect.trusttrack.v1.TachoRequest.TypeR\x05types\x12R\n" +
+	"\bstatuses\x18\x05 \x03(\x0e26.wayplatform.connect.trusttrack.v1.TachoRequest.StatusR\bstatuses\x12D\n" +
+	"\x10create_from_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecreateFromTime\x12@\n" +
+	"\x0ecreate_to_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreateToTime\x12D\n" +
+	"\x10update_from_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0eupdateFromTime\x12@\n" +
+	"\x0eupdate_to_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fupdateToTime\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\v \x01(\tR\x11continuationToken\"\xa2\x01\n" +
+	"\x19ListTachoRequestsResponse\x12V\n" +
+	"\x0etacho_requests\x18\x01 \x03(\v2/.wayplatform.connect.trusttrack.v1.TachoRequestR\rtachoRequests\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"7\n" +
+	"\x16GetTachoRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"o\n" +
+	"\x17GetTachoRequestResponse\x12T\n" +
+	"\rtacho_request\x18\x01 \x01(\v2/.wayplatform.connect.trusttrack.v1.TachoRequestR\ftachoRequest\":\n" +
+	"\x19DeleteTachoRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\x1c\n" +
+	"\x1aDeleteTachoRequestResponse\"4\n" +
+	"\x13GetTachoFileRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"*\n" +
+	"\x14GetTachoFileResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xe2\x01\n" +
 	"\x10ListTripsRequest\x12\x1b\n" +
 	"\tobject_id\x18\x01 \x01(\tR\bobjectId\x127\n" +
 	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
@@ -1976,80 +9407,339 @@ const file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc = "" +
 	"\x12continuation_token\x18\x05 \x01(\tR\x11continuationToken\"\x81\x01\n" +
 	"\x11ListTripsResponse\x12=\n" +
 	"\x05trips\x18\x01 \x03(\v2'.wayplatform.connect.trusttrack.v1.TripR\x05trips\x12-\n" +
-	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken2\xe1\b\n" +
-	"\rTrustTrackApi\x12|\n" +
-	"\vListDrivers\x125.wayplatform.connect.trusttrack.v1.ListDriversRequest\x1a6.wayplatform.connect.trusttrack.v1.ListDriversResponse\x12\x85\x01\n" +
-	"\x0eListFuelEvents\x128.wayplatform.connect.trusttrack.v1.ListFuelEventsRequest\x1a9.wayplatform.connect.trusttrack.v1.ListFuelEventsResponse\x12\x85\x01\n" +
-	"\x0eGetObjectGroup\x128.wayplatform.connect.trusttrack.v1.GetObjectGroupRequest\x1a9.wayplatform.connect.trusttrack.v1.GetObjectGroupResponse\x12\x8b\x01\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"W\n" +
+	"\x10ListUsersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken\"\x81\x01\n" +
+	"\x11ListUsersResponse\x12=\n" +
+	"\x05users\x18\x01 \x03(\v2'.wayplatform.connect.trusttrack.v1.UserR\x05users\x12-\n" +
+	"\x12continuation_token\x18\x02 \x01(\tR\x11continuationToken2\xc8.\n" +
+	"\rTrustTrackApi\x12\xa0\x01\n" +
+	"\x17ListObjectCountryVisits\x12A.wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsRequest\x1aB.wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsResponse\x12\xa0\x01\n" +
+	"\x17ListDriverCountryVisits\x12A.wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsRequest\x1aB.wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsResponse\x12\x91\x01\n" +
+	"\x12ListDetectedEvents\x12<.wayplatform.connect.trusttrack.v1.ListDetectedEventsRequest\x1a=.wayplatform.connect.trusttrack.v1.ListDetectedEventsResponse\x12|\n" +
+	"\vListDrivers\x125.wayplatform.connect.trusttrack.v1.ListDriversRequest\x1a6.wayplatform.connect.trusttrack.v1.ListDriversResponse\x12\x7f\n" +
+	"\fCreateDriver\x126.wayplatform.connect.trusttrack.v1.CreateDriverRequest\x1a7.wayplatform.connect.trusttrack.v1.CreateDriverResponse\x12\x7f\n" +
+	"\fUpdateDriver\x126.wayplatform.connect.trusttrack.v1.UpdateDriverRequest\x1a7.wayplatform.connect.trusttrack.v1.UpdateDriverResponse\x12\x7f\n" +
+	"\fDeleteDriver\x126.wayplatform.connect.trusttrack.v1.DeleteDriverRequest\x1a7.wayplatform.connect.trusttrack.v1.DeleteDriverResponse\x12\xa3\x01\n" +
+	"\x18GetLastDriverAssignation\x12B.wayplatform.connect.trusttrack.v1.GetLastDriverAssignationRequest\x1aC.wayplatform.connect.trusttrack.v1.GetLastDriverAssignationResponse\x12\xa0\x01\n" +
+	"\x17CreateDriverAssignation\x12A.wayplatform.connect.trusttrack.v1.CreateDriverAssignationRequest\x1aB.wayplatform.connect.trusttrack.v1.CreateDriverAssignationResponse\x12\x9a\x01\n" +
+	"\x15GetDriverTimeAnalysis\x12?.wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisRequest\x1a@.wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisResponse\x12\x91\x01\n" +
+	"\x12GetDriverTimeTable\x12<.wayplatform.connect.trusttrack.v1.GetDriverTimeTableRequest\x1a=.wayplatform.connect.trusttrack.v1.GetDriverTimeTableResponse\x12\x8b\x01\n" +
+	"\x10ListDriverStates\x12:.wayplatform.connect.trusttrack.v1.ListDriverStatesRequest\x1a;.wayplatform.connect.trusttrack.v1.ListDriverStatesResponse\x12\x97\x01\n" +
+	"\x14ListDriverViolations\x12>.wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest\x1a?.wayplatform.connect.trusttrack.v1.ListDriverViolationsResponse\x12\x97\x01\n" +
+	"\x14ListObjectEcodriving\x12>.wayplatform.connect.trusttrack.v1.ListObjectEcodrivingRequest\x1a?.wayplatform.connect.trusttrack.v1.ListObjectEcodrivingResponse\x12\x97\x01\n" +
+	"\x14ListDriverEcodriving\x12>.wayplatform.connect.trusttrack.v1.ListDriverEcodrivingRequest\x1a?.wayplatform.connect.trusttrack.v1.ListDriverEcodrivingResponse\x12\x85\x01\n" +
+	"\x0eListFuelEvents\x128.wayplatform.connect.trusttrack.v1.ListFuelEventsRequest\x1a9.wayplatform.connect.trusttrack.v1.ListFuelEventsResponse\x12\x7f\n" +
+	"\fListGeozones\x126.wayplatform.connect.trusttrack.v1.ListGeozonesRequest\x1a7.wayplatform.connect.trusttrack.v1.ListGeozonesResponse\x12\x8e\x01\n" +
+	"\x11ListGeozoneVisits\x12;.wayplatform.connect.trusttrack.v1.ListGeozoneVisitsRequest\x1a<.wayplatform.connect.trusttrack.v1.ListGeozoneVisitsResponse\x12\x85\x01\n" +
+	"\x0eGetObjectGroup\x128.wayplatform.connect.trusttrack.v1.GetObjectGroupRequest\x1a9.wayplatform.connect.trusttrack.v1.GetObjectGroupResponse\x12\x8e\x01\n" +
+	"\x11UpdateObjectGroup\x12;.wayplatform.connect.trusttrack.v1.UpdateObjectGroupRequest\x1a<.wayplatform.connect.trusttrack.v1.UpdateObjectGroupResponse\x12\x8b\x01\n" +
 	"\x10ListObjectGroups\x12:.wayplatform.connect.trusttrack.v1.ListObjectGroupsRequest\x1a;.wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse\x12\x9a\x01\n" +
-	"\x15ListObjectCoordinates\x12?.wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest\x1a@.wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse\x12|\n" +
-	"\vListObjects\x125.wayplatform.connect.trusttrack.v1.ListObjectsRequest\x1a6.wayplatform.connect.trusttrack.v1.ListObjectsResponse\x12\xa0\x01\n" +
-	"\x17ListObjectsLastPosition\x12A.wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest\x1aB.wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse\x12v\n" +
-	"\tListTrips\x123.wayplatform.connect.trusttrack.v1.ListTripsRequest\x1a4.wayplatform.connect.trusttrack.v1.ListTripsResponseB\xc5\x02\n" +
+	"\x15ListObjectCoordinates\x12?.wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest\x1a@.wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse\x12\x94\x01\n" +
+	"\x13GetObjectCoordinate\x12=.wayplatform.connect.trusttrack.v1.GetObjectCoordinateRequest\x1a>.wayplatform.connect.trusttrack.v1.GetObjectCoordinateResponse\x12\xa2\x01\n" +
+	"\x17StreamObjectCoordinates\x12A.wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesRequest\x1aB.wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesResponse0\x01\x12v\n" +
+	"\tGetObject\x123.wayplatform.connect.trusttrack.v1.GetObjectRequest\x1a4.wayplatform.connect.trusttrack.v1.GetObjectResponse\x12|\n" +
+	"\vListObjects\x125.wayplatform.connect.trusttrack.v1.ListObjectsRequest\x1a6.wayplatform.connect.trusttrack.v1.ListObjectsResponse\x12\x9a\x01\n" +
+	"\x15GetObjectLastPosition\x12?.wayplatform.connect.trusttrack.v1.GetObjectLastPositionRequest\x1a@.wayplatform.connect.trusttrack.v1.GetObjectLastPositionResponse\x12\xa0\x01\n" +
+	"\x17ListObjectsLastPosition\x12A.wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest\x1aB.wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse\x12\x88\x01\n" +
+	"\x0fCreateShareLink\x129.wayplatform.connect.trusttrack.v1.CreateShareLinkRequest\x1a:.wayplatform.connect.trusttrack.v1.CreateShareLinkResponse\x12\x7f\n" +
+	"\fGetShareLink\x126.wayplatform.connect.trusttrack.v1.GetShareLinkRequest\x1a7.wayplatform.connect.trusttrack.v1.GetShareLinkResponse\x12\x85\x01\n" +
+	"\x0eListShareLinks\x128.wayplatform.connect.trusttrack.v1.ListShareLinksRequest\x1a9.wayplatform.connect.trusttrack.v1.ListShareLinksResponse\x12\x88\x01\n" +
+	"\x0fUpdateShareLink\x129.wayplatform.connect.trusttrack.v1.UpdateShareLinkRequest\x1a:.wayplatform.connect.trusttrack.v1.UpdateShareLinkResponse\x12\x88\x01\n" +
+	"\x0fDeleteShareLink\x129.wayplatform.connect.trusttrack.v1.DeleteShareLinkRequest\x1a:.wayplatform.connect.trusttrack.v1.DeleteShareLinkResponse\x12\xaf\x01\n" +
+	"\x1cScheduleVehicleTachoDownload\x12F.wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest\x1aG.wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadResponse\x12\xb8\x01\n" +
+	"\x1fScheduleDriverCardTachoDownload\x12I.wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest\x1aJ.wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadResponse\x12\x8e\x01\n" +
+	"\x11ListTachoRequests\x12;.wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest\x1a<.wayplatform.connect.trusttrack.v1.ListTachoRequestsResponse\x12\x88\x01\n" +
+	"\x0fGetTachoRequest\x129.wayplatform.connect.trusttrack.v1.GetTachoRequestRequest\x1a:.wayplatform.connect.trusttrack.v1.GetTachoRequestResponse\x12\x91\x01\n" +
+	"\x12DeleteTachoRequest\x12<.wayplatform.connect.trusttrack.v1.DeleteTachoRequestRequest\x1a=.wayplatform.connect.trusttrack.v1.DeleteTachoRequestResponse\x12\x7f\n" +
+	"\fGetTachoFile\x126.wayplatform.connect.trusttrack.v1.GetTachoFileRequest\x1a7.wayplatform.connect.trusttrack.v1.GetTachoFileResponse\x12v\n" +
+	"\tListTrips\x123.wayplatform.connect.trusttrack.v1.ListTripsRequest\x1a4.wayplatform.connect.trusttrack.v1.ListTripsResponse\x12v\n" +
+	"\tListUsers\x123.wayplatform.connect.trusttrack.v1.ListUsersRequest\x1a4.wayplatform.connect.trusttrack.v1.ListUsersResponseB\xc5\x02\n" +
 	"%com.wayplatform.connect.trusttrack.v1B\x12TrusttrackApiProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
 
-var file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_goTypes = []any{
-	(*ListDriversRequest)(nil),              // 0: wayplatform.connect.trusttrack.v1.ListDriversRequest
-	(*ListDriversResponse)(nil),             // 1: wayplatform.connect.trusttrack.v1.ListDriversResponse
-	(*ListFuelEventsRequest)(nil),           // 2: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest
-	(*ListFuelEventsResponse)(nil),          // 3: wayplatform.connect.trusttrack.v1.ListFuelEventsResponse
-	(*GetObjectGroupRequest)(nil),           // 4: wayplatform.connect.trusttrack.v1.GetObjectGroupRequest
-	(*GetObjectGroupResponse)(nil),          // 5: wayplatform.connect.trusttrack.v1.GetObjectGroupResponse
-	(*ListObjectGroupsRequest)(nil),         // 6: wayplatform.connect.trusttrack.v1.ListObjectGroupsRequest
-	(*ListObjectGroupsResponse)(nil),        // 7: wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse
-	(*ListObjectCoordinatesRequest)(nil),    // 8: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest
-	(*ListObjectCoordinatesResponse)(nil),   // 9: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse
-	(*ListObjectsRequest)(nil),              // 10: wayplatform.connect.trusttrack.v1.ListObjectsRequest
-	(*ListObjectsResponse)(nil),             // 11: wayplatform.connect.trusttrack.v1.ListObjectsResponse
-	(*ListObjectsLastPositionRequest)(nil),  // 12: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest
-	(*ListObjectsLastPositionResponse)(nil), // 13: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse
-	(*ListTripsRequest)(nil),                // 14: wayplatform.connect.trusttrack.v1.ListTripsRequest
-	(*ListTripsResponse)(nil),               // 15: wayplatform.connect.trusttrack.v1.ListTripsResponse
-	(*Driver)(nil),                          // 16: wayplatform.connect.trusttrack.v1.Driver
-	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
-	(*FuelEvent)(nil),                       // 18: wayplatform.connect.trusttrack.v1.FuelEvent
-	(*ObjectGroup)(nil),                     // 19: wayplatform.connect.trusttrack.v1.ObjectGroup
-	(*Coordinate)(nil),                      // 20: wayplatform.connect.trusttrack.v1.Coordinate
-	(*Object)(nil),                          // 21: wayplatform.connect.trusttrack.v1.Object
-	(*Trip)(nil),                            // 22: wayplatform.connect.trusttrack.v1.Trip
+	(ScheduleVehicleTachoDownloadRequest_Option)(0),  // 0: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.Option
+	(ScheduleDriverCardTachoDownloadRequest_Slot)(0), // 1: wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest.Slot
+	(*ListObjectCountryVisitsRequest)(nil),           // 2: wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsRequest
+	(*ListObjectCountryVisitsResponse)(nil),          // 3: wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsResponse
+	(*ListDriverCountryVisitsRequest)(nil),           // 4: wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsRequest
+	(*ListDriverCountryVisitsResponse)(nil),          // 5: wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsResponse
+	(*ListDetectedEventsRequest)(nil),                // 6: wayplatform.connect.trusttrack.v1.ListDetectedEventsRequest
+	(*ListDetectedEventsResponse)(nil),               // 7: wayplatform.connect.trusttrack.v1.ListDetectedEventsResponse
+	(*ListDriversRequest)(nil),                       // 8: wayplatform.connect.trusttrack.v1.ListDriversRequest
+	(*ListDriversResponse)(nil),                      // 9: wayplatform.connect.trusttrack.v1.ListDriversResponse
+	(*CreateDriverRequest)(nil),                      // 10: wayplatform.connect.trusttrack.v1.CreateDriverRequest
+	(*CreateDriverResponse)(nil),                     // 11: wayplatform.connect.trusttrack.v1.CreateDriverResponse
+	(*UpdateDriverRequest)(nil),                      // 12: wayplatform.connect.trusttrack.v1.UpdateDriverRequest
+	(*UpdateDriverResponse)(nil),                     // 13: wayplatform.connect.trusttrack.v1.UpdateDriverResponse
+	(*DeleteDriverRequest)(nil),                      // 14: wayplatform.connect.trusttrack.v1.DeleteDriverRequest
+	(*DeleteDriverResponse)(nil),                     // 15: wayplatform.connect.trusttrack.v1.DeleteDriverResponse
+	(*GetLastDriverAssignationRequest)(nil),          // 16: wayplatform.connect.trusttrack.v1.GetLastDriverAssignationRequest
+	(*GetLastDriverAssignationResponse)(nil),         // 17: wayplatform.connect.trusttrack.v1.GetLastDriverAssignationResponse
+	(*CreateDriverAssignationRequest)(nil),           // 18: wayplatform.connect.trusttrack.v1.CreateDriverAssignationRequest
+	(*CreateDriverAssignationResponse)(nil),          // 19: wayplatform.connect.trusttrack.v1.CreateDriverAssignationResponse
+	(*GetDriverTimeAnalysisRequest)(nil),             // 20: wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisRequest
+	(*GetDriverTimeAnalysisResponse)(nil),            // 21: wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisResponse
+	(*GetDriverTimeTableRequest)(nil),                // 22: wayplatform.connect.trusttrack.v1.GetDriverTimeTableRequest
+	(*GetDriverTimeTableResponse)(nil),               // 23: wayplatform.connect.trusttrack.v1.GetDriverTimeTableResponse
+	(*ListDriverStatesRequest)(nil),                  // 24: wayplatform.connect.trusttrack.v1.ListDriverStatesRequest
+	(*ListDriverStatesResponse)(nil),                 // 25: wayplatform.connect.trusttrack.v1.ListDriverStatesResponse
+	(*ListDriverViolationsRequest)(nil),              // 26: wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest
+	(*ListDriverViolationsResponse)(nil),             // 27: wayplatform.connect.trusttrack.v1.ListDriverViolationsResponse
+	(*ListObjectEcodrivingRequest)(nil),              // 28: wayplatform.connect.trusttrack.v1.ListObjectEcodrivingRequest
+	(*ListObjectEcodrivingResponse)(nil),             // 29: wayplatform.connect.trusttrack.v1.ListObjectEcodrivingResponse
+	(*ListDriverEcodrivingRequest)(nil),              // 30: wayplatform.connect.trusttrack.v1.ListDriverEcodrivingRequest
+	(*ListDriverEcodrivingResponse)(nil),             // 31: wayplatform.connect.trusttrack.v1.ListDriverEcodrivingResponse
+	(*ListFuelEventsRequest)(nil),                    // 32: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest
+	(*ListFuelEventsResponse)(nil),                   // 33: wayplatform.connect.trusttrack.v1.ListFuelEventsResponse
+	(*ListGeozonesRequest)(nil),                      // 34: wayplatform.connect.trusttrack.v1.ListGeozonesRequest
+	(*ListGeozonesResponse)(nil),                     // 35: wayplatform.connect.trusttrack.v1.ListGeozonesResponse
+	(*ListGeozoneVisitsRequest)(nil),                 // 36: wayplatform.connect.trusttrack.v1.ListGeozoneVisitsRequest
+	(*ListGeozoneVisitsResponse)(nil),                // 37: wayplatform.connect.trusttrack.v1.ListGeozoneVisitsResponse
+	(*GetObjectGroupRequest)(nil),                    // 38: wayplatform.connect.trusttrack.v1.GetObjectGroupRequest
+	(*GetObjectGroupResponse)(nil),                   // 39: wayplatform.connect.trusttrack.v1.GetObjectGroupResponse
+	(*UpdateObjectGroupRequest)(nil),                 // 40: wayplatform.connect.trusttrack.v1.UpdateObjectGroupRequest
+	(*UpdateObjectGroupResponse)(nil),                // 41: wayplatform.connect.trusttrack.v1.UpdateObjectGroupResponse
+	(*ListObjectGroupsRequest)(nil),                  // 42: wayplatform.connect.trusttrack.v1.ListObjectGroupsRequest
+	(*ListObjectGroupsResponse)(nil),                 // 43: wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse
+	(*ListObjectCoordinatesRequest)(nil),             // 44: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest
+	(*ListObjectCoordinatesResponse)(nil),            // 45: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse
+	(*GetObjectCoordinateRequest)(nil),               // 46: wayplatform.connect.trusttrack.v1.GetObjectCoordinateRequest
+	(*GetObjectCoordinateResponse)(nil),              // 47: wayplatform.connect.trusttrack.v1.GetObjectCoordinateResponse
+	(*StreamObjectCoordinatesRequest)(nil),           // 48: wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesRequest
+	(*StreamObjectCoordinatesResponse)(nil),          // 49: wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesResponse
+	(*GetObjectRequest)(nil),                         // 50: wayplatform.connect.trusttrack.v1.GetObjectRequest
+	(*GetObjectResponse)(nil),                        // 51: wayplatform.connect.trusttrack.v1.GetObjectResponse
+	(*ListObjectsRequest)(nil),                       // 52: wayplatform.connect.trusttrack.v1.ListObjectsRequest
+	(*ListObjectsResponse)(nil),                      // 53: wayplatform.connect.trusttrack.v1.ListObjectsResponse
+	(*GetObjectLastPositionRequest)(nil),             // 54: wayplatform.connect.trusttrack.v1.GetObjectLastPositionRequest
+	(*GetObjectLastPositionResponse)(nil),            // 55: wayplatform.connect.trusttrack.v1.GetObjectLastPositionResponse
+	(*ListObjectsLastPositionRequest)(nil),           // 56: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest
+	(*ListObjectsLastPositionResponse)(nil),          // 57: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse
+	(*CreateShareLinkRequest)(nil),                   // 58: wayplatform.connect.trusttrack.v1.CreateShareLinkRequest
+	(*CreateShareLinkResponse)(nil),                  // 59: wayplatform.connect.trusttrack.v1.CreateShareLinkResponse
+	(*GetShareLinkRequest)(nil),                      // 60: wayplatform.connect.trusttrack.v1.GetShareLinkRequest
+	(*GetShareLinkResponse)(nil),                     // 61: wayplatform.connect.trusttrack.v1.GetShareLinkResponse
+	(*ListShareLinksRequest)(nil),                    // 62: wayplatform.connect.trusttrack.v1.ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),                   // 63: wayplatform.connect.trusttrack.v1.ListShareLinksResponse
+	(*UpdateShareLinkRequest)(nil),                   // 64: wayplatform.connect.trusttrack.v1.UpdateShareLinkRequest
+	(*UpdateShareLinkResponse)(nil),                  // 65: wayplatform.connect.trusttrack.v1.UpdateShareLinkResponse
+	(*DeleteShareLinkRequest)(nil),                   // 66: wayplatform.connect.trusttrack.v1.DeleteShareLinkRequest
+	(*DeleteShareLinkResponse)(nil),                  // 67: wayplatform.connect.trusttrack.v1.DeleteShareLinkResponse
+	(*ScheduleVehicleTachoDownloadRequest)(nil),      // 68: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest
+	(*ScheduleVehicleTachoDownloadResponse)(nil),     // 69: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadResponse
+	(*ScheduleDriverCardTachoDownloadRequest)(nil),   // 70: wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest
+	(*ScheduleDriverCardTachoDownloadResponse)(nil),  // 71: wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadResponse
+	(*ListTachoRequestsRequest)(nil),                 // 72: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest
+	(*ListTachoRequestsResponse)(nil),                // 73: wayplatform.connect.trusttrack.v1.ListTachoRequestsResponse
+	(*GetTachoRequestRequest)(nil),                   // 74: wayplatform.connect.trusttrack.v1.GetTachoRequestRequest
+	(*GetTachoRequestResponse)(nil),                  // 75: wayplatform.connect.trusttrack.v1.GetTachoRequestResponse
+	(*DeleteTachoRequestRequest)(nil),                // 76: wayplatform.connect.trusttrack.v1.DeleteTachoRequestRequest
+	(*DeleteTachoRequestResponse)(nil),               // 77: wayplatform.connect.trusttrack.v1.DeleteTachoRequestResponse
+	(*GetTachoFileRequest)(nil),                      // 78: wayplatform.connect.trusttrack.v1.GetTachoFileRequest
+	(*GetTachoFileResponse)(nil),                     // 79: wayplatform.connect.trusttrack.v1.GetTachoFileResponse
+	(*ListTripsRequest)(nil),                         // 80: wayplatform.connect.trusttrack.v1.ListTripsRequest
+	(*ListTripsResponse)(nil),                        // 81: wayplatform.connect.trusttrack.v1.ListTripsResponse
+	(*ListUsersRequest)(nil),                         // 82: wayplatform.connect.trusttrack.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                        // 83: wayplatform.connect.trusttrack.v1.ListUsersResponse
+	(*timestamppb.Timestamp)(nil),                    // 84: google.protobuf.Timestamp
+	(*CountryVisit)(nil),                             // 85: wayplatform.connect.trusttrack.v1.CountryVisit
+	(*DetectedEvent)(nil),                            // 86: wayplatform.connect.trusttrack.v1.DetectedEvent
+	(*Driver)(nil),                                   // 87: wayplatform.connect.trusttrack.v1.Driver
+	(*fieldmaskpb.FieldMask)(nil),                    // 88: google.protobuf.FieldMask
+	(*DriverAssignation)(nil),                        // 89: wayplatform.connect.trusttrack.v1.DriverAssignation
+	(*DriverTimeAnalysis)(nil),                       // 90: wayplatform.connect.trusttrack.v1.DriverTimeAnalysis
+	(*DriverIdentifier)(nil),                         // 91: wayplatform.connect.trusttrack.v1.DriverIdentifier
+	(*DriverTimeTable)(nil),                          // 92: wayplatform.connect.trusttrack.v1.DriverTimeTable
+	(*DriverState)(nil),                              // 93: wayplatform.connect.trusttrack.v1.DriverState
+	(Violation_Severity)(0),                          // 94: wayplatform.connect.trusttrack.v1.Violation.Severity
+	(Violation_Type)(0),                              // 95: wayplatform.connect.trusttrack.v1.Violation.Type
+	(*Violation)(nil),                                // 96: wayplatform.connect.trusttrack.v1.Violation
+	(*Ecodriving)(nil),                               // 97: wayplatform.connect.trusttrack.v1.Ecodriving
+	(*FuelEvent)(nil),                                // 98: wayplatform.connect.trusttrack.v1.FuelEvent
+	(*Geozone)(nil),                                  // 99: wayplatform.connect.trusttrack.v1.Geozone
+	(*GeozoneVisit)(nil),                             // 100: wayplatform.connect.trusttrack.v1.GeozoneVisit
+	(*ObjectGroup)(nil),                              // 101: wayplatform.connect.trusttrack.v1.ObjectGroup
+	(*Coordinate)(nil),                               // 102: wayplatform.connect.trusttrack.v1.Coordinate
+	(*Object)(nil),                                   // 103: wayplatform.connect.trusttrack.v1.Object
+	(*ShareLink)(nil),                                // 104: wayplatform.connect.trusttrack.v1.ShareLink
+	(TachoRequest_Type)(0),                           // 105: wayplatform.connect.trusttrack.v1.TachoRequest.Type
+	(TachoRequest_Status)(0),                         // 106: wayplatform.connect.trusttrack.v1.TachoRequest.Status
+	(*TachoRequest)(nil),                             // 107: wayplatform.connect.trusttrack.v1.TachoRequest
+	(*Trip)(nil),                                     // 108: wayplatform.connect.trusttrack.v1.Trip
+	(*User)(nil),                                     // 109: wayplatform.connect.trusttrack.v1.User
 }
 var file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_depIdxs = []int32{
-	16, // 0: wayplatform.connect.trusttrack.v1.ListDriversResponse.drivers:type_name -> wayplatform.connect.trusttrack.v1.Driver
-	17, // 1: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest.from_time:type_name -> google.protobuf.Timestamp
-	17, // 2: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest.to_time:type_name -> google.protobuf.Timestamp
-	18, // 3: wayplatform.connect.trusttrack.v1.ListFuelEventsResponse.fuel_events:type_name -> wayplatform.connect.trusttrack.v1.FuelEvent
-	19, // 4: wayplatform.connect.trusttrack.v1.GetObjectGroupResponse.object_group:type_name -> wayplatform.connect.trusttrack.v1.ObjectGroup
-	19, // 5: wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse.object_groups:type_name -> wayplatform.connect.trusttrack.v1.ObjectGroup
-	17, // 6: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest.from_time:type_name -> google.protobuf.Timestamp
-	17, // 7: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest.to_time:type_name -> google.protobuf.Timestamp
-	20, // 8: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse.coordinates:type_name -> wayplatform.connect.trusttrack.v1.Coordinate
-	21, // 9: wayplatform.connect.trusttrack.v1.ListObjectsResponse.objects:type_name -> wayplatform.connect.trusttrack.v1.Object
-	21, // 10: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse.objects:type_name -> wayplatform.connect.trusttrack.v1.Object
-	17, // 11: wayplatform.connect.trusttrack.v1.ListTripsRequest.from_time:type_name -> google.protobuf.Timestamp
-	17, // 12: wayplatform.connect.trusttrack.v1.ListTripsRequest.to_time:type_name -> google.protobuf.Timestamp
-	22, // 13: wayplatform.connect.trusttrack.v1.ListTripsResponse.trips:type_name -> wayplatform.connect.trusttrack.v1.Trip
-	0,  // 14: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers:input_type -> wayplatform.connect.trusttrack.v1.ListDriversRequest
-	2,  // 15: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents:input_type -> wayplatform.connect.trusttrack.v1.ListFuelEventsRequest
-	4,  // 16: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup:input_type -> wayplatform.connect.trusttrack.v1.GetObjectGroupRequest
-	6,  // 17: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups:input_type -> wayplatform.connect.trusttrack.v1.ListObjectGroupsRequest
-	8,  // 18: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCoordinates:input_type -> wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest
-	10, // 19: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects:input_type -> wayplatform.connect.trusttrack.v1.ListObjectsRequest
-	12, // 20: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition:input_type -> wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest
-	14, // 21: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips:input_type -> wayplatform.connect.trusttrack.v1.ListTripsRequest
-	1,  // 22: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers:output_type -> wayplatform.connect.trusttrack.v1.ListDriversResponse
-	3,  // 23: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents:output_type -> wayplatform.connect.trusttrack.v1.ListFuelEventsResponse
-	5,  // 24: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup:output_type -> wayplatform.connect.trusttrack.v1.GetObjectGroupResponse
-	7,  // 25: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups:output_type -> wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse
-	9,  // 26: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCoordinates:output_type -> wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse
-	11, // 27: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects:output_type -> wayplatform.connect.trusttrack.v1.ListObjectsResponse
-	13, // 28: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition:output_type -> wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse
-	15, // 29: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips:output_type -> wayplatform.connect.trusttrack.v1.ListTripsResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	84,  // 0: wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 1: wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsRequest.to_time:type_name -> google.protobuf.Timestamp
+	85,  // 2: wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsResponse.country_visits:type_name -> wayplatform.connect.trusttrack.v1.CountryVisit
+	84,  // 3: wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 4: wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsRequest.to_time:type_name -> google.protobuf.Timestamp
+	85,  // 5: wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsResponse.country_visits:type_name -> wayplatform.connect.trusttrack.v1.CountryVisit
+	84,  // 6: wayplatform.connect.trusttrack.v1.ListDetectedEventsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 7: wayplatform.connect.trusttrack.v1.ListDetectedEventsRequest.to_time:type_name -> google.protobuf.Timestamp
+	86,  // 8: wayplatform.connect.trusttrack.v1.ListDetectedEventsResponse.detected_events:type_name -> wayplatform.connect.trusttrack.v1.DetectedEvent
+	87,  // 9: wayplatform.connect.trusttrack.v1.ListDriversResponse.drivers:type_name -> wayplatform.connect.trusttrack.v1.Driver
+	87,  // 10: wayplatform.connect.trusttrack.v1.CreateDriverRequest.driver:type_name -> wayplatform.connect.trusttrack.v1.Driver
+	87,  // 11: wayplatform.connect.trusttrack.v1.CreateDriverResponse.driver:type_name -> wayplatform.connect.trusttrack.v1.Driver
+	87,  // 12: wayplatform.connect.trusttrack.v1.UpdateDriverRequest.driver:type_name -> wayplatform.connect.trusttrack.v1.Driver
+	88,  // 13: wayplatform.connect.trusttrack.v1.UpdateDriverRequest.update_mask:type_name -> google.protobuf.FieldMask
+	87,  // 14: wayplatform.connect.trusttrack.v1.UpdateDriverResponse.driver:type_name -> wayplatform.connect.trusttrack.v1.Driver
+	89,  // 15: wayplatform.connect.trusttrack.v1.GetLastDriverAssignationResponse.driver_assignation:type_name -> wayplatform.connect.trusttrack.v1.DriverAssignation
+	89,  // 16: wayplatform.connect.trusttrack.v1.CreateDriverAssignationRequest.driver_assignation:type_name -> wayplatform.connect.trusttrack.v1.DriverAssignation
+	89,  // 17: wayplatform.connect.trusttrack.v1.CreateDriverAssignationResponse.driver_assignation:type_name -> wayplatform.connect.trusttrack.v1.DriverAssignation
+	90,  // 18: wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisResponse.driver_time_analysis:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeAnalysis
+	91,  // 19: wayplatform.connect.trusttrack.v1.GetDriverTimeTableRequest.driver_identifier:type_name -> wayplatform.connect.trusttrack.v1.DriverIdentifier
+	92,  // 20: wayplatform.connect.trusttrack.v1.GetDriverTimeTableResponse.driver_time_table:type_name -> wayplatform.connect.trusttrack.v1.DriverTimeTable
+	84,  // 21: wayplatform.connect.trusttrack.v1.ListDriverStatesRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 22: wayplatform.connect.trusttrack.v1.ListDriverStatesRequest.to_time:type_name -> google.protobuf.Timestamp
+	93,  // 23: wayplatform.connect.trusttrack.v1.ListDriverStatesResponse.driver_states:type_name -> wayplatform.connect.trusttrack.v1.DriverState
+	84,  // 24: wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 25: wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest.to_time:type_name -> google.protobuf.Timestamp
+	94,  // 26: wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest.severities:type_name -> wayplatform.connect.trusttrack.v1.Violation.Severity
+	95,  // 27: wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest.types:type_name -> wayplatform.connect.trusttrack.v1.Violation.Type
+	96,  // 28: wayplatform.connect.trusttrack.v1.ListDriverViolationsResponse.violations:type_name -> wayplatform.connect.trusttrack.v1.Violation
+	84,  // 29: wayplatform.connect.trusttrack.v1.ListObjectEcodrivingRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 30: wayplatform.connect.trusttrack.v1.ListObjectEcodrivingRequest.to_time:type_name -> google.protobuf.Timestamp
+	97,  // 31: wayplatform.connect.trusttrack.v1.ListObjectEcodrivingResponse.ecodriving:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving
+	84,  // 32: wayplatform.connect.trusttrack.v1.ListDriverEcodrivingRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 33: wayplatform.connect.trusttrack.v1.ListDriverEcodrivingRequest.to_time:type_name -> google.protobuf.Timestamp
+	97,  // 34: wayplatform.connect.trusttrack.v1.ListDriverEcodrivingResponse.ecodriving:type_name -> wayplatform.connect.trusttrack.v1.Ecodriving
+	84,  // 35: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 36: wayplatform.connect.trusttrack.v1.ListFuelEventsRequest.to_time:type_name -> google.protobuf.Timestamp
+	98,  // 37: wayplatform.connect.trusttrack.v1.ListFuelEventsResponse.fuel_events:type_name -> wayplatform.connect.trusttrack.v1.FuelEvent
+	99,  // 38: wayplatform.connect.trusttrack.v1.ListGeozonesResponse.geozones:type_name -> wayplatform.connect.trusttrack.v1.Geozone
+	84,  // 39: wayplatform.connect.trusttrack.v1.ListGeozoneVisitsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 40: wayplatform.connect.trusttrack.v1.ListGeozoneVisitsRequest.to_time:type_name -> google.protobuf.Timestamp
+	100, // 41: wayplatform.connect.trusttrack.v1.ListGeozoneVisitsResponse.geozone_visits:type_name -> wayplatform.connect.trusttrack.v1.GeozoneVisit
+	101, // 42: wayplatform.connect.trusttrack.v1.GetObjectGroupResponse.object_group:type_name -> wayplatform.connect.trusttrack.v1.ObjectGroup
+	101, // 43: wayplatform.connect.trusttrack.v1.UpdateObjectGroupResponse.object_group:type_name -> wayplatform.connect.trusttrack.v1.ObjectGroup
+	101, // 44: wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse.object_groups:type_name -> wayplatform.connect.trusttrack.v1.ObjectGroup
+	84,  // 45: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 46: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest.to_time:type_name -> google.protobuf.Timestamp
+	102, // 47: wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse.coordinates:type_name -> wayplatform.connect.trusttrack.v1.Coordinate
+	84,  // 48: wayplatform.connect.trusttrack.v1.GetObjectCoordinateRequest.time:type_name -> google.protobuf.Timestamp
+	102, // 49: wayplatform.connect.trusttrack.v1.GetObjectCoordinateResponse.coordinate:type_name -> wayplatform.connect.trusttrack.v1.Coordinate
+	102, // 50: wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesResponse.coordinate:type_name -> wayplatform.connect.trusttrack.v1.Coordinate
+	103, // 51: wayplatform.connect.trusttrack.v1.GetObjectResponse.object:type_name -> wayplatform.connect.trusttrack.v1.Object
+	103, // 52: wayplatform.connect.trusttrack.v1.ListObjectsResponse.objects:type_name -> wayplatform.connect.trusttrack.v1.Object
+	103, // 53: wayplatform.connect.trusttrack.v1.GetObjectLastPositionResponse.object:type_name -> wayplatform.connect.trusttrack.v1.Object
+	103, // 54: wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse.objects:type_name -> wayplatform.connect.trusttrack.v1.Object
+	104, // 55: wayplatform.connect.trusttrack.v1.CreateShareLinkRequest.share_link:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	104, // 56: wayplatform.connect.trusttrack.v1.CreateShareLinkResponse.share_link:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	104, // 57: wayplatform.connect.trusttrack.v1.GetShareLinkResponse.share_link:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	104, // 58: wayplatform.connect.trusttrack.v1.ListShareLinksResponse.share_links:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	104, // 59: wayplatform.connect.trusttrack.v1.UpdateShareLinkRequest.share_link:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	88,  // 60: wayplatform.connect.trusttrack.v1.UpdateShareLinkRequest.update_mask:type_name -> google.protobuf.FieldMask
+	104, // 61: wayplatform.connect.trusttrack.v1.UpdateShareLinkResponse.share_link:type_name -> wayplatform.connect.trusttrack.v1.ShareLink
+	84,  // 62: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 63: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.to_time:type_name -> google.protobuf.Timestamp
+	0,   // 64: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.options:type_name -> wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest.Option
+	84,  // 65: wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadResponse.create_time:type_name -> google.protobuf.Timestamp
+	1,   // 66: wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest.slot:type_name -> wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest.Slot
+	84,  // 67: wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadResponse.create_time:type_name -> google.protobuf.Timestamp
+	105, // 68: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.types:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Type
+	106, // 69: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.statuses:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest.Status
+	84,  // 70: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.create_from_time:type_name -> google.protobuf.Timestamp
+	84,  // 71: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.create_to_time:type_name -> google.protobuf.Timestamp
+	84,  // 72: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.update_from_time:type_name -> google.protobuf.Timestamp
+	84,  // 73: wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest.update_to_time:type_name -> google.protobuf.Timestamp
+	107, // 74: wayplatform.connect.trusttrack.v1.ListTachoRequestsResponse.tacho_requests:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest
+	107, // 75: wayplatform.connect.trusttrack.v1.GetTachoRequestResponse.tacho_request:type_name -> wayplatform.connect.trusttrack.v1.TachoRequest
+	84,  // 76: wayplatform.connect.trusttrack.v1.ListTripsRequest.from_time:type_name -> google.protobuf.Timestamp
+	84,  // 77: wayplatform.connect.trusttrack.v1.ListTripsRequest.to_time:type_name -> google.protobuf.Timestamp
+	108, // 78: wayplatform.connect.trusttrack.v1.ListTripsResponse.trips:type_name -> wayplatform.connect.trusttrack.v1.Trip
+	109, // 79: wayplatform.connect.trusttrack.v1.ListUsersResponse.users:type_name -> wayplatform.connect.trusttrack.v1.User
+	2,   // 80: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCountryVisits:input_type -> wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsRequest
+	4,   // 81: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverCountryVisits:input_type -> wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsRequest
+	6,   // 82: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDetectedEvents:input_type -> wayplatform.connect.trusttrack.v1.ListDetectedEventsRequest
+	8,   // 83: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers:input_type -> wayplatform.connect.trusttrack.v1.ListDriversRequest
+	10,  // 84: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriver:input_type -> wayplatform.connect.trusttrack.v1.CreateDriverRequest
+	12,  // 85: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateDriver:input_type -> wayplatform.connect.trusttrack.v1.UpdateDriverRequest
+	14,  // 86: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteDriver:input_type -> wayplatform.connect.trusttrack.v1.DeleteDriverRequest
+	16,  // 87: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetLastDriverAssignation:input_type -> wayplatform.connect.trusttrack.v1.GetLastDriverAssignationRequest
+	18,  // 88: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriverAssignation:input_type -> wayplatform.connect.trusttrack.v1.CreateDriverAssignationRequest
+	20,  // 89: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeAnalysis:input_type -> wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisRequest
+	22,  // 90: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeTable:input_type -> wayplatform.connect.trusttrack.v1.GetDriverTimeTableRequest
+	24,  // 91: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverStates:input_type -> wayplatform.connect.trusttrack.v1.ListDriverStatesRequest
+	26,  // 92: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverViolations:input_type -> wayplatform.connect.trusttrack.v1.ListDriverViolationsRequest
+	28,  // 93: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectEcodriving:input_type -> wayplatform.connect.trusttrack.v1.ListObjectEcodrivingRequest
+	30,  // 94: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverEcodriving:input_type -> wayplatform.connect.trusttrack.v1.ListDriverEcodrivingRequest
+	32,  // 95: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents:input_type -> wayplatform.connect.trusttrack.v1.ListFuelEventsRequest
+	34,  // 96: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozones:input_type -> wayplatform.connect.trusttrack.v1.ListGeozonesRequest
+	36,  // 97: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozoneVisits:input_type -> wayplatform.connect.trusttrack.v1.ListGeozoneVisitsRequest
+	38,  // 98: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup:input_type -> wayplatform.connect.trusttrack.v1.GetObjectGroupRequest
+	40,  // 99: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateObjectGroup:input_type -> wayplatform.connect.trusttrack.v1.UpdateObjectGroupRequest
+	42,  // 100: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups:input_type -> wayplatform.connect.trusttrack.v1.ListObjectGroupsRequest
+	44,  // 101: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCoordinates:input_type -> wayplatform.connect.trusttrack.v1.ListObjectCoordinatesRequest
+	46,  // 102: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectCoordinate:input_type -> wayplatform.connect.trusttrack.v1.GetObjectCoordinateRequest
+	48,  // 103: wayplatform.connect.trusttrack.v1.TrustTrackApi.StreamObjectCoordinates:input_type -> wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesRequest
+	50,  // 104: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObject:input_type -> wayplatform.connect.trusttrack.v1.GetObjectRequest
+	52,  // 105: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects:input_type -> wayplatform.connect.trusttrack.v1.ListObjectsRequest
+	54,  // 106: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectLastPosition:input_type -> wayplatform.connect.trusttrack.v1.GetObjectLastPositionRequest
+	56,  // 107: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition:input_type -> wayplatform.connect.trusttrack.v1.ListObjectsLastPositionRequest
+	58,  // 108: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateShareLink:input_type -> wayplatform.connect.trusttrack.v1.CreateShareLinkRequest
+	60,  // 109: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetShareLink:input_type -> wayplatform.connect.trusttrack.v1.GetShareLinkRequest
+	62,  // 110: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListShareLinks:input_type -> wayplatform.connect.trusttrack.v1.ListShareLinksRequest
+	64,  // 111: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateShareLink:input_type -> wayplatform.connect.trusttrack.v1.UpdateShareLinkRequest
+	66,  // 112: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteShareLink:input_type -> wayplatform.connect.trusttrack.v1.DeleteShareLinkRequest
+	68,  // 113: wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleVehicleTachoDownload:input_type -> wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadRequest
+	70,  // 114: wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleDriverCardTachoDownload:input_type -> wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadRequest
+	72,  // 115: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTachoRequests:input_type -> wayplatform.connect.trusttrack.v1.ListTachoRequestsRequest
+	74,  // 116: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoRequest:input_type -> wayplatform.connect.trusttrack.v1.GetTachoRequestRequest
+	76,  // 117: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteTachoRequest:input_type -> wayplatform.connect.trusttrack.v1.DeleteTachoRequestRequest
+	78,  // 118: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoFile:input_type -> wayplatform.connect.trusttrack.v1.GetTachoFileRequest
+	80,  // 119: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips:input_type -> wayplatform.connect.trusttrack.v1.ListTripsRequest
+	82,  // 120: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListUsers:input_type -> wayplatform.connect.trusttrack.v1.ListUsersRequest
+	3,   // 121: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCountryVisits:output_type -> wayplatform.connect.trusttrack.v1.ListObjectCountryVisitsResponse
+	5,   // 122: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverCountryVisits:output_type -> wayplatform.connect.trusttrack.v1.ListDriverCountryVisitsResponse
+	7,   // 123: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDetectedEvents:output_type -> wayplatform.connect.trusttrack.v1.ListDetectedEventsResponse
+	9,   // 124: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers:output_type -> wayplatform.connect.trusttrack.v1.ListDriversResponse
+	11,  // 125: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriver:output_type -> wayplatform.connect.trusttrack.v1.CreateDriverResponse
+	13,  // 126: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateDriver:output_type -> wayplatform.connect.trusttrack.v1.UpdateDriverResponse
+	15,  // 127: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteDriver:output_type -> wayplatform.connect.trusttrack.v1.DeleteDriverResponse
+	17,  // 128: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetLastDriverAssignation:output_type -> wayplatform.connect.trusttrack.v1.GetLastDriverAssignationResponse
+	19,  // 129: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriverAssignation:output_type -> wayplatform.connect.trusttrack.v1.CreateDriverAssignationResponse
+	21,  // 130: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeAnalysis:output_type -> wayplatform.connect.trusttrack.v1.GetDriverTimeAnalysisResponse
+	23,  // 131: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeTable:output_type -> wayplatform.connect.trusttrack.v1.GetDriverTimeTableResponse
+	25,  // 132: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverStates:output_type -> wayplatform.connect.trusttrack.v1.ListDriverStatesResponse
+	27,  // 133: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverViolations:output_type -> wayplatform.connect.trusttrack.v1.ListDriverViolationsResponse
+	29,  // 134: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectEcodriving:output_type -> wayplatform.connect.trusttrack.v1.ListObjectEcodrivingResponse
+	31,  // 135: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverEcodriving:output_type -> wayplatform.connect.trusttrack.v1.ListDriverEcodrivingResponse
+	33,  // 136: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents:output_type -> wayplatform.connect.trusttrack.v1.ListFuelEventsResponse
+	35,  // 137: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozones:output_type -> wayplatform.connect.trusttrack.v1.ListGeozonesResponse
+	37,  // 138: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozoneVisits:output_type -> wayplatform.connect.trusttrack.v1.ListGeozoneVisitsResponse
+	39,  // 139: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup:output_type -> wayplatform.connect.trusttrack.v1.GetObjectGroupResponse
+	41,  // 140: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateObjectGroup:output_type -> wayplatform.connect.trusttrack.v1.UpdateObjectGroupResponse
+	43,  // 141: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups:output_type -> wayplatform.connect.trusttrack.v1.ListObjectGroupsResponse
+	45,  // 142: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCoordinates:output_type -> wayplatform.connect.trusttrack.v1.ListObjectCoordinatesResponse
+	47,  // 143: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectCoordinate:output_type -> wayplatform.connect.trusttrack.v1.GetObjectCoordinateResponse
+	49,  // 144: wayplatform.connect.trusttrack.v1.TrustTrackApi.StreamObjectCoordinates:output_type -> wayplatform.connect.trusttrack.v1.StreamObjectCoordinatesResponse
+	51,  // 145: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObject:output_type -> wayplatform.connect.trusttrack.v1.GetObjectResponse
+	53,  // 146: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects:output_type -> wayplatform.connect.trusttrack.v1.ListObjectsResponse
+	55,  // 147: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectLastPosition:output_type -> wayplatform.connect.trusttrack.v1.GetObjectLastPositionResponse
+	57,  // 148: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition:output_type -> wayplatform.connect.trusttrack.v1.ListObjectsLastPositionResponse
+	59,  // 149: wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateShareLink:output_type -> wayplatform.connect.trusttrack.v1.CreateShareLinkResponse
+	61,  // 150: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetShareLink:output_type -> wayplatform.connect.trusttrack.v1.GetShareLinkResponse
+	63,  // 151: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListShareLinks:output_type -> wayplatform.connect.trusttrack.v1.ListShareLinksResponse
+	65,  // 152: wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateShareLink:output_type -> wayplatform.connect.trusttrack.v1.UpdateShareLinkResponse
+	67,  // 153: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteShareLink:output_type -> wayplatform.connect.trusttrack.v1.DeleteShareLinkResponse
+	69,  // 154: wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleVehicleTachoDownload:output_type -> wayplatform.connect.trusttrack.v1.ScheduleVehicleTachoDownloadResponse
+	71,  // 155: wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleDriverCardTachoDownload:output_type -> wayplatform.connect.trusttrack.v1.ScheduleDriverCardTachoDownloadResponse
+	73,  // 156: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTachoRequests:output_type -> wayplatform.connect.trusttrack.v1.ListTachoRequestsResponse
+	75,  // 157: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoRequest:output_type -> wayplatform.connect.trusttrack.v1.GetTachoRequestResponse
+	77,  // 158: wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteTachoRequest:output_type -> wayplatform.connect.trusttrack.v1.DeleteTachoRequestResponse
+	79,  // 159: wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoFile:output_type -> wayplatform.connect.trusttrack.v1.GetTachoFileResponse
+	81,  // 160: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips:output_type -> wayplatform.connect.trusttrack.v1.ListTripsResponse
+	83,  // 161: wayplatform.connect.trusttrack.v1.TrustTrackApi.ListUsers:output_type -> wayplatform.connect.trusttrack.v1.ListUsersResponse
+	121, // [121:162] is the sub-list for method output_type
+	80,  // [80:121] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_init() }
@@ -2058,23 +9748,37 @@ func file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_init() {
 		return
 	}
 	file_wayplatform_connect_trusttrack_v1_coordinate_proto_init()
+	file_wayplatform_connect_trusttrack_v1_country_visit_proto_init()
+	file_wayplatform_connect_trusttrack_v1_detected_event_proto_init()
 	file_wayplatform_connect_trusttrack_v1_driver_proto_init()
+	file_wayplatform_connect_trusttrack_v1_driver_assignation_proto_init()
+	file_wayplatform_connect_trusttrack_v1_driver_state_proto_init()
+	file_wayplatform_connect_trusttrack_v1_driver_time_analysis_proto_init()
+	file_wayplatform_connect_trusttrack_v1_driver_time_table_proto_init()
+	file_wayplatform_connect_trusttrack_v1_ecodriving_proto_init()
 	file_wayplatform_connect_trusttrack_v1_fuel_event_proto_init()
+	file_wayplatform_connect_trusttrack_v1_geozone_proto_init()
+	file_wayplatform_connect_trusttrack_v1_geozone_visit_proto_init()
 	file_wayplatform_connect_trusttrack_v1_object_proto_init()
 	file_wayplatform_connect_trusttrack_v1_object_group_proto_init()
+	file_wayplatform_connect_trusttrack_v1_share_link_proto_init()
+	file_wayplatform_connect_trusttrack_v1_tacho_request_proto_init()
 	file_wayplatform_connect_trusttrack_v1_trip_proto_init()
+	file_wayplatform_connect_trusttrack_v1_user_proto_init()
+	file_wayplatform_connect_trusttrack_v1_violation_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      2,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_goTypes,
 		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_enumTypes,
 		MessageInfos:      file_wayplatform_connect_trusttrack_v1_trusttrack_api_proto_msgTypes,
 	}.Build()
 	File_wayplatform_connect_trusttrack_v1_trusttrack_api_proto = out.File
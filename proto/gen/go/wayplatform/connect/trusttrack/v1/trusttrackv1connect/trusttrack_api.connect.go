@@ -33,49 +33,212 @@ const (
 // reflection-formatted method names, remove the leading slash and convert the remaining slash to a
 // period.
 const (
+	// TrustTrackApiListObjectCountryVisitsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListObjectCountryVisits RPC.
+	TrustTrackApiListObjectCountryVisitsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjectCountryVisits"
+	// TrustTrackApiListDriverCountryVisitsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListDriverCountryVisits RPC.
+	TrustTrackApiListDriverCountryVisitsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDriverCountryVisits"
+	// TrustTrackApiListDetectedEventsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListDetectedEvents RPC.
+	TrustTrackApiListDetectedEventsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDetectedEvents"
 	// TrustTrackApiListDriversProcedure is the fully-qualified name of the TrustTrackApi's ListDrivers
 	// RPC.
 	TrustTrackApiListDriversProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDrivers"
+	// TrustTrackApiCreateDriverProcedure is the fully-qualified name of the TrustTrackApi's
+	// CreateDriver RPC.
+	TrustTrackApiCreateDriverProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/CreateDriver"
+	// TrustTrackApiUpdateDriverProcedure is the fully-qualified name of the TrustTrackApi's
+	// UpdateDriver RPC.
+	TrustTrackApiUpdateDriverProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/UpdateDriver"
+	// TrustTrackApiDeleteDriverProcedure is the fully-qualified name of the TrustTrackApi's
+	// DeleteDriver RPC.
+	TrustTrackApiDeleteDriverProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/DeleteDriver"
+	// TrustTrackApiGetLastDriverAssignationProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetLastDriverAssignation RPC.
+	TrustTrackApiGetLastDriverAssignationProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetLastDriverAssignation"
+	// TrustTrackApiCreateDriverAssignationProcedure is the fully-qualified name of the TrustTrackApi's
+	// CreateDriverAssignation RPC.
+	TrustTrackApiCreateDriverAssignationProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/CreateDriverAssignation"
+	// TrustTrackApiGetDriverTimeAnalysisProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetDriverTimeAnalysis RPC.
+	TrustTrackApiGetDriverTimeAnalysisProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetDriverTimeAnalysis"
+	// TrustTrackApiGetDriverTimeTableProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetDriverTimeTable RPC.
+	TrustTrackApiGetDriverTimeTableProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetDriverTimeTable"
+	// TrustTrackApiListDriverStatesProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListDriverStates RPC.
+	TrustTrackApiListDriverStatesProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDriverStates"
+	// TrustTrackApiListDriverViolationsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListDriverViolations RPC.
+	TrustTrackApiListDriverViolationsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDriverViolations"
+	// TrustTrackApiListObjectEcodrivingProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListObjectEcodriving RPC.
+	TrustTrackApiListObjectEcodrivingProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjectEcodriving"
+	// TrustTrackApiListDriverEcodrivingProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListDriverEcodriving RPC.
+	TrustTrackApiListDriverEcodrivingProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListDriverEcodriving"
 	// TrustTrackApiListFuelEventsProcedure is the fully-qualified name of the TrustTrackApi's
 	// ListFuelEvents RPC.
 	TrustTrackApiListFuelEventsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListFuelEvents"
+	// TrustTrackApiListGeozonesProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListGeozones RPC.
+	TrustTrackApiListGeozonesProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListGeozones"
+	// TrustTrackApiListGeozoneVisitsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListGeozoneVisits RPC.
+	TrustTrackApiListGeozoneVisitsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListGeozoneVisits"
 	// TrustTrackApiGetObjectGroupProcedure is the fully-qualified name of the TrustTrackApi's
 	// GetObjectGroup RPC.
 	TrustTrackApiGetObjectGroupProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetObjectGroup"
+	// TrustTrackApiUpdateObjectGroupProcedure is the fully-qualified name of the TrustTrackApi's
+	// UpdateObjectGroup RPC.
+	TrustTrackApiUpdateObjectGroupProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/UpdateObjectGroup"
 	// TrustTrackApiListObjectGroupsProcedure is the fully-qualified name of the TrustTrackApi's
 	// ListObjectGroups RPC.
 	TrustTrackApiListObjectGroupsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjectGroups"
 	// TrustTrackApiListObjectCoordinatesProcedure is the fully-qualified name of the TrustTrackApi's
 	// ListObjectCoordinates RPC.
 	TrustTrackApiListObjectCoordinatesProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjectCoordinates"
+	// TrustTrackApiGetObjectCoordinateProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetObjectCoordinate RPC.
+	TrustTrackApiGetObjectCoordinateProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetObjectCoordinate"
+	// TrustTrackApiStreamObjectCoordinatesProcedure is the fully-qualified name of the TrustTrackApi's
+	// StreamObjectCoordinates RPC.
+	TrustTrackApiStreamObjectCoordinatesProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/StreamObjectCoordinates"
+	// TrustTrackApiGetObjectProcedure is the fully-qualified name of the TrustTrackApi's GetObject RPC.
+	TrustTrackApiGetObjectProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetObject"
 	// TrustTrackApiListObjectsProcedure is the fully-qualified name of the TrustTrackApi's ListObjects
 	// RPC.
 	TrustTrackApiListObjectsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjects"
+	// TrustTrackApiGetObjectLastPositionProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetObjectLastPosition RPC.
+	TrustTrackApiGetObjectLastPositionProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetObjectLastPosition"
 	// TrustTrackApiListObjectsLastPositionProcedure is the fully-qualified name of the TrustTrackApi's
 	// ListObjectsLastPosition RPC.
 	TrustTrackApiListObjectsLastPositionProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListObjectsLastPosition"
+	// TrustTrackApiCreateShareLinkProcedure is the fully-qualified name of the TrustTrackApi's
+	// CreateShareLink RPC.
+	TrustTrackApiCreateShareLinkProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/CreateShareLink"
+	// TrustTrackApiGetShareLinkProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetShareLink RPC.
+	TrustTrackApiGetShareLinkProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetShareLink"
+	// TrustTrackApiListShareLinksProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListShareLinks RPC.
+	TrustTrackApiListShareLinksProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListShareLinks"
+	// TrustTrackApiUpdateShareLinkProcedure is the fully-qualified name of the TrustTrackApi's
+	// UpdateShareLink RPC.
+	TrustTrackApiUpdateShareLinkProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/UpdateShareLink"
+	// TrustTrackApiDeleteShareLinkProcedure is the fully-qualified name of the TrustTrackApi's
+	// DeleteShareLink RPC.
+	TrustTrackApiDeleteShareLinkProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/DeleteShareLink"
+	// TrustTrackApiScheduleVehicleTachoDownloadProcedure is the fully-qualified name of the
+	// TrustTrackApi's ScheduleVehicleTachoDownload RPC.
+	TrustTrackApiScheduleVehicleTachoDownloadProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ScheduleVehicleTachoDownload"
+	// TrustTrackApiScheduleDriverCardTachoDownloadProcedure is the fully-qualified name of the
+	// TrustTrackApi's ScheduleDriverCardTachoDownload RPC.
+	TrustTrackApiScheduleDriverCardTachoDownloadProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ScheduleDriverCardTachoDownload"
+	// TrustTrackApiListTachoRequestsProcedure is the fully-qualified name of the TrustTrackApi's
+	// ListTachoRequests RPC.
+	TrustTrackApiListTachoRequestsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListTachoRequests"
+	// TrustTrackApiGetTachoRequestProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetTachoRequest RPC.
+	TrustTrackApiGetTachoRequestProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetTachoRequest"
+	// TrustTrackApiDeleteTachoRequestProcedure is the fully-qualified name of the TrustTrackApi's
+	// DeleteTachoRequest RPC.
+	TrustTrackApiDeleteTachoRequestProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/DeleteTachoRequest"
+	// TrustTrackApiGetTachoFileProcedure is the fully-qualified name of the TrustTrackApi's
+	// GetTachoFile RPC.
+	TrustTrackApiGetTachoFileProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/GetTachoFile"
 	// TrustTrackApiListTripsProcedure is the fully-qualified name of the TrustTrackApi's ListTrips RPC.
 	TrustTrackApiListTripsProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListTrips"
+	// TrustTrackApiListUsersProcedure is the fully-qualified name of the TrustTrackApi's ListUsers RPC.
+	TrustTrackApiListUsersProcedure = "/wayplatform.connect.trusttrack.v1.TrustTrackApi/ListUsers"
 )
 
 // TrustTrackApiClient is a client for the wayplatform.connect.trusttrack.v1.TrustTrackApi service.
 type TrustTrackApiClient interface {
+	// ListObjectCountryVisits lists the country visits of an object for a specified time period.
+	ListObjectCountryVisits(context.Context, *v1.ListObjectCountryVisitsRequest) (*v1.ListObjectCountryVisitsResponse, error)
+	// ListDriverCountryVisits lists the country visits of a driver for a specified time period.
+	ListDriverCountryVisits(context.Context, *v1.ListDriverCountryVisitsRequest) (*v1.ListDriverCountryVisitsResponse, error)
+	// ListDetectedEvents lists events detected for an object.
+	ListDetectedEvents(context.Context, *v1.ListDetectedEventsRequest) (*v1.ListDetectedEventsResponse, error)
 	// ListDrivers lists all drivers.
 	ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error)
+	// CreateDriver creates a driver.
+	CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error)
+	// UpdateDriver updates a driver.
+	UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error)
+	// DeleteDriver deletes a driver.
+	DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error)
+	// GetLastDriverAssignation gets the last assignation event of a driver or an object.
+	GetLastDriverAssignation(context.Context, *v1.GetLastDriverAssignationRequest) (*v1.GetLastDriverAssignationResponse, error)
+	// CreateDriverAssignation creates a manual driver assignation event.
+	CreateDriverAssignation(context.Context, *v1.CreateDriverAssignationRequest) (*v1.CreateDriverAssignationResponse, error)
+	// GetDriverTimeAnalysis gets an analysis of a driver's current driving and rest times.
+	GetDriverTimeAnalysis(context.Context, *v1.GetDriverTimeAnalysisRequest) (*v1.GetDriverTimeAnalysisResponse, error)
+	// GetDriverTimeTable gets the monthly working time table of a driver.
+	GetDriverTimeTable(context.Context, *v1.GetDriverTimeTableRequest) (*v1.GetDriverTimeTableResponse, error)
+	// ListDriverStates lists the tachograph activity periods of a driver for a specified time period.
+	ListDriverStates(context.Context, *v1.ListDriverStatesRequest) (*v1.ListDriverStatesResponse, error)
+	// ListDriverViolations lists driving and rest time violations of drivers.
+	ListDriverViolations(context.Context, *v1.ListDriverViolationsRequest) (*v1.ListDriverViolationsResponse, error)
+	// ListObjectEcodriving lists the ecodriving parameters of an object for a specified time period.
+	ListObjectEcodriving(context.Context, *v1.ListObjectEcodrivingRequest) (*v1.ListObjectEcodrivingResponse, error)
+	// ListDriverEcodriving lists the ecodriving parameters of a driver for a specified time period.
+	ListDriverEcodriving(context.Context, *v1.ListDriverEcodrivingRequest) (*v1.ListDriverEcodrivingResponse, error)
 	// ListFuelEvents lists fuel events for an object.
 	ListFuelEvents(context.Context, *v1.ListFuelEventsRequest) (*v1.ListFuelEventsResponse, error)
+	// ListGeozones lists all geozones.
+	ListGeozones(context.Context, *v1.ListGeozonesRequest) (*v1.ListGeozonesResponse, error)
+	// ListGeozoneVisits lists geozone enter and exit events for objects.
+	ListGeozoneVisits(context.Context, *v1.ListGeozoneVisitsRequest) (*v1.ListGeozoneVisitsResponse, error)
 	// GetObjectGroup gets a specific object group by external ID.
 	GetObjectGroup(context.Context, *v1.GetObjectGroupRequest) (*v1.GetObjectGroupResponse, error)
+	// UpdateObjectGroup adds objects to and removes objects from an object group.
+	UpdateObjectGroup(context.Context, *v1.UpdateObjectGroupRequest) (*v1.UpdateObjectGroupResponse, error)
 	// ListObjectGroups lists all object groups.
 	ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error)
 	// ListObjectCoordinates lists object coordinates for a specified time period.
 	ListObjectCoordinates(context.Context, *v1.ListObjectCoordinatesRequest) (*v1.ListObjectCoordinatesResponse, error)
+	// GetObjectCoordinate gets the object coordinate recorded at a specific time.
+	GetObjectCoordinate(context.Context, *v1.GetObjectCoordinateRequest) (*v1.GetObjectCoordinateResponse, error)
+	// StreamObjectCoordinates streams real-time coordinates of all objects or a single object.
+	StreamObjectCoordinates(context.Context, *v1.StreamObjectCoordinatesRequest) (*connect.ServerStreamForClient[v1.StreamObjectCoordinatesResponse], error)
+	// GetObject gets an object.
+	GetObject(context.Context, *v1.GetObjectRequest) (*v1.GetObjectResponse, error)
 	// ListObjects lists all objects.
 	ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error)
+	// GetObjectLastPosition gets an object with its last position.
+	GetObjectLastPosition(context.Context, *v1.GetObjectLastPositionRequest) (*v1.GetObjectLastPositionResponse, error)
 	// ListObjectsLastPosition lists all objects with their last position.
 	ListObjectsLastPosition(context.Context, *v1.ListObjectsLastPositionRequest) (*v1.ListObjectsLastPositionResponse, error)
+	// CreateShareLink creates a share link.
+	CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error)
+	// GetShareLink gets a share link.
+	GetShareLink(context.Context, *v1.GetShareLinkRequest) (*v1.GetShareLinkResponse, error)
+	// ListShareLinks lists share links.
+	ListShareLinks(context.Context, *v1.ListShareLinksRequest) (*v1.ListShareLinksResponse, error)
+	// UpdateShareLink updates the validity period of a share link.
+	UpdateShareLink(context.Context, *v1.UpdateShareLinkRequest) (*v1.UpdateShareLinkResponse, error)
+	// DeleteShareLink deletes a share link.
+	DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error)
+	// ScheduleVehicleTachoDownload schedules a remote download of vehicle unit data.
+	ScheduleVehicleTachoDownload(context.Context, *v1.ScheduleVehicleTachoDownloadRequest) (*v1.ScheduleVehicleTachoDownloadResponse, error)
+	// ScheduleDriverCardTachoDownload schedules a remote download of a driver card.
+	ScheduleDriverCardTachoDownload(context.Context, *v1.ScheduleDriverCardTachoDownloadRequest) (*v1.ScheduleDriverCardTachoDownloadResponse, error)
+	// ListTachoRequests lists remote tachograph download requests.
+	ListTachoRequests(context.Context, *v1.ListTachoRequestsRequest) (*v1.ListTachoRequestsResponse, error)
+	// GetTachoRequest gets a remote tachograph download request.
+	GetTachoRequest(context.Context, *v1.GetTachoRequestRequest) (*v1.GetTachoRequestResponse, error)
+	// DeleteTachoRequest deletes a remote tachograph download request.
+	DeleteTachoRequest(context.Context, *v1.DeleteTachoRequestRequest) (*v1.DeleteTachoRequestResponse, error)
+	// GetTachoFile gets the file downloaded by a completed tachograph download request.
+	GetTachoFile(context.Context, *v1.GetTachoFileRequest) (*v1.GetTachoFileResponse, error)
 	// ListTrips lists trips for an object.
 	ListTrips(context.Context, *v1.ListTripsRequest) (*v1.ListTripsResponse, error)
+	// ListUsers lists the users with access to the account.
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 }
 
 // NewTrustTrackApiClient constructs a client for the
@@ -90,24 +253,126 @@ func NewTrustTrackApiClient(httpClient connect.HTTPClient, baseURL string, opts
 	baseURL = strings.TrimRight(baseURL, "/")
 	trustTrackApiMethods := v1.File_wayplatform_connect_trusttrack_v1_trusttrack_api_proto.Services().ByName("TrustTrackApi").Methods()
 	return &trustTrackApiClient{
+		listObjectCountryVisits: connect.NewClient[v1.ListObjectCountryVisitsRequest, v1.ListObjectCountryVisitsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListObjectCountryVisitsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListObjectCountryVisits")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverCountryVisits: connect.NewClient[v1.ListDriverCountryVisitsRequest, v1.ListDriverCountryVisitsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListDriverCountryVisitsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListDriverCountryVisits")),
+			connect.WithClientOptions(opts...),
+		),
+		listDetectedEvents: connect.NewClient[v1.ListDetectedEventsRequest, v1.ListDetectedEventsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListDetectedEventsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListDetectedEvents")),
+			connect.WithClientOptions(opts...),
+		),
 		listDrivers: connect.NewClient[v1.ListDriversRequest, v1.ListDriversResponse](
 			httpClient,
 			baseURL+TrustTrackApiListDriversProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("ListDrivers")),
 			connect.WithClientOptions(opts...),
 		),
+		createDriver: connect.NewClient[v1.CreateDriverRequest, v1.CreateDriverResponse](
+			httpClient,
+			baseURL+TrustTrackApiCreateDriverProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("CreateDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDriver: connect.NewClient[v1.UpdateDriverRequest, v1.UpdateDriverResponse](
+			httpClient,
+			baseURL+TrustTrackApiUpdateDriverProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("UpdateDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDriver: connect.NewClient[v1.DeleteDriverRequest, v1.DeleteDriverResponse](
+			httpClient,
+			baseURL+TrustTrackApiDeleteDriverProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("DeleteDriver")),
+			connect.WithClientOptions(opts...),
+		),
+		getLastDriverAssignation: connect.NewClient[v1.GetLastDriverAssignationRequest, v1.GetLastDriverAssignationResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetLastDriverAssignationProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetLastDriverAssignation")),
+			connect.WithClientOptions(opts...),
+		),
+		createDriverAssignation: connect.NewClient[v1.CreateDriverAssignationRequest, v1.CreateDriverAssignationResponse](
+			httpClient,
+			baseURL+TrustTrackApiCreateDriverAssignationProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("CreateDriverAssignation")),
+			connect.WithClientOptions(opts...),
+		),
+		getDriverTimeAnalysis: connect.NewClient[v1.GetDriverTimeAnalysisRequest, v1.GetDriverTimeAnalysisResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetDriverTimeAnalysisProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetDriverTimeAnalysis")),
+			connect.WithClientOptions(opts...),
+		),
+		getDriverTimeTable: connect.NewClient[v1.GetDriverTimeTableRequest, v1.GetDriverTimeTableResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetDriverTimeTableProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetDriverTimeTable")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverStates: connect.NewClient[v1.ListDriverStatesRequest, v1.ListDriverStatesResponse](
+			httpClient,
+			baseURL+TrustTrackApiListDriverStatesProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListDriverStates")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverViolations: connect.NewClient[v1.ListDriverViolationsRequest, v1.ListDriverViolationsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListDriverViolationsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListDriverViolations")),
+			connect.WithClientOptions(opts...),
+		),
+		listObjectEcodriving: connect.NewClient[v1.ListObjectEcodrivingRequest, v1.ListObjectEcodrivingResponse](
+			httpClient,
+			baseURL+TrustTrackApiListObjectEcodrivingProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListObjectEcodriving")),
+			connect.WithClientOptions(opts...),
+		),
+		listDriverEcodriving: connect.NewClient[v1.ListDriverEcodrivingRequest, v1.ListDriverEcodrivingResponse](
+			httpClient,
+			baseURL+TrustTrackApiListDriverEcodrivingProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListDriverEcodriving")),
+			connect.WithClientOptions(opts...),
+		),
 		listFuelEvents: connect.NewClient[v1.ListFuelEventsRequest, v1.ListFuelEventsResponse](
 			httpClient,
 			baseURL+TrustTrackApiListFuelEventsProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("ListFuelEvents")),
 			connect.WithClientOptions(opts...),
 		),
+		listGeozones: connect.NewClient[v1.ListGeozonesRequest, v1.ListGeozonesResponse](
+			httpClient,
+			baseURL+TrustTrackApiListGeozonesProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListGeozones")),
+			connect.WithClientOptions(opts...),
+		),
+		listGeozoneVisits: connect.NewClient[v1.ListGeozoneVisitsRequest, v1.ListGeozoneVisitsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListGeozoneVisitsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListGeozoneVisits")),
+			connect.WithClientOptions(opts...),
+		),
 		getObjectGroup: connect.NewClient[v1.GetObjectGroupRequest, v1.GetObjectGroupResponse](
 			httpClient,
 			baseURL+TrustTrackApiGetObjectGroupProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("GetObjectGroup")),
 			connect.WithClientOptions(opts...),
 		),
+		updateObjectGroup: connect.NewClient[v1.UpdateObjectGroupRequest, v1.UpdateObjectGroupResponse](
+			httpClient,
+			baseURL+TrustTrackApiUpdateObjectGroupProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("UpdateObjectGroup")),
+			connect.WithClientOptions(opts...),
+		),
 		listObjectGroups: connect.NewClient[v1.ListObjectGroupsRequest, v1.ListObjectGroupsResponse](
 			httpClient,
 			baseURL+TrustTrackApiListObjectGroupsProcedure,
@@ -120,37 +385,195 @@ func NewTrustTrackApiClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(trustTrackApiMethods.ByName("ListObjectCoordinates")),
 			connect.WithClientOptions(opts...),
 		),
+		getObjectCoordinate: connect.NewClient[v1.GetObjectCoordinateRequest, v1.GetObjectCoordinateResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetObjectCoordinateProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetObjectCoordinate")),
+			connect.WithClientOptions(opts...),
+		),
+		streamObjectCoordinates: connect.NewClient[v1.StreamObjectCoordinatesRequest, v1.StreamObjectCoordinatesResponse](
+			httpClient,
+			baseURL+TrustTrackApiStreamObjectCoordinatesProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("StreamObjectCoordinates")),
+			connect.WithClientOptions(opts...),
+		),
+		getObject: connect.NewClient[v1.GetObjectRequest, v1.GetObjectResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetObjectProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetObject")),
+			connect.WithClientOptions(opts...),
+		),
 		listObjects: connect.NewClient[v1.ListObjectsRequest, v1.ListObjectsResponse](
 			httpClient,
 			baseURL+TrustTrackApiListObjectsProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("ListObjects")),
 			connect.WithClientOptions(opts...),
 		),
+		getObjectLastPosition: connect.NewClient[v1.GetObjectLastPositionRequest, v1.GetObjectLastPositionResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetObjectLastPositionProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetObjectLastPosition")),
+			connect.WithClientOptions(opts...),
+		),
 		listObjectsLastPosition: connect.NewClient[v1.ListObjectsLastPositionRequest, v1.ListObjectsLastPositionResponse](
 			httpClient,
 			baseURL+TrustTrackApiListObjectsLastPositionProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("ListObjectsLastPosition")),
 			connect.WithClientOptions(opts...),
 		),
+		createShareLink: connect.NewClient[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse](
+			httpClient,
+			baseURL+TrustTrackApiCreateShareLinkProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("CreateShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getShareLink: connect.NewClient[v1.GetShareLinkRequest, v1.GetShareLinkResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetShareLinkProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listShareLinks: connect.NewClient[v1.ListShareLinksRequest, v1.ListShareLinksResponse](
+			httpClient,
+			baseURL+TrustTrackApiListShareLinksProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListShareLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		updateShareLink: connect.NewClient[v1.UpdateShareLinkRequest, v1.UpdateShareLinkResponse](
+			httpClient,
+			baseURL+TrustTrackApiUpdateShareLinkProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("UpdateShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteShareLink: connect.NewClient[v1.DeleteShareLinkRequest, v1.DeleteShareLinkResponse](
+			httpClient,
+			baseURL+TrustTrackApiDeleteShareLinkProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("DeleteShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleVehicleTachoDownload: connect.NewClient[v1.ScheduleVehicleTachoDownloadRequest, v1.ScheduleVehicleTachoDownloadResponse](
+			httpClient,
+			baseURL+TrustTrackApiScheduleVehicleTachoDownloadProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ScheduleVehicleTachoDownload")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleDriverCardTachoDownload: connect.NewClient[v1.ScheduleDriverCardTachoDownloadRequest, v1.ScheduleDriverCardTachoDownloadResponse](
+			httpClient,
+			baseURL+TrustTrackApiScheduleDriverCardTachoDownloadProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ScheduleDriverCardTachoDownload")),
+			connect.WithClientOptions(opts...),
+		),
+		listTachoRequests: connect.NewClient[v1.ListTachoRequestsRequest, v1.ListTachoRequestsResponse](
+			httpClient,
+			baseURL+TrustTrackApiListTachoRequestsProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListTachoRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		getTachoRequest: connect.NewClient[v1.GetTachoRequestRequest, v1.GetTachoRequestResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetTachoRequestProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetTachoRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTachoRequest: connect.NewClient[v1.DeleteTachoRequestRequest, v1.DeleteTachoRequestResponse](
+			httpClient,
+			baseURL+TrustTrackApiDeleteTachoRequestProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("DeleteTachoRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		getTachoFile: connect.NewClient[v1.GetTachoFileRequest, v1.GetTachoFileResponse](
+			httpClient,
+			baseURL+TrustTrackApiGetTachoFileProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("GetTachoFile")),
+			connect.WithClientOptions(opts...),
+		),
 		listTrips: connect.NewClient[v1.ListTripsRequest, v1.ListTripsResponse](
 			httpClient,
 			baseURL+TrustTrackApiListTripsProcedure,
 			connect.WithSchema(trustTrackApiMethods.ByName("ListTrips")),
 			connect.WithClientOptions(opts...),
 		),
+		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+TrustTrackApiListUsersProcedure,
+			connect.WithSchema(trustTrackApiMethods.ByName("ListUsers")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // trustTrackApiClient implements TrustTrackApiClient.
 type trustTrackApiClient struct {
-	listDrivers             *connect.Client[v1.ListDriversRequest, v1.ListDriversResponse]
-	listFuelEvents          *connect.Client[v1.ListFuelEventsRequest, v1.ListFuelEventsResponse]
-	getObjectGroup          *connect.Client[v1.GetObjectGroupRequest, v1.GetObjectGroupResponse]
-	listObjectGroups        *connect.Client[v1.ListObjectGroupsRequest, v1.ListObjectGroupsResponse]
-	listObjectCoordinates   *connect.Client[v1.ListObjectCoordinatesRequest, v1.ListObjectCoordinatesResponse]
-	listObjects             *connect.Client[v1.ListObjectsRequest, v1.ListObjectsResponse]
-	listObjectsLastPosition *connect.Client[v1.ListObjectsLastPositionRequest, v1.ListObjectsLastPositionResponse]
-	listTrips               *connect.Client[v1.ListTripsRequest, v1.ListTripsResponse]
+	listObjectCountryVisits         *connect.Client[v1.ListObjectCountryVisitsRequest, v1.ListObjectCountryVisitsResponse]
+	listDriverCountryVisits         *connect.Client[v1.ListDriverCountryVisitsRequest, v1.ListDriverCountryVisitsResponse]
+	listDetectedEvents              *connect.Client[v1.ListDetectedEventsRequest, v1.ListDetectedEventsResponse]
+	listDrivers                     *connect.Client[v1.ListDriversRequest, v1.ListDriversResponse]
+	createDriver                    *connect.Client[v1.CreateDriverRequest, v1.CreateDriverResponse]
+	updateDriver                    *connect.Client[v1.UpdateDriverRequest, v1.UpdateDriverResponse]
+	deleteDriver                    *connect.Client[v1.DeleteDriverRequest, v1.DeleteDriverResponse]
+	getLastDriverAssignation        *connect.Client[v1.GetLastDriverAssignationRequest, v1.GetLastDriverAssignationResponse]
+	createDriverAssignation         *connect.Client[v1.CreateDriverAssignationRequest, v1.CreateDriverAssignationResponse]
+	getDriverTimeAnalysis           *connect.Client[v1.GetDriverTimeAnalysisRequest, v1.GetDriverTimeAnalysisResponse]
+	getDriverTimeTable              *connect.Client[v1.GetDriverTimeTableRequest, v1.GetDriverTimeTableResponse]
+	listDriverStates                *connect.Client[v1.ListDriverStatesRequest, v1.ListDriverStatesResponse]
+	listDriverViolations            *connect.Client[v1.ListDriverViolationsRequest, v1.ListDriverViolationsResponse]
+	listObjectEcodriving            *connect.Client[v1.ListObjectEcodrivingRequest, v1.ListObjectEcodrivingResponse]
+	listDriverEcodriving            *connect.Client[v1.ListDriverEcodrivingRequest, v1.ListDriverEcodrivingResponse]
+	listFuelEvents                  *connect.Client[v1.ListFuelEventsRequest, v1.ListFuelEventsResponse]
+	listGeozones                    *connect.Client[v1.ListGeozonesRequest, v1.ListGeozonesResponse]
+	listGeozoneVisits               *connect.Client[v1.ListGeozoneVisitsRequest, v1.ListGeozoneVisitsResponse]
+	getObjectGroup                  *connect.Client[v1.GetObjectGroupRequest, v1.GetObjectGroupResponse]
+	updateObjectGroup               *connect.Client[v1.UpdateObjectGroupRequest, v1.UpdateObjectGroupResponse]
+	listObjectGroups                *connect.Client[v1.ListObjectGroupsRequest, v1.ListObjectGroupsResponse]
+	listObjectCoordinates           *connect.Client[v1.ListObjectCoordinatesRequest, v1.ListObjectCoordinatesResponse]
+	getObjectCoordinate             *connect.Client[v1.GetObjectCoordinateRequest, v1.GetObjectCoordinateResponse]
+	streamObjectCoordinates         *connect.Client[v1.StreamObjectCoordinatesRequest, v1.StreamObjectCoordinatesResponse]
+	getObject                       *connect.Client[v1.GetObjectRequest, v1.GetObjectResponse]
+	listObjects                     *connect.Client[v1.ListObjectsRequest, v1.ListObjectsResponse]
+	getObjectLastPosition           *connect.Client[v1.GetObjectLastPositionRequest, v1.GetObjectLastPositionResponse]
+	listObjectsLastPosition         *connect.Client[v1.ListObjectsLastPositionRequest, v1.ListObjectsLastPositionResponse]
+	createShareLink                 *connect.Client[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse]
+	getShareLink                    *connect.Client[v1.GetShareLinkRequest, v1.GetShareLinkResponse]
+	listShareLinks                  *connect.Client[v1.ListShareLinksRequest, v1.ListShareLinksResponse]
+	updateShareLink                 *connect.Client[v1.UpdateShareLinkRequest, v1.UpdateShareLinkResponse]
+	deleteShareLink                 *connect.Client[v1.DeleteShareLinkRequest, v1.DeleteShareLinkResponse]
+	scheduleVehicleTachoDownload    *connect.Client[v1.ScheduleVehicleTachoDownloadRequest, v1.ScheduleVehicleTachoDownloadResponse]
+	scheduleDriverCardTachoDownload *connect.Client[v1.ScheduleDriverCardTachoDownloadRequest, v1.ScheduleDriverCardTachoDownloadResponse]
+	listTachoRequests               *connect.Client[v1.ListTachoRequestsRequest, v1.ListTachoRequestsResponse]
+	getTachoRequest                 *connect.Client[v1.GetTachoRequestRequest, v1.GetTachoRequestResponse]
+	deleteTachoRequest              *connect.Client[v1.DeleteTachoRequestRequest, v1.DeleteTachoRequestResponse]
+	getTachoFile                    *connect.Client[v1.GetTachoFileRequest, v1.GetTachoFileResponse]
+	listTrips                       *connect.Client[v1.ListTripsRequest, v1.ListTripsResponse]
+	listUsers                       *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+}
+
+// ListObjectCountryVisits calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCountryVisits.
+func (c *trustTrackApiClient) ListObjectCountryVisits(ctx context.Context, req *v1.ListObjectCountryVisitsRequest) (*v1.ListObjectCountryVisitsResponse, error) {
+	response, err := c.listObjectCountryVisits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverCountryVisits calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverCountryVisits.
+func (c *trustTrackApiClient) ListDriverCountryVisits(ctx context.Context, req *v1.ListDriverCountryVisitsRequest) (*v1.ListDriverCountryVisitsResponse, error) {
+	response, err := c.listDriverCountryVisits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDetectedEvents calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDetectedEvents.
+func (c *trustTrackApiClient) ListDetectedEvents(ctx context.Context, req *v1.ListDetectedEventsRequest) (*v1.ListDetectedEventsResponse, error) {
+	response, err := c.listDetectedEvents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
 }
 
 // ListDrivers calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers.
@@ -162,6 +585,108 @@ func (c *trustTrackApiClient) ListDrivers(ctx context.Context, req *v1.ListDrive
 	return nil, err
 }
 
+// CreateDriver calls wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriver.
+func (c *trustTrackApiClient) CreateDriver(ctx context.Context, req *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error) {
+	response, err := c.createDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateDriver calls wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateDriver.
+func (c *trustTrackApiClient) UpdateDriver(ctx context.Context, req *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error) {
+	response, err := c.updateDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteDriver calls wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteDriver.
+func (c *trustTrackApiClient) DeleteDriver(ctx context.Context, req *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error) {
+	response, err := c.deleteDriver.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLastDriverAssignation calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.GetLastDriverAssignation.
+func (c *trustTrackApiClient) GetLastDriverAssignation(ctx context.Context, req *v1.GetLastDriverAssignationRequest) (*v1.GetLastDriverAssignationResponse, error) {
+	response, err := c.getLastDriverAssignation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateDriverAssignation calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriverAssignation.
+func (c *trustTrackApiClient) CreateDriverAssignation(ctx context.Context, req *v1.CreateDriverAssignationRequest) (*v1.CreateDriverAssignationResponse, error) {
+	response, err := c.createDriverAssignation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDriverTimeAnalysis calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeAnalysis.
+func (c *trustTrackApiClient) GetDriverTimeAnalysis(ctx context.Context, req *v1.GetDriverTimeAnalysisRequest) (*v1.GetDriverTimeAnalysisResponse, error) {
+	response, err := c.getDriverTimeAnalysis.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDriverTimeTable calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeTable.
+func (c *trustTrackApiClient) GetDriverTimeTable(ctx context.Context, req *v1.GetDriverTimeTableRequest) (*v1.GetDriverTimeTableResponse, error) {
+	response, err := c.getDriverTimeTable.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverStates calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverStates.
+func (c *trustTrackApiClient) ListDriverStates(ctx context.Context, req *v1.ListDriverStatesRequest) (*v1.ListDriverStatesResponse, error) {
+	response, err := c.listDriverStates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverViolations calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverViolations.
+func (c *trustTrackApiClient) ListDriverViolations(ctx context.Context, req *v1.ListDriverViolationsRequest) (*v1.ListDriverViolationsResponse, error) {
+	response, err := c.listDriverViolations.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListObjectEcodriving calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectEcodriving.
+func (c *trustTrackApiClient) ListObjectEcodriving(ctx context.Context, req *v1.ListObjectEcodrivingRequest) (*v1.ListObjectEcodrivingResponse, error) {
+	response, err := c.listObjectEcodriving.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDriverEcodriving calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverEcodriving.
+func (c *trustTrackApiClient) ListDriverEcodriving(ctx context.Context, req *v1.ListDriverEcodrivingRequest) (*v1.ListDriverEcodrivingResponse, error) {
+	response, err := c.listDriverEcodriving.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ListFuelEvents calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents.
 func (c *trustTrackApiClient) ListFuelEvents(ctx context.Context, req *v1.ListFuelEventsRequest) (*v1.ListFuelEventsResponse, error) {
 	response, err := c.listFuelEvents.CallUnary(ctx, connect.NewRequest(req))
@@ -171,6 +696,24 @@ func (c *trustTrackApiClient) ListFuelEvents(ctx context.Context, req *v1.ListFu
 	return nil, err
 }
 
+// ListGeozones calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozones.
+func (c *trustTrackApiClient) ListGeozones(ctx context.Context, req *v1.ListGeozonesRequest) (*v1.ListGeozonesResponse, error) {
+	response, err := c.listGeozones.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListGeozoneVisits calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozoneVisits.
+func (c *trustTrackApiClient) ListGeozoneVisits(ctx context.Context, req *v1.ListGeozoneVisitsRequest) (*v1.ListGeozoneVisitsResponse, error) {
+	response, err := c.listGeozoneVisits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // GetObjectGroup calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup.
 func (c *trustTrackApiClient) GetObjectGroup(ctx context.Context, req *v1.GetObjectGroupRequest) (*v1.GetObjectGroupResponse, error) {
 	response, err := c.getObjectGroup.CallUnary(ctx, connect.NewRequest(req))
@@ -180,6 +723,15 @@ func (c *trustTrackApiClient) GetObjectGroup(ctx context.Context, req *v1.GetObj
 	return nil, err
 }
 
+// UpdateObjectGroup calls wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateObjectGroup.
+func (c *trustTrackApiClient) UpdateObjectGroup(ctx context.Context, req *v1.UpdateObjectGroupRequest) (*v1.UpdateObjectGroupResponse, error) {
+	response, err := c.updateObjectGroup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ListObjectGroups calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups.
 func (c *trustTrackApiClient) ListObjectGroups(ctx context.Context, req *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error) {
 	response, err := c.listObjectGroups.CallUnary(ctx, connect.NewRequest(req))
@@ -199,6 +751,30 @@ func (c *trustTrackApiClient) ListObjectCoordinates(ctx context.Context, req *v1
 	return nil, err
 }
 
+// GetObjectCoordinate calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectCoordinate.
+func (c *trustTrackApiClient) GetObjectCoordinate(ctx context.Context, req *v1.GetObjectCoordinateRequest) (*v1.GetObjectCoordinateResponse, error) {
+	response, err := c.getObjectCoordinate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StreamObjectCoordinates calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.StreamObjectCoordinates.
+func (c *trustTrackApiClient) StreamObjectCoordinates(ctx context.Context, req *v1.StreamObjectCoordinatesRequest) (*connect.ServerStreamForClient[v1.StreamObjectCoordinatesResponse], error) {
+	return c.streamObjectCoordinates.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetObject calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObject.
+func (c *trustTrackApiClient) GetObject(ctx context.Context, req *v1.GetObjectRequest) (*v1.GetObjectResponse, error) {
+	response, err := c.getObject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ListObjects calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects.
 func (c *trustTrackApiClient) ListObjects(ctx context.Context, req *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error) {
 	response, err := c.listObjects.CallUnary(ctx, connect.NewRequest(req))
@@ -208,6 +784,16 @@ func (c *trustTrackApiClient) ListObjects(ctx context.Context, req *v1.ListObjec
 	return nil, err
 }
 
+// GetObjectLastPosition calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectLastPosition.
+func (c *trustTrackApiClient) GetObjectLastPosition(ctx context.Context, req *v1.GetObjectLastPositionRequest) (*v1.GetObjectLastPositionResponse, error) {
+	response, err := c.getObjectLastPosition.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ListObjectsLastPosition calls
 // wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition.
 func (c *trustTrackApiClient) ListObjectsLastPosition(ctx context.Context, req *v1.ListObjectsLastPositionRequest) (*v1.ListObjectsLastPositionResponse, error) {
@@ -218,6 +804,107 @@ func (c *trustTrackApiClient) ListObjectsLastPosition(ctx context.Context, req *
 	return nil, err
 }
 
+// CreateShareLink calls wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateShareLink.
+func (c *trustTrackApiClient) CreateShareLink(ctx context.Context, req *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	response, err := c.createShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetShareLink calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetShareLink.
+func (c *trustTrackApiClient) GetShareLink(ctx context.Context, req *v1.GetShareLinkRequest) (*v1.GetShareLinkResponse, error) {
+	response, err := c.getShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListShareLinks calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListShareLinks.
+func (c *trustTrackApiClient) ListShareLinks(ctx context.Context, req *v1.ListShareLinksRequest) (*v1.ListShareLinksResponse, error) {
+	response, err := c.listShareLinks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateShareLink calls wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateShareLink.
+func (c *trustTrackApiClient) UpdateShareLink(ctx context.Context, req *v1.UpdateShareLinkRequest) (*v1.UpdateShareLinkResponse, error) {
+	response, err := c.updateShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteShareLink calls wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteShareLink.
+func (c *trustTrackApiClient) DeleteShareLink(ctx context.Context, req *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error) {
+	response, err := c.deleteShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ScheduleVehicleTachoDownload calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleVehicleTachoDownload.
+func (c *trustTrackApiClient) ScheduleVehicleTachoDownload(ctx context.Context, req *v1.ScheduleVehicleTachoDownloadRequest) (*v1.ScheduleVehicleTachoDownloadResponse, error) {
+	response, err := c.scheduleVehicleTachoDownload.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ScheduleDriverCardTachoDownload calls
+// wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleDriverCardTachoDownload.
+func (c *trustTrackApiClient) ScheduleDriverCardTachoDownload(ctx context.Context, req *v1.ScheduleDriverCardTachoDownloadRequest) (*v1.ScheduleDriverCardTachoDownloadResponse, error) {
+	response, err := c.scheduleDriverCardTachoDownload.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTachoRequests calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTachoRequests.
+func (c *trustTrackApiClient) ListTachoRequests(ctx context.Context, req *v1.ListTachoRequestsRequest) (*v1.ListTachoRequestsResponse, error) {
+	response, err := c.listTachoRequests.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTachoRequest calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoRequest.
+func (c *trustTrackApiClient) GetTachoRequest(ctx context.Context, req *v1.GetTachoRequestRequest) (*v1.GetTachoRequestResponse, error) {
+	response, err := c.getTachoRequest.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTachoRequest calls wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteTachoRequest.
+func (c *trustTrackApiClient) DeleteTachoRequest(ctx context.Context, req *v1.DeleteTachoRequestRequest) (*v1.DeleteTachoRequestResponse, error) {
+	response, err := c.deleteTachoRequest.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTachoFile calls wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoFile.
+func (c *trustTrackApiClient) GetTachoFile(ctx context.Context, req *v1.GetTachoFileRequest) (*v1.GetTachoFileResponse, error) {
+	response, err := c.getTachoFile.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ListTrips calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips.
 func (c *trustTrackApiClient) ListTrips(ctx context.Context, req *v1.ListTripsRequest) (*v1.ListTripsResponse, error) {
 	response, err := c.listTrips.CallUnary(ctx, connect.NewRequest(req))
@@ -227,25 +914,100 @@ func (c *trustTrackApiClient) ListTrips(ctx context.Context, req *v1.ListTripsRe
 	return nil, err
 }
 
+// ListUsers calls wayplatform.connect.trusttrack.v1.TrustTrackApi.ListUsers.
+func (c *trustTrackApiClient) ListUsers(ctx context.Context, req *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
+	response, err := c.listUsers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // TrustTrackApiHandler is an implementation of the wayplatform.connect.trusttrack.v1.TrustTrackApi
 // service.
 type TrustTrackApiHandler interface {
+	// ListObjectCountryVisits lists the country visits of an object for a specified time period.
+	ListObjectCountryVisits(context.Context, *v1.ListObjectCountryVisitsRequest) (*v1.ListObjectCountryVisitsResponse, error)
+	// ListDriverCountryVisits lists the country visits of a driver for a specified time period.
+	ListDriverCountryVisits(context.Context, *v1.ListDriverCountryVisitsRequest) (*v1.ListDriverCountryVisitsResponse, error)
+	// ListDetectedEvents lists events detected for an object.
+	ListDetectedEvents(context.Context, *v1.ListDetectedEventsRequest) (*v1.ListDetectedEventsResponse, error)
 	// ListDrivers lists all drivers.
 	ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error)
+	// CreateDriver creates a driver.
+	CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error)
+	// UpdateDriver updates a driver.
+	UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error)
+	// DeleteDriver deletes a driver.
+	DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error)
+	// GetLastDriverAssignation gets the last assignation event of a driver or an object.
+	GetLastDriverAssignation(context.Context, *v1.GetLastDriverAssignationRequest) (*v1.GetLastDriverAssignationResponse, error)
+	// CreateDriverAssignation creates a manual driver assignation event.
+	CreateDriverAssignation(context.Context, *v1.CreateDriverAssignationRequest) (*v1.CreateDriverAssignationResponse, error)
+	// GetDriverTimeAnalysis gets an analysis of a driver's current driving and rest times.
+	GetDriverTimeAnalysis(context.Context, *v1.GetDriverTimeAnalysisRequest) (*v1.GetDriverTimeAnalysisResponse, error)
+	// GetDriverTimeTable gets the monthly working time table of a driver.
+	GetDriverTimeTable(context.Context, *v1.GetDriverTimeTableRequest) (*v1.GetDriverTimeTableResponse, error)
+	// ListDriverStates lists the tachograph activity periods of a driver for a specified time period.
+	ListDriverStates(context.Context, *v1.ListDriverStatesRequest) (*v1.ListDriverStatesResponse, error)
+	// ListDriverViolations lists driving and rest time violations of drivers.
+	ListDriverViolations(context.Context, *v1.ListDriverViolationsRequest) (*v1.ListDriverViolationsResponse, error)
+	// ListObjectEcodriving lists the ecodriving parameters of an object for a specified time period.
+	ListObjectEcodriving(context.Context, *v1.ListObjectEcodrivingRequest) (*v1.ListObjectEcodrivingResponse, error)
+	// ListDriverEcodriving lists the ecodriving parameters of a driver for a specified time period.
+	ListDriverEcodriving(context.Context, *v1.ListDriverEcodrivingRequest) (*v1.ListDriverEcodrivingResponse, error)
 	// ListFuelEvents lists fuel events for an object.
 	ListFuelEvents(context.Context, *v1.ListFuelEventsRequest) (*v1.ListFuelEventsResponse, error)
+	// ListGeozones lists all geozones.
+	ListGeozones(context.Context, *v1.ListGeozonesRequest) (*v1.ListGeozonesResponse, error)
+	// ListGeozoneVisits lists geozone enter and exit events for objects.
+	ListGeozoneVisits(context.Context, *v1.ListGeozoneVisitsRequest) (*v1.ListGeozoneVisitsResponse, error)
 	// GetObjectGroup gets a specific object group by external ID.
 	GetObjectGroup(context.Context, *v1.GetObjectGroupRequest) (*v1.GetObjectGroupResponse, error)
+	// UpdateObjectGroup adds objects to and removes objects from an object group.
+	UpdateObjectGroup(context.Context, *v1.UpdateObjectGroupRequest) (*v1.UpdateObjectGroupResponse, error)
 	// ListObjectGroups lists all object groups.
 	ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error)
 	// ListObjectCoordinates lists object coordinates for a specified time period.
 	ListObjectCoordinates(context.Context, *v1.ListObjectCoordinatesRequest) (*v1.ListObjectCoordinatesResponse, error)
+	// GetObjectCoordinate gets the object coordinate recorded at a specific time.
+	GetObjectCoordinate(context.Context, *v1.GetObjectCoordinateRequest) (*v1.GetObjectCoordinateResponse, error)
+	// StreamObjectCoordinates streams real-time coordinates of all objects or a single object.
+	StreamObjectCoordinates(context.Context, *v1.StreamObjectCoordinatesRequest, *connect.ServerStream[v1.StreamObjectCoordinatesResponse]) error
+	// GetObject gets an object.
+	GetObject(context.Context, *v1.GetObjectRequest) (*v1.GetObjectResponse, error)
 	// ListObjects lists all objects.
 	ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error)
+	// GetObjectLastPosition gets an object with its last position.
+	GetObjectLastPosition(context.Context, *v1.GetObjectLastPositionRequest) (*v1.GetObjectLastPositionResponse, error)
 	// ListObjectsLastPosition lists all objects with their last position.
 	ListObjectsLastPosition(context.Context, *v1.ListObjectsLastPositionRequest) (*v1.ListObjectsLastPositionResponse, error)
+	// CreateShareLink creates a share link.
+	CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error)
+	// GetShareLink gets a share link.
+	GetShareLink(context.Context, *v1.GetShareLinkRequest) (*v1.GetShareLinkResponse, error)
+	// ListShareLinks lists share links.
+	ListShareLinks(context.Context, *v1.ListShareLinksRequest) (*v1.ListShareLinksResponse, error)
+	// UpdateShareLink updates the validity period of a share link.
+	UpdateShareLink(context.Context, *v1.UpdateShareLinkRequest) (*v1.UpdateShareLinkResponse, error)
+	// DeleteShareLink deletes a share link.
+	DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error)
+	// ScheduleVehicleTachoDownload schedules a remote download of vehicle unit data.
+	ScheduleVehicleTachoDownload(context.Context, *v1.ScheduleVehicleTachoDownloadRequest) (*v1.ScheduleVehicleTachoDownloadResponse, error)
+	// ScheduleDriverCardTachoDownload schedules a remote download of a driver card.
+	ScheduleDriverCardTachoDownload(context.Context, *v1.ScheduleDriverCardTachoDownloadRequest) (*v1.ScheduleDriverCardTachoDownloadResponse, error)
+	// ListTachoRequests lists remote tachograph download requests.
+	ListTachoRequests(context.Context, *v1.ListTachoRequestsRequest) (*v1.ListTachoRequestsResponse, error)
+	// GetTachoRequest gets a remote tachograph download request.
+	GetTachoRequest(context.Context, *v1.GetTachoRequestRequest) (*v1.GetTachoRequestResponse, error)
+	// DeleteTachoRequest deletes a remote tachograph download request.
+	DeleteTachoRequest(context.Context, *v1.DeleteTachoRequestRequest) (*v1.DeleteTachoRequestResponse, error)
+	// GetTachoFile gets the file downloaded by a completed tachograph download request.
+	GetTachoFile(context.Context, *v1.GetTachoFileRequest) (*v1.GetTachoFileResponse, error)
 	// ListTrips lists trips for an object.
 	ListTrips(context.Context, *v1.ListTripsRequest) (*v1.ListTripsResponse, error)
+	// ListUsers lists the users with access to the account.
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
 }
 
 // NewTrustTrackApiHandler builds an HTTP handler from the service implementation. It returns the
@@ -255,24 +1017,126 @@ type TrustTrackApiHandler interface {
 // and JSON codecs. They also support gzip compression.
 func NewTrustTrackApiHandler(svc TrustTrackApiHandler, opts ...connect.HandlerOption) (string, http.Handler) {
 	trustTrackApiMethods := v1.File_wayplatform_connect_trusttrack_v1_trusttrack_api_proto.Services().ByName("TrustTrackApi").Methods()
+	trustTrackApiListObjectCountryVisitsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListObjectCountryVisitsProcedure,
+		svc.ListObjectCountryVisits,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListObjectCountryVisits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListDriverCountryVisitsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListDriverCountryVisitsProcedure,
+		svc.ListDriverCountryVisits,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListDriverCountryVisits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListDetectedEventsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListDetectedEventsProcedure,
+		svc.ListDetectedEvents,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListDetectedEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListDriversHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListDriversProcedure,
 		svc.ListDrivers,
 		connect.WithSchema(trustTrackApiMethods.ByName("ListDrivers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiCreateDriverHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiCreateDriverProcedure,
+		svc.CreateDriver,
+		connect.WithSchema(trustTrackApiMethods.ByName("CreateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiUpdateDriverHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiUpdateDriverProcedure,
+		svc.UpdateDriver,
+		connect.WithSchema(trustTrackApiMethods.ByName("UpdateDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiDeleteDriverHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiDeleteDriverProcedure,
+		svc.DeleteDriver,
+		connect.WithSchema(trustTrackApiMethods.ByName("DeleteDriver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetLastDriverAssignationHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetLastDriverAssignationProcedure,
+		svc.GetLastDriverAssignation,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetLastDriverAssignation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiCreateDriverAssignationHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiCreateDriverAssignationProcedure,
+		svc.CreateDriverAssignation,
+		connect.WithSchema(trustTrackApiMethods.ByName("CreateDriverAssignation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetDriverTimeAnalysisHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetDriverTimeAnalysisProcedure,
+		svc.GetDriverTimeAnalysis,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetDriverTimeAnalysis")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetDriverTimeTableHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetDriverTimeTableProcedure,
+		svc.GetDriverTimeTable,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetDriverTimeTable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListDriverStatesHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListDriverStatesProcedure,
+		svc.ListDriverStates,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListDriverStates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListDriverViolationsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListDriverViolationsProcedure,
+		svc.ListDriverViolations,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListDriverViolations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListObjectEcodrivingHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListObjectEcodrivingProcedure,
+		svc.ListObjectEcodriving,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListObjectEcodriving")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListDriverEcodrivingHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListDriverEcodrivingProcedure,
+		svc.ListDriverEcodriving,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListDriverEcodriving")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListFuelEventsHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListFuelEventsProcedure,
 		svc.ListFuelEvents,
 		connect.WithSchema(trustTrackApiMethods.ByName("ListFuelEvents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiListGeozonesHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListGeozonesProcedure,
+		svc.ListGeozones,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListGeozones")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListGeozoneVisitsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListGeozoneVisitsProcedure,
+		svc.ListGeozoneVisits,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListGeozoneVisits")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiGetObjectGroupHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiGetObjectGroupProcedure,
 		svc.GetObjectGroup,
 		connect.WithSchema(trustTrackApiMethods.ByName("GetObjectGroup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiUpdateObjectGroupHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiUpdateObjectGroupProcedure,
+		svc.UpdateObjectGroup,
+		connect.WithSchema(trustTrackApiMethods.ByName("UpdateObjectGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListObjectGroupsHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListObjectGroupsProcedure,
 		svc.ListObjectGroups,
@@ -285,42 +1149,204 @@ func NewTrustTrackApiHandler(svc TrustTrackApiHandler, opts ...connect.HandlerOp
 		connect.WithSchema(trustTrackApiMethods.ByName("ListObjectCoordinates")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiGetObjectCoordinateHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetObjectCoordinateProcedure,
+		svc.GetObjectCoordinate,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetObjectCoordinate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiStreamObjectCoordinatesHandler := connect.NewServerStreamHandlerSimple(
+		TrustTrackApiStreamObjectCoordinatesProcedure,
+		svc.StreamObjectCoordinates,
+		connect.WithSchema(trustTrackApiMethods.ByName("StreamObjectCoordinates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetObjectHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetObjectProcedure,
+		svc.GetObject,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetObject")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListObjectsHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListObjectsProcedure,
 		svc.ListObjects,
 		connect.WithSchema(trustTrackApiMethods.ByName("ListObjects")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiGetObjectLastPositionHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetObjectLastPositionProcedure,
+		svc.GetObjectLastPosition,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetObjectLastPosition")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListObjectsLastPositionHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListObjectsLastPositionProcedure,
 		svc.ListObjectsLastPosition,
 		connect.WithSchema(trustTrackApiMethods.ByName("ListObjectsLastPosition")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiCreateShareLinkHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiCreateShareLinkProcedure,
+		svc.CreateShareLink,
+		connect.WithSchema(trustTrackApiMethods.ByName("CreateShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetShareLinkHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetShareLinkProcedure,
+		svc.GetShareLink,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListShareLinksHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListShareLinksProcedure,
+		svc.ListShareLinks,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListShareLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiUpdateShareLinkHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiUpdateShareLinkProcedure,
+		svc.UpdateShareLink,
+		connect.WithSchema(trustTrackApiMethods.ByName("UpdateShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiDeleteShareLinkHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiDeleteShareLinkProcedure,
+		svc.DeleteShareLink,
+		connect.WithSchema(trustTrackApiMethods.ByName("DeleteShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiScheduleVehicleTachoDownloadHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiScheduleVehicleTachoDownloadProcedure,
+		svc.ScheduleVehicleTachoDownload,
+		connect.WithSchema(trustTrackApiMethods.ByName("ScheduleVehicleTachoDownload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiScheduleDriverCardTachoDownloadHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiScheduleDriverCardTachoDownloadProcedure,
+		svc.ScheduleDriverCardTachoDownload,
+		connect.WithSchema(trustTrackApiMethods.ByName("ScheduleDriverCardTachoDownload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiListTachoRequestsHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListTachoRequestsProcedure,
+		svc.ListTachoRequests,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListTachoRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetTachoRequestHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetTachoRequestProcedure,
+		svc.GetTachoRequest,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetTachoRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiDeleteTachoRequestHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiDeleteTachoRequestProcedure,
+		svc.DeleteTachoRequest,
+		connect.WithSchema(trustTrackApiMethods.ByName("DeleteTachoRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	trustTrackApiGetTachoFileHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiGetTachoFileProcedure,
+		svc.GetTachoFile,
+		connect.WithSchema(trustTrackApiMethods.ByName("GetTachoFile")),
+		connect.WithHandlerOptions(opts...),
+	)
 	trustTrackApiListTripsHandler := connect.NewUnaryHandlerSimple(
 		TrustTrackApiListTripsProcedure,
 		svc.ListTrips,
 		connect.WithSchema(trustTrackApiMethods.ByName("ListTrips")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustTrackApiListUsersHandler := connect.NewUnaryHandlerSimple(
+		TrustTrackApiListUsersProcedure,
+		svc.ListUsers,
+		connect.WithSchema(trustTrackApiMethods.ByName("ListUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/wayplatform.connect.trusttrack.v1.TrustTrackApi/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
+		case TrustTrackApiListObjectCountryVisitsProcedure:
+			trustTrackApiListObjectCountryVisitsHandler.ServeHTTP(w, r)
+		case TrustTrackApiListDriverCountryVisitsProcedure:
+			trustTrackApiListDriverCountryVisitsHandler.ServeHTTP(w, r)
+		case TrustTrackApiListDetectedEventsProcedure:
+			trustTrackApiListDetectedEventsHandler.ServeHTTP(w, r)
 		case TrustTrackApiListDriversProcedure:
 			trustTrackApiListDriversHandler.ServeHTTP(w, r)
+		case TrustTrackApiCreateDriverProcedure:
+			trustTrackApiCreateDriverHandler.ServeHTTP(w, r)
+		case TrustTrackApiUpdateDriverProcedure:
+			trustTrackApiUpdateDriverHandler.ServeHTTP(w, r)
+		case TrustTrackApiDeleteDriverProcedure:
+			trustTrackApiDeleteDriverHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetLastDriverAssignationProcedure:
+			trustTrackApiGetLastDriverAssignationHandler.ServeHTTP(w, r)
+		case TrustTrackApiCreateDriverAssignationProcedure:
+			trustTrackApiCreateDriverAssignationHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetDriverTimeAnalysisProcedure:
+			trustTrackApiGetDriverTimeAnalysisHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetDriverTimeTableProcedure:
+			trustTrackApiGetDriverTimeTableHandler.ServeHTTP(w, r)
+		case TrustTrackApiListDriverStatesProcedure:
+			trustTrackApiListDriverStatesHandler.ServeHTTP(w, r)
+		case TrustTrackApiListDriverViolationsProcedure:
+			trustTrackApiListDriverViolationsHandler.ServeHTTP(w, r)
+		case TrustTrackApiListObjectEcodrivingProcedure:
+			trustTrackApiListObjectEcodrivingHandler.ServeHTTP(w, r)
+		case TrustTrackApiListDriverEcodrivingProcedure:
+			trustTrackApiListDriverEcodrivingHandler.ServeHTTP(w, r)
 		case TrustTrackApiListFuelEventsProcedure:
 			trustTrackApiListFuelEventsHandler.ServeHTTP(w, r)
+		case TrustTrackApiListGeozonesProcedure:
+			trustTrackApiListGeozonesHandler.ServeHTTP(w, r)
+		case TrustTrackApiListGeozoneVisitsProcedure:
+			trustTrackApiListGeozoneVisitsHandler.ServeHTTP(w, r)
 		case TrustTrackApiGetObjectGroupProcedure:
 			trustTrackApiGetObjectGroupHandler.ServeHTTP(w, r)
+		case TrustTrackApiUpdateObjectGroupProcedure:
+			trustTrackApiUpdateObjectGroupHandler.ServeHTTP(w, r)
 		case TrustTrackApiListObjectGroupsProcedure:
 			trustTrackApiListObjectGroupsHandler.ServeHTTP(w, r)
 		case TrustTrackApiListObjectCoordinatesProcedure:
 			trustTrackApiListObjectCoordinatesHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetObjectCoordinateProcedure:
+			trustTrackApiGetObjectCoordinateHandler.ServeHTTP(w, r)
+		case TrustTrackApiStreamObjectCoordinatesProcedure:
+			trustTrackApiStreamObjectCoordinatesHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetObjectProcedure:
+			trustTrackApiGetObjectHandler.ServeHTTP(w, r)
 		case TrustTrackApiListObjectsProcedure:
 			trustTrackApiListObjectsHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetObjectLastPositionProcedure:
+			trustTrackApiGetObjectLastPositionHandler.ServeHTTP(w, r)
 		case TrustTrackApiListObjectsLastPositionProcedure:
 			trustTrackApiListObjectsLastPositionHandler.ServeHTTP(w, r)
+		case TrustTrackApiCreateShareLinkProcedure:
+			trustTrackApiCreateShareLinkHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetShareLinkProcedure:
+			trustTrackApiGetShareLinkHandler.ServeHTTP(w, r)
+		case TrustTrackApiListShareLinksProcedure:
+			trustTrackApiListShareLinksHandler.ServeHTTP(w, r)
+		case TrustTrackApiUpdateShareLinkProcedure:
+			trustTrackApiUpdateShareLinkHandler.ServeHTTP(w, r)
+		case TrustTrackApiDeleteShareLinkProcedure:
+			trustTrackApiDeleteShareLinkHandler.ServeHTTP(w, r)
+		case TrustTrackApiScheduleVehicleTachoDownloadProcedure:
+			trustTrackApiScheduleVehicleTachoDownloadHandler.ServeHTTP(w, r)
+		case TrustTrackApiScheduleDriverCardTachoDownloadProcedure:
+			trustTrackApiScheduleDriverCardTachoDownloadHandler.ServeHTTP(w, r)
+		case TrustTrackApiListTachoRequestsProcedure:
+			trustTrackApiListTachoRequestsHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetTachoRequestProcedure:
+			trustTrackApiGetTachoRequestHandler.ServeHTTP(w, r)
+		case TrustTrackApiDeleteTachoRequestProcedure:
+			trustTrackApiDeleteTachoRequestHandler.ServeHTTP(w, r)
+		case TrustTrackApiGetTachoFileProcedure:
+			trustTrackApiGetTachoFileHandler.ServeHTTP(w, r)
 		case TrustTrackApiListTripsProcedure:
 			trustTrackApiListTripsHandler.ServeHTTP(w, r)
+		case TrustTrackApiListUsersProcedure:
+			trustTrackApiListUsersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -330,18 +1356,86 @@ func NewTrustTrackApiHandler(svc TrustTrackApiHandler, opts ...connect.HandlerOp
 // UnimplementedTrustTrackApiHandler returns CodeUnimplemented from all methods.
 type UnimplementedTrustTrackApiHandler struct{}
 
+func (UnimplementedTrustTrackApiHandler) ListObjectCountryVisits(context.Context, *v1.ListObjectCountryVisitsRequest) (*v1.ListObjectCountryVisitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCountryVisits is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListDriverCountryVisits(context.Context, *v1.ListDriverCountryVisitsRequest) (*v1.ListDriverCountryVisitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverCountryVisits is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListDetectedEvents(context.Context, *v1.ListDetectedEventsRequest) (*v1.ListDetectedEventsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDetectedEvents is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListDrivers(context.Context, *v1.ListDriversRequest) (*v1.ListDriversResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDrivers is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) CreateDriver(context.Context, *v1.CreateDriverRequest) (*v1.CreateDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriver is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) UpdateDriver(context.Context, *v1.UpdateDriverRequest) (*v1.UpdateDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateDriver is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) DeleteDriver(context.Context, *v1.DeleteDriverRequest) (*v1.DeleteDriverResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteDriver is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetLastDriverAssignation(context.Context, *v1.GetLastDriverAssignationRequest) (*v1.GetLastDriverAssignationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetLastDriverAssignation is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) CreateDriverAssignation(context.Context, *v1.CreateDriverAssignationRequest) (*v1.CreateDriverAssignationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateDriverAssignation is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetDriverTimeAnalysis(context.Context, *v1.GetDriverTimeAnalysisRequest) (*v1.GetDriverTimeAnalysisResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeAnalysis is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetDriverTimeTable(context.Context, *v1.GetDriverTimeTableRequest) (*v1.GetDriverTimeTableResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetDriverTimeTable is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListDriverStates(context.Context, *v1.ListDriverStatesRequest) (*v1.ListDriverStatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverStates is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListDriverViolations(context.Context, *v1.ListDriverViolationsRequest) (*v1.ListDriverViolationsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverViolations is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListObjectEcodriving(context.Context, *v1.ListObjectEcodrivingRequest) (*v1.ListObjectEcodrivingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectEcodriving is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListDriverEcodriving(context.Context, *v1.ListDriverEcodrivingRequest) (*v1.ListDriverEcodrivingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListDriverEcodriving is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListFuelEvents(context.Context, *v1.ListFuelEventsRequest) (*v1.ListFuelEventsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListFuelEvents is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) ListGeozones(context.Context, *v1.ListGeozonesRequest) (*v1.ListGeozonesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozones is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListGeozoneVisits(context.Context, *v1.ListGeozoneVisitsRequest) (*v1.ListGeozoneVisitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListGeozoneVisits is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) GetObjectGroup(context.Context, *v1.GetObjectGroupRequest) (*v1.GetObjectGroupResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectGroup is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) UpdateObjectGroup(context.Context, *v1.UpdateObjectGroupRequest) (*v1.UpdateObjectGroupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateObjectGroup is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListObjectGroups(context.Context, *v1.ListObjectGroupsRequest) (*v1.ListObjectGroupsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectGroups is not implemented"))
 }
@@ -350,14 +1444,78 @@ func (UnimplementedTrustTrackApiHandler) ListObjectCoordinates(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectCoordinates is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) GetObjectCoordinate(context.Context, *v1.GetObjectCoordinateRequest) (*v1.GetObjectCoordinateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectCoordinate is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) StreamObjectCoordinates(context.Context, *v1.StreamObjectCoordinatesRequest, *connect.ServerStream[v1.StreamObjectCoordinatesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.StreamObjectCoordinates is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetObject(context.Context, *v1.GetObjectRequest) (*v1.GetObjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObject is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListObjects(context.Context, *v1.ListObjectsRequest) (*v1.ListObjectsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjects is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) GetObjectLastPosition(context.Context, *v1.GetObjectLastPositionRequest) (*v1.GetObjectLastPositionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetObjectLastPosition is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListObjectsLastPosition(context.Context, *v1.ListObjectsLastPositionRequest) (*v1.ListObjectsLastPositionResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListObjectsLastPosition is not implemented"))
 }
 
+func (UnimplementedTrustTrackApiHandler) CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.CreateShareLink is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetShareLink(context.Context, *v1.GetShareLinkRequest) (*v1.GetShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetShareLink is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListShareLinks(context.Context, *v1.ListShareLinksRequest) (*v1.ListShareLinksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListShareLinks is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) UpdateShareLink(context.Context, *v1.UpdateShareLinkRequest) (*v1.UpdateShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.UpdateShareLink is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteShareLink is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ScheduleVehicleTachoDownload(context.Context, *v1.ScheduleVehicleTachoDownloadRequest) (*v1.ScheduleVehicleTachoDownloadResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleVehicleTachoDownload is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ScheduleDriverCardTachoDownload(context.Context, *v1.ScheduleDriverCardTachoDownloadRequest) (*v1.ScheduleDriverCardTachoDownloadResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ScheduleDriverCardTachoDownload is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) ListTachoRequests(context.Context, *v1.ListTachoRequestsRequest) (*v1.ListTachoRequestsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTachoRequests is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetTachoRequest(context.Context, *v1.GetTachoRequestRequest) (*v1.GetTachoRequestResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoRequest is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) DeleteTachoRequest(context.Context, *v1.DeleteTachoRequestRequest) (*v1.DeleteTachoRequestResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.DeleteTachoRequest is not implemented"))
+}
+
+func (UnimplementedTrustTrackApiHandler) GetTachoFile(context.Context, *v1.GetTachoFileRequest) (*v1.GetTachoFileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.GetTachoFile is not implemented"))
+}
+
 func (UnimplementedTrustTrackApiHandler) ListTrips(context.Context, *v1.ListTripsRequest) (*v1.ListTripsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListTrips is not implemented"))
 }
+
+func (UnimplementedTrustTrackApiHandler) ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("wayplatform.connect.trusttrack.v1.TrustTrackApi.ListUsers is not implemented"))
+}
@@ -0,0 +1,970 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: wayplatform/connect/trusttrack/v1/violation.proto
+
+package trusttrackv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents the severity of a violation.
+type Violation_Severity int32
+
+const (
+	Violation_SEVERITY_UNSPECIFIED   Violation_Severity = 0
+	Violation_SEVERITY_UNKNOWN       Violation_Severity = 1
+	Violation_SEVERITY_NOT_AVAILABLE Violation_Severity = 2
+	Violation_NOT_APPLICABLE         Violation_Severity = 3
+	Violation_MINOR                  Violation_Severity = 4
+	Violation_SERIOUS                Violation_Severity = 5
+	Violation_VERY_SERIOUS           Violation_Severity = 6
+	Violation_MOST_SERIOUS           Violation_Severity = 7
+)
+
+// Enum value maps for Violation_Severity.
+var (
+	Violation_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_UNKNOWN",
+		2: "SEVERITY_NOT_AVAILABLE",
+		3: "NOT_APPLICABLE",
+		4: "MINOR",
+		5: "SERIOUS",
+		6: "VERY_SERIOUS",
+		7: "MOST_SERIOUS",
+	}
+	Violation_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED":   0,
+		"SEVERITY_UNKNOWN":       1,
+		"SEVERITY_NOT_AVAILABLE": 2,
+		"NOT_APPLICABLE":         3,
+		"MINOR":                  4,
+		"SERIOUS":                5,
+		"VERY_SERIOUS":           6,
+		"MOST_SERIOUS":           7,
+	}
+)
+
+func (x Violation_Severity) Enum() *Violation_Severity {
+	p := new(Violation_Severity)
+	*p = x
+	return p
+}
+
+func (x Violation_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Violation_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[0].Descriptor()
+}
+
+func (Violation_Severity) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[0]
+}
+
+func (x Violation_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the type of a violation.
+type Violation_Type int32
+
+const (
+	Violation_TYPE_UNSPECIFIED                        Violation_Type = 0
+	Violation_TYPE_UNKNOWN                            Violation_Type = 1
+	Violation_TYPE_NOT_AVAILABLE                      Violation_Type = 2
+	Violation_WEEKLY_REST_NOT_STARTED                 Violation_Type = 3
+	Violation_INSUFFICIENT_REGULAR_WEEKLY_REST        Violation_Type = 4
+	Violation_INSUFFICIENT_REDUCED_WEEKLY_REST        Violation_Type = 5
+	Violation_INSUFFICIENT_REGULAR_DAILY_REST         Violation_Type = 6
+	Violation_INSUFFICIENT_REDUCED_DAILY_REST         Violation_Type = 7
+	Violation_INSUFFICIENT_CREW_DAILY_REST            Violation_Type = 8
+	Violation_NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST Violation_Type = 9
+	Violation_REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED    Violation_Type = 10
+	Violation_EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED   Violation_Type = 11
+	Violation_WEEKLY_DRIVING_LIMIT_EXCEEDED           Violation_Type = 12
+	Violation_FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED      Violation_Type = 13
+	Violation_UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED    Violation_Type = 14
+	Violation_WEEKLY_WORK_LIMIT_EXCEEDED              Violation_Type = 15
+	Violation_WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED      Violation_Type = 16
+	Violation_NO_BREAK_AFTER_SIX_HOUR_WORK            Violation_Type = 17
+	Violation_NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK    Violation_Type = 18
+	Violation_NO_COUNTRY_SET_ON_WORK_START            Violation_Type = 19
+	Violation_NO_COUNTRY_SET_ON_WORK_END              Violation_Type = 20
+	Violation_NIGHT_SHIFT_WORK_LIMIT_EXCEEDED         Violation_Type = 21
+	Violation_UNKNOWN_ACTIVITY                        Violation_Type = 22
+)
+
+// Enum value maps for Violation_Type.
+var (
+	Violation_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_UNKNOWN",
+		2:  "TYPE_NOT_AVAILABLE",
+		3:  "WEEKLY_REST_NOT_STARTED",
+		4:  "INSUFFICIENT_REGULAR_WEEKLY_REST",
+		5:  "INSUFFICIENT_REDUCED_WEEKLY_REST",
+		6:  "INSUFFICIENT_REGULAR_DAILY_REST",
+		7:  "INSUFFICIENT_REDUCED_DAILY_REST",
+		8:  "INSUFFICIENT_CREW_DAILY_REST",
+		9:  "NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST",
+		10: "REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED",
+		11: "EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED",
+		12: "WEEKLY_DRIVING_LIMIT_EXCEEDED",
+		13: "FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED",
+		14: "UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED",
+		15: "WEEKLY_WORK_LIMIT_EXCEEDED",
+		16: "WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED",
+		17: "NO_BREAK_AFTER_SIX_HOUR_WORK",
+		18: "NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK",
+		19: "NO_COUNTRY_SET_ON_WORK_START",
+		20: "NO_COUNTRY_SET_ON_WORK_END",
+		21: "NIGHT_SHIFT_WORK_LIMIT_EXCEEDED",
+		22: "UNKNOWN_ACTIVITY",
+	}
+	Violation_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":                        0,
+		"TYPE_UNKNOWN":                            1,
+		"TYPE_NOT_AVAILABLE":                      2,
+		"WEEKLY_REST_NOT_STARTED":                 3,
+		"INSUFFICIENT_REGULAR_WEEKLY_REST":        4,
+		"INSUFFICIENT_REDUCED_WEEKLY_REST":        5,
+		"INSUFFICIENT_REGULAR_DAILY_REST":         6,
+		"INSUFFICIENT_REDUCED_DAILY_REST":         7,
+		"INSUFFICIENT_CREW_DAILY_REST":            8,
+		"NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST": 9,
+		"REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED":    10,
+		"EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED":   11,
+		"WEEKLY_DRIVING_LIMIT_EXCEEDED":           12,
+		"FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED":      13,
+		"UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED":    14,
+		"WEEKLY_WORK_LIMIT_EXCEEDED":              15,
+		"WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED":      16,
+		"NO_BREAK_AFTER_SIX_HOUR_WORK":            17,
+		"NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK":    18,
+		"NO_COUNTRY_SET_ON_WORK_START":            19,
+		"NO_COUNTRY_SET_ON_WORK_END":              20,
+		"NIGHT_SHIFT_WORK_LIMIT_EXCEEDED":         21,
+		"UNKNOWN_ACTIVITY":                        22,
+	}
+)
+
+func (x Violation_Type) Enum() *Violation_Type {
+	p := new(Violation_Type)
+	*p = x
+	return p
+}
+
+func (x Violation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Violation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[1].Descriptor()
+}
+
+func (Violation_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[1]
+}
+
+func (x Violation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the type of a fact.
+type Violation_Fact_Type int32
+
+const (
+	Violation_Fact_TYPE_UNSPECIFIED   Violation_Fact_Type = 0
+	Violation_Fact_TYPE_UNKNOWN       Violation_Fact_Type = 1
+	Violation_Fact_TYPE_NOT_AVAILABLE Violation_Fact_Type = 2
+	Violation_Fact_DURATION           Violation_Fact_Type = 3
+)
+
+// Enum value maps for Violation_Fact_Type.
+var (
+	Violation_Fact_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UNKNOWN",
+		2: "TYPE_NOT_AVAILABLE",
+		3: "DURATION",
+	}
+	Violation_Fact_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"TYPE_UNKNOWN":       1,
+		"TYPE_NOT_AVAILABLE": 2,
+		"DURATION":           3,
+	}
+)
+
+func (x Violation_Fact_Type) Enum() *Violation_Fact_Type {
+	p := new(Violation_Fact_Type)
+	*p = x
+	return p
+}
+
+func (x Violation_Fact_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Violation_Fact_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[2].Descriptor()
+}
+
+func (Violation_Fact_Type) Type() protoreflect.EnumType {
+	return &file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes[2]
+}
+
+func (x Violation_Fact_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// A driving and rest time violation of a driver, based on tachograph data.
+type Violation struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CountryCode     *string                `protobuf:"bytes,1,opt,name=country_code,json=countryCode"`
+	xxx_hidden_Time            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time"`
+	xxx_hidden_Driver          *Violation_Driver      `protobuf:"bytes,3,opt,name=driver"`
+	xxx_hidden_Facts           *[]*Violation_Fact     `protobuf:"bytes,4,rep,name=facts"`
+	xxx_hidden_Severity        Violation_Severity     `protobuf:"varint,5,opt,name=severity,enum=wayplatform.connect.trusttrack.v1.Violation_Severity"`
+	xxx_hidden_UnknownSeverity *string                `protobuf:"bytes,6,opt,name=unknown_severity,json=unknownSeverity"`
+	xxx_hidden_Type            Violation_Type         `protobuf:"varint,7,opt,name=type,enum=wayplatform.connect.trusttrack.v1.Violation_Type"`
+	xxx_hidden_UnknownType     *string                `protobuf:"bytes,8,opt,name=unknown_type,json=unknownType"`
+	xxx_hidden_Vehicle         *string                `protobuf:"bytes,9,opt,name=vehicle"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Violation) GetCountryCode() string {
+	if x != nil {
+		if x.xxx_hidden_CountryCode != nil {
+			return *x.xxx_hidden_CountryCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *Violation) GetDriver() *Violation_Driver {
+	if x != nil {
+		return x.xxx_hidden_Driver
+	}
+	return nil
+}
+
+func (x *Violation) GetFacts() []*Violation_Fact {
+	if x != nil {
+		if x.xxx_hidden_Facts != nil {
+			return *x.xxx_hidden_Facts
+		}
+	}
+	return nil
+}
+
+func (x *Violation) GetSeverity() Violation_Severity {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_Severity
+		}
+	}
+	return Violation_SEVERITY_UNSPECIFIED
+}
+
+func (x *Violation) GetUnknownSeverity() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownSeverity != nil {
+			return *x.xxx_hidden_UnknownSeverity
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation) GetType() Violation_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 6) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return Violation_TYPE_UNSPECIFIED
+}
+
+func (x *Violation) GetUnknownType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownType != nil {
+			return *x.xxx_hidden_UnknownType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation) GetVehicle() string {
+	if x != nil {
+		if x.xxx_hidden_Vehicle != nil {
+			return *x.xxx_hidden_Vehicle
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation) SetCountryCode(v string) {
+	x.xxx_hidden_CountryCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *Violation) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *Violation) SetDriver(v *Violation_Driver) {
+	x.xxx_hidden_Driver = v
+}
+
+func (x *Violation) SetFacts(v []*Violation_Fact) {
+	x.xxx_hidden_Facts = &v
+}
+
+func (x *Violation) SetSeverity(v Violation_Severity) {
+	x.xxx_hidden_Severity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *Violation) SetUnknownSeverity(v string) {
+	x.xxx_hidden_UnknownSeverity = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *Violation) SetType(v Violation_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *Violation) SetUnknownType(v string) {
+	x.xxx_hidden_UnknownType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *Violation) SetVehicle(v string) {
+	x.xxx_hidden_Vehicle = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *Violation) HasCountryCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Violation) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *Violation) HasDriver() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Driver != nil
+}
+
+func (x *Violation) HasSeverity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Violation) HasUnknownSeverity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Violation) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Violation) HasUnknownType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Violation) HasVehicle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Violation) ClearCountryCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CountryCode = nil
+}
+
+func (x *Violation) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+func (x *Violation) ClearDriver() {
+	x.xxx_hidden_Driver = nil
+}
+
+func (x *Violation) ClearSeverity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Severity = Violation_SEVERITY_UNSPECIFIED
+}
+
+func (x *Violation) ClearUnknownSeverity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnknownSeverity = nil
+}
+
+func (x *Violation) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Type = Violation_TYPE_UNSPECIFIED
+}
+
+func (x *Violation) ClearUnknownType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_UnknownType = nil
+}
+
+func (x *Violation) ClearVehicle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Vehicle = nil
+}
+
+type Violation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The country code of the country where the violation occurred.
+	CountryCode *string
+	// The datetime of the violation.
+	Time *timestamppb.Timestamp
+	// The driver who committed the violation.
+	Driver *Violation_Driver
+	// The facts describing the violation.
+	Facts []*Violation_Fact
+	// The severity of the violation.
+	Severity *Violation_Severity
+	// The unknown severity of the violation.
+	// This field is used when the severity is SEVERITY_UNKNOWN.
+	UnknownSeverity *string
+	// The type of the violation.
+	Type *Violation_Type
+	// The unknown type of the violation.
+	// This field is used when the type is TYPE_UNKNOWN.
+	UnknownType *string
+	// The vehicle the driver was driving when the violation occurred.
+	Vehicle *string
+}
+
+func (b0 Violation_builder) Build() *Violation {
+	m0 := &Violation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CountryCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_CountryCode = b.CountryCode
+	}
+	x.xxx_hidden_Time = b.Time
+	x.xxx_hidden_Driver = b.Driver
+	x.xxx_hidden_Facts = &b.Facts
+	if b.Severity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Severity = *b.Severity
+	}
+	if b.UnknownSeverity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_UnknownSeverity = b.UnknownSeverity
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnknownType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_UnknownType = b.UnknownType
+	}
+	if b.Vehicle != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_Vehicle = b.Vehicle
+	}
+	return m0
+}
+
+// The driver who committed a violation.
+type Violation_Driver struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FirstName   *string                `protobuf:"bytes,1,opt,name=first_name,json=firstName"`
+	xxx_hidden_LastName    *string                `protobuf:"bytes,2,opt,name=last_name,json=lastName"`
+	xxx_hidden_CardNumber  *string                `protobuf:"bytes,3,opt,name=card_number,json=cardNumber"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Violation_Driver) Reset() {
+	*x = Violation_Driver{}
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation_Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation_Driver) ProtoMessage() {}
+
+func (x *Violation_Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Violation_Driver) GetFirstName() string {
+	if x != nil {
+		if x.xxx_hidden_FirstName != nil {
+			return *x.xxx_hidden_FirstName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation_Driver) GetLastName() string {
+	if x != nil {
+		if x.xxx_hidden_LastName != nil {
+			return *x.xxx_hidden_LastName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation_Driver) GetCardNumber() string {
+	if x != nil {
+		if x.xxx_hidden_CardNumber != nil {
+			return *x.xxx_hidden_CardNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation_Driver) SetFirstName(v string) {
+	x.xxx_hidden_FirstName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Violation_Driver) SetLastName(v string) {
+	x.xxx_hidden_LastName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *Violation_Driver) SetCardNumber(v string) {
+	x.xxx_hidden_CardNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *Violation_Driver) HasFirstName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Violation_Driver) HasLastName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Violation_Driver) HasCardNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Violation_Driver) ClearFirstName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FirstName = nil
+}
+
+func (x *Violation_Driver) ClearLastName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_LastName = nil
+}
+
+func (x *Violation_Driver) ClearCardNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CardNumber = nil
+}
+
+type Violation_Driver_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The first name of the driver.
+	FirstName *string
+	// The last name of the driver.
+	LastName *string
+	// The tachograph card number of the driver.
+	CardNumber *string
+}
+
+func (b0 Violation_Driver_builder) Build() *Violation_Driver {
+	m0 := &Violation_Driver{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FirstName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_FirstName = b.FirstName
+	}
+	if b.LastName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_LastName = b.LastName
+	}
+	if b.CardNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_CardNumber = b.CardNumber
+	}
+	return m0
+}
+
+// A fact describing a violation.
+type Violation_Fact struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        Violation_Fact_Type    `protobuf:"varint,1,opt,name=type,enum=wayplatform.connect.trusttrack.v1.Violation_Fact_Type"`
+	xxx_hidden_UnknownType *string                `protobuf:"bytes,2,opt,name=unknown_type,json=unknownType"`
+	xxx_hidden_Value       *string                `protobuf:"bytes,3,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Violation_Fact) Reset() {
+	*x = Violation_Fact{}
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation_Fact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation_Fact) ProtoMessage() {}
+
+func (x *Violation_Fact) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Violation_Fact) GetType() Violation_Fact_Type {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return Violation_Fact_TYPE_UNSPECIFIED
+}
+
+func (x *Violation_Fact) GetUnknownType() string {
+	if x != nil {
+		if x.xxx_hidden_UnknownType != nil {
+			return *x.xxx_hidden_UnknownType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation_Fact) GetValue() string {
+	if x != nil {
+		if x.xxx_hidden_Value != nil {
+			return *x.xxx_hidden_Value
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Violation_Fact) SetType(v Violation_Fact_Type) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Violation_Fact) SetUnknownType(v string) {
+	x.xxx_hidden_UnknownType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *Violation_Fact) SetValue(v string) {
+	x.xxx_hidden_Value = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *Violation_Fact) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Violation_Fact) HasUnknownType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Violation_Fact) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Violation_Fact) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = Violation_Fact_TYPE_UNSPECIFIED
+}
+
+func (x *Violation_Fact) ClearUnknownType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnknownType = nil
+}
+
+func (x *Violation_Fact) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Value = nil
+}
+
+type Violation_Fact_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The type of the fact.
+	Type *Violation_Fact_Type
+	// The unknown type of the fact.
+	// This field is used when the type is TYPE_UNKNOWN.
+	UnknownType *string
+	// The value of the fact.
+	Value *string
+}
+
+func (b0 Violation_Fact_builder) Build() *Violation_Fact {
+	m0 := &Violation_Fact{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.UnknownType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_UnknownType = b.UnknownType
+	}
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Value = b.Value
+	}
+	return m0
+}
+
+var File_wayplatform_connect_trusttrack_v1_violation_proto protoreflect.FileDescriptor
+
+const file_wayplatform_connect_trusttrack_v1_violation_proto_rawDesc = "" +
+	"\n" +
+	"1wayplatform/connect/trusttrack/v1/violation.proto\x12!wayplatform.connect.trusttrack.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x0e\n" +
+	"\tViolation\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12;\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02*\x00R\x04time\x12K\n" +
+	"\x06driver\x18\x03 \x01(\v23.wayplatform.connect.trusttrack.v1.Violation.DriverR\x06driver\x12G\n" +
+	"\x05facts\x18\x04 \x03(\v21.wayplatform.connect.trusttrack.v1.Violation.FactR\x05facts\x12]\n" +
+	"\bseverity\x18\x05 \x01(\x0e25.wayplatform.connect.trusttrack.v1.Violation.SeverityB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bseverity\x12)\n" +
+	"\x10unknown_severity\x18\x06 \x01(\tR\x0funknownSeverity\x12Q\n" +
+	"\x04type\x18\a \x01(\x0e21.wayplatform.connect.trusttrack.v1.Violation.TypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12!\n" +
+	"\funknown_type\x18\b \x01(\tR\vunknownType\x12\x18\n" +
+	"\avehicle\x18\t \x01(\tR\avehicle\x1at\n" +
+	"\x06Driver\x12\"\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tB\x03\x80\x01\x01R\tfirstName\x12 \n" +
+	"\tlast_name\x18\x02 \x01(\tB\x03\x80\x01\x01R\blastName\x12$\n" +
+	"\vcard_number\x18\x03 \x01(\tB\x03\x80\x01\x01R\n" +
+	"cardNumber\x1a\xed\x01\n" +
+	"\x04Fact\x12V\n" +
+	"\x04type\x18\x01 \x01(\x0e26.wayplatform.connect.trusttrack.v1.Violation.Fact.TypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12!\n" +
+	"\funknown_type\x18\x02 \x01(\tR\vunknownType\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"T\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x01\x12\x16\n" +
+	"\x12TYPE_NOT_AVAILABLE\x10\x02\x12\f\n" +
+	"\bDURATION\x10\x03\"\xa6\x01\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SEVERITY_UNKNOWN\x10\x01\x12\x1a\n" +
+	"\x16SEVERITY_NOT_AVAILABLE\x10\x02\x12\x12\n" +
+	"\x0eNOT_APPLICABLE\x10\x03\x12\t\n" +
+	"\x05MINOR\x10\x04\x12\v\n" +
+	"\aSERIOUS\x10\x05\x12\x10\n" +
+	"\fVERY_SERIOUS\x10\x06\x12\x10\n" +
+	"\fMOST_SERIOUS\x10\a\"\xa3\x06\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x01\x12\x16\n" +
+	"\x12TYPE_NOT_AVAILABLE\x10\x02\x12\x1b\n" +
+	"\x17WEEKLY_REST_NOT_STARTED\x10\x03\x12$\n" +
+	" INSUFFICIENT_REGULAR_WEEKLY_REST\x10\x04\x12$\n" +
+	" INSUFFICIENT_REDUCED_WEEKLY_REST\x10\x05\x12#\n" +
+	"\x1fINSUFFICIENT_REGULAR_DAILY_REST\x10\x06\x12#\n" +
+	"\x1fINSUFFICIENT_REDUCED_DAILY_REST\x10\a\x12 \n" +
+	"\x1cINSUFFICIENT_CREW_DAILY_REST\x10\b\x12+\n" +
+	"'NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST\x10\t\x12(\n" +
+	"$REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED\x10\n" +
+	"\x12)\n" +
+	"%EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED\x10\v\x12!\n" +
+	"\x1dWEEKLY_DRIVING_LIMIT_EXCEEDED\x10\f\x12&\n" +
+	"\"FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED\x10\r\x12(\n" +
+	"$UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED\x10\x0e\x12\x1e\n" +
+	"\x1aWEEKLY_WORK_LIMIT_EXCEEDED\x10\x0f\x12&\n" +
+	"\"WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED\x10\x10\x12 \n" +
+	"\x1cNO_BREAK_AFTER_SIX_HOUR_WORK\x10\x11\x12(\n" +
+	"$NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK\x10\x12\x12 \n" +
+	"\x1cNO_COUNTRY_SET_ON_WORK_START\x10\x13\x12\x1e\n" +
+	"\x1aNO_COUNTRY_SET_ON_WORK_END\x10\x14\x12#\n" +
+	"\x1fNIGHT_SHIFT_WORK_LIMIT_EXCEEDED\x10\x15\x12\x14\n" +
+	"\x10UNKNOWN_ACTIVITY\x10\x16B\xc1\x02\n" +
+	"%com.wayplatform.connect.trusttrack.v1B\x0eViolationProtoP\x01Zagithub.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1;trusttrackv1\xa2\x02\x03WCT\xaa\x02!Wayplatform.Connect.Trusttrack.V1\xca\x02!Wayplatform\\Connect\\Trusttrack\\V1\xe2\x02-Wayplatform\\Connect\\Trusttrack\\V1\\GPBMetadata\xea\x02$Wayplatform::Connect::Trusttrack::V1b\beditionsp\xe8\a"
+
+var file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_wayplatform_connect_trusttrack_v1_violation_proto_goTypes = []any{
+	(Violation_Severity)(0),       // 0: wayplatform.connect.trusttrack.v1.Violation.Severity
+	(Violation_Type)(0),           // 1: wayplatform.connect.trusttrack.v1.Violation.Type
+	(Violation_Fact_Type)(0),      // 2: wayplatform.connect.trusttrack.v1.Violation.Fact.Type
+	(*Violation)(nil),             // 3: wayplatform.connect.trusttrack.v1.Violation
+	(*Violation_Driver)(nil),      // 4: wayplatform.connect.trusttrack.v1.Violation.Driver
+	(*Violation_Fact)(nil),        // 5: wayplatform.connect.trusttrack.v1.Violation.Fact
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_wayplatform_connect_trusttrack_v1_violation_proto_depIdxs = []int32{
+	6, // 0: wayplatform.connect.trusttrack.v1.Violation.time:type_name -> google.protobuf.Timestamp
+	4, // 1: wayplatform.connect.trusttrack.v1.Violation.driver:type_name -> wayplatform.connect.trusttrack.v1.Violation.Driver
+	5, // 2: wayplatform.connect.trusttrack.v1.Violation.facts:type_name -> wayplatform.connect.trusttrack.v1.Violation.Fact
+	0, // 3: wayplatform.connect.trusttrack.v1.Violation.severity:type_name -> wayplatform.connect.trusttrack.v1.Violation.Severity
+	1, // 4: wayplatform.connect.trusttrack.v1.Violation.type:type_name -> wayplatform.connect.trusttrack.v1.Violation.Type
+	2, // 5: wayplatform.connect.trusttrack.v1.Violation.Fact.type:type_name -> wayplatform.connect.trusttrack.v1.Violation.Fact.Type
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_wayplatform_connect_trusttrack_v1_violation_proto_init() }
+func file_wayplatform_connect_trusttrack_v1_violation_proto_init() {
+	if File_wayplatform_connect_trusttrack_v1_violation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_connect_trusttrack_v1_violation_proto_rawDesc), len(file_wayplatform_connect_trusttrack_v1_violation_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wayplatform_connect_trusttrack_v1_violation_proto_goTypes,
+		DependencyIndexes: file_wayplatform_connect_trusttrack_v1_violation_proto_depIdxs,
+		EnumInfos:         file_wayplatform_connect_trusttrack_v1_violation_proto_enumTypes,
+		MessageInfos:      file_wayplatform_connect_trusttrack_v1_violation_proto_msgTypes,
+	}.Build()
+	File_wayplatform_connect_trusttrack_v1_violation_proto = out.File
+	file_wayplatform_connect_trusttrack_v1_violation_proto_goTypes = nil
+	file_wayplatform_connect_trusttrack_v1_violation_proto_depIdxs = nil
+}
@@ -28,6 +28,9 @@ func coordinateToProto(input *ttoapi.Coordinate) *trusttrackv1.Coordinate {
 	if input.GeozoneIds != nil {
 		output.SetGeozoneIds(input.GeozoneIds)
 	}
+	if input.NearestGeozone != nil {
+		output.SetNearestGeozone(nearestGeozoneToProto(input.NearestGeozone))
+	}
 	if input.Inputs != nil {
 		if input.Inputs.CalculatedInputs != nil {
 			output.SetCalculatedInputs(calculatedInputsToProto(input.Inputs.CalculatedInputs))
@@ -101,3 +104,14 @@ func coordinateTripTypeToProto(input ttoapi.CoordinateTripType) trusttrackv1.Tri
 		return trusttrackv1.TripType_TRIP_TYPE_UNKNOWN
 	}
 }
+
+func nearestGeozoneToProto(input *ttoapi.ExternalNearestGeozone) *trusttrackv1.Coordinate_NearestGeozone {
+	var output trusttrackv1.Coordinate_NearestGeozone
+	if input.ID != nil {
+		output.SetGeozoneId(*input.ID)
+	}
+	if input.Distance != nil {
+		output.SetDistanceM(float64(*input.Distance))
+	}
+	return &output
+}
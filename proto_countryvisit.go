@@ -0,0 +1,82 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// countryVisitToProto converts a country visit of an object.
+// Country visits of drivers have the same shape and can be converted to this type.
+func countryVisitToProto(input *ttoapi.ExternalCountryVisitObject) *trusttrackv1.CountryVisit {
+	var output trusttrackv1.CountryVisit
+	if input.CountryCode != nil {
+		output.SetCountryCode(*input.CountryCode)
+	}
+	if input.EnteredFrom != nil {
+		output.SetEnteredFromCountryCode(*input.EnteredFrom)
+	}
+	if input.ExitedTo != nil {
+		output.SetExitedToCountryCode(*input.ExitedTo)
+	}
+	if input.Start != nil {
+		output.SetStart(countryVisitBoundaryToProto(input.Start))
+	}
+	if input.End != nil {
+		output.SetEnd(countryVisitBoundaryToProto(input.End))
+	}
+	if input.Mileage != nil {
+		output.SetMileageKm(float64(*input.Mileage))
+	}
+	if input.MileageVirtual != nil {
+		output.SetVirtualMileageKm(float64(*input.MileageVirtual))
+	}
+	if input.FuelConsumed != nil {
+		output.SetFuelConsumedL(float64(*input.FuelConsumed))
+	}
+	if input.AverageFuelConsumption != nil {
+		output.SetAverageFuelConsumptionLPer_100Km(float64(*input.AverageFuelConsumption))
+	}
+	if input.WorkingDuration != nil {
+		output.SetWorkingDurationS(float64(*input.WorkingDuration))
+	}
+	if input.IdlingDuration != nil {
+		output.SetIdlingDurationS(float64(*input.IdlingDuration))
+	}
+	if input.StopDuration != nil {
+		output.SetStopDurationS(float64(*input.StopDuration))
+	}
+	if input.DrivingStatusDuration != nil {
+		output.SetDrivingStatusDurationS(float64(*input.DrivingStatusDuration))
+	}
+	if input.WorkingStatusDuration != nil {
+		output.SetWorkingStatusDurationS(float64(*input.WorkingStatusDuration))
+	}
+	if input.RestingStatusDuration != nil {
+		output.SetRestingStatusDurationS(float64(*input.RestingStatusDuration))
+	}
+	if input.AvailableStatusDuration != nil {
+		output.SetAvailableStatusDurationS(float64(*input.AvailableStatusDuration))
+	}
+	output.SetObjectIds(input.ObjectIds)
+	output.SetFirstDriverIds(input.FirstDriverIds)
+	output.SetSecondDriverIds(input.SecondDriverIds)
+	return &output
+}
+
+func countryVisitBoundaryToProto(input *ttoapi.ExternalCountryCoordinate) *trusttrackv1.CountryVisit_Boundary {
+	var output trusttrackv1.CountryVisit_Boundary
+	if input.Datetime != nil {
+		output.SetTime(timestamppb.New(*input.Datetime))
+	}
+	if input.Latitude != nil {
+		output.SetLatitude(float64(*input.Latitude))
+	}
+	if input.Longitude != nil {
+		output.SetLongitude(float64(*input.Longitude))
+	}
+	if input.Address != nil {
+		output.SetAddress(addressToProto(input.Address))
+	}
+	return &output
+}
@@ -0,0 +1,87 @@
+package trusttrack
+
+import (
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func detectedEventToProto(input *ttoapi.DetectedEvent) *trusttrackv1.DetectedEvent {
+	var output trusttrackv1.DetectedEvent
+	if input.Name != nil {
+		output.SetName(*input.Name)
+	}
+	if input.Description != nil {
+		output.SetDescription(*input.Description)
+	}
+	if input.DriverID != nil {
+		output.SetDriverId(*input.DriverID)
+	}
+	if input.TripType != nil {
+		tripType := detectedEventTripTypeToProto(*input.TripType)
+		output.SetTripType(tripType)
+		if tripType == trusttrackv1.TripType_TRIP_TYPE_UNKNOWN {
+			output.SetUnknownTripType(string(*input.TripType))
+		}
+	}
+	if input.Duration != nil {
+		output.SetDurationS(float64(*input.Duration))
+	}
+	if input.Start != nil {
+		output.SetStart(detectedEventMetricsToProto(
+			input.Start.Datetime, input.Start.Location, input.Start.Mileage, input.Start.Speed,
+		))
+	}
+	if input.End != nil {
+		output.SetEnd(detectedEventMetricsToProto(
+			input.End.Datetime, input.End.Location, input.End.Mileage, nil,
+		))
+	}
+	return &output
+}
+
+func detectedEventMetricsToProto(
+	datetime *time.Time,
+	location *ttoapi.Location,
+	mileage *float64,
+	speed *int,
+) *trusttrackv1.DetectedEvent_Metrics {
+	var output trusttrackv1.DetectedEvent_Metrics
+	if datetime != nil {
+		output.SetTime(timestamppb.New(*datetime))
+	}
+	if location != nil {
+		if location.Latitude != nil {
+			output.SetLatitude(*location.Latitude)
+		}
+		if location.Longitude != nil {
+			output.SetLongitude(*location.Longitude)
+		}
+	}
+	if mileage != nil {
+		output.SetMileageKm(*mileage)
+	}
+	if speed != nil {
+		output.SetSpeedKmh(float64(*speed))
+	}
+	return &output
+}
+
+func detectedEventTripTypeToProto(input ttoapi.DetectedEventTripType) trusttrackv1.TripType {
+	switch input {
+	case ttoapi.DetectedEventTripTypeNONE:
+		return trusttrackv1.TripType_TRIP_TYPE_NONE
+	case ttoapi.DetectedEventTripTypePRIVATE:
+		return trusttrackv1.TripType_PRIVATE
+	case ttoapi.DetectedEventTripTypeBUSINESS:
+		return trusttrackv1.TripType_BUSINESS
+	case ttoapi.DetectedEventTripTypeWORK:
+		return trusttrackv1.TripType_WORK
+	case ttoapi.DetectedEventTripTypeUNKNOWN:
+		return trusttrackv1.TripType_TRIP_TYPE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.TripType_TRIP_TYPE_UNKNOWN
+	}
+}
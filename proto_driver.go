@@ -1,6 +1,8 @@
 package trusttrack
 
 import (
+	"fmt"
+
 	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
 	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
 )
@@ -60,3 +62,78 @@ func driverIdentifierTypeToProto(input string) trusttrackv1.DriverIdentifier_Ide
 		return trusttrackv1.DriverIdentifier_IDENTIFIER_TYPE_UNKNOWN
 	}
 }
+
+func driverIdentifierTypeFromProto(input trusttrackv1.DriverIdentifier_IdentifierType) (string, bool) {
+	switch input {
+	case trusttrackv1.DriverIdentifier_DLT:
+		return "DLT", true
+	case trusttrackv1.DriverIdentifier_TACHOGRAPH:
+		return "TACHOGRAPH", true
+	case trusttrackv1.DriverIdentifier_WIRELESS:
+		return "WIRELESS", true
+	case trusttrackv1.DriverIdentifier_IBUTTON:
+		return "IBUTTON", true
+	default:
+		return "", false
+	}
+}
+
+func managementDriverToProto(input *ttoapi.Driver) *trusttrackv1.Driver {
+	var output trusttrackv1.Driver
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.FirstName != nil {
+		output.SetFirstName(*input.FirstName)
+	}
+	if input.LastName != nil {
+		output.SetLastName(*input.LastName)
+	}
+	if input.Address != nil {
+		output.SetAddress(*input.Address)
+	}
+	if input.Phone != nil {
+		output.SetPhone(*input.Phone)
+	}
+	if len(input.Identifiers) > 0 {
+		identifiers := make([]*trusttrackv1.DriverIdentifier, 0, len(input.Identifiers))
+		for _, identifier := range input.Identifiers {
+			identifiers = append(identifiers, driverIdentifierToProto(&ttoapi.V2ExternalIdentifier{
+				Identifier: identifier.Identifier,
+				Type:       (*string)(identifier.Type),
+			}))
+		}
+		output.SetIdentifiers(identifiers)
+	}
+	return &output
+}
+
+func managementDriverFromProto(input *trusttrackv1.Driver) (*ttoapi.Driver, error) {
+	var output ttoapi.Driver
+	if input.HasId() {
+		output.ID = new(input.GetId())
+	}
+	if input.HasFirstName() {
+		output.FirstName = new(input.GetFirstName())
+	}
+	if input.HasLastName() {
+		output.LastName = new(input.GetLastName())
+	}
+	if input.HasAddress() {
+		output.Address = new(input.GetAddress())
+	}
+	if input.HasPhone() {
+		output.Phone = new(input.GetPhone())
+	}
+	for _, identifier := range input.GetIdentifiers() {
+		identifierType, ok := driverIdentifierTypeFromProto(identifier.GetType())
+		if !ok {
+			return nil, fmt.Errorf("unsupported identifier type: %v", identifier.GetType())
+		}
+		output.Identifiers = append(output.Identifiers, ttoapi.ExternalIdentifier{
+			Identifier: new(identifier.GetIdentifier()),
+			Type:       new(ttoapi.ExternalIdentifierType(identifierType)),
+		})
+	}
+	return &output, nil
+}
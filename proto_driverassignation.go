@@ -0,0 +1,56 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func driverAssignationToProto(input *ttoapi.ResponseDriverAssignationEvent) *trusttrackv1.DriverAssignation {
+	var output trusttrackv1.DriverAssignation
+	if input.DriverID != nil {
+		output.SetDriverId(*input.DriverID)
+	}
+	if input.ObjectID != nil {
+		output.SetObjectId(*input.ObjectID)
+	}
+	if input.Datetime != nil {
+		output.SetTime(timestamppb.New(*input.Datetime))
+	}
+	if input.EventType != nil {
+		eventType := driverAssignationEventTypeToProto(*input.EventType)
+		output.SetEventType(eventType)
+		if eventType == trusttrackv1.DriverAssignation_EVENT_TYPE_UNKNOWN {
+			output.SetUnknownEventType(string(*input.EventType))
+		}
+	}
+	return &output
+}
+
+func driverAssignationEventTypeToProto(
+	input ttoapi.ResponseDriverAssignationEventEventType,
+) trusttrackv1.DriverAssignation_EventType {
+	switch input {
+	case ttoapi.ResponseDriverAssignationEventEventTypeSTART:
+		return trusttrackv1.DriverAssignation_START
+	case ttoapi.ResponseDriverAssignationEventEventTypeSTOP:
+		return trusttrackv1.DriverAssignation_STOP
+	case ttoapi.ResponseDriverAssignationEventEventTypeUNKNOWN:
+		return trusttrackv1.DriverAssignation_EVENT_TYPE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.DriverAssignation_EVENT_TYPE_UNKNOWN
+	}
+}
+
+func driverAssignationEventTypeFromProto(
+	input trusttrackv1.DriverAssignation_EventType,
+) (ttoapi.RequestDriverAssignationEventType, bool) {
+	switch input {
+	case trusttrackv1.DriverAssignation_START:
+		return ttoapi.RequestDriverAssignationEventTypeSTART, true
+	case trusttrackv1.DriverAssignation_STOP:
+		return ttoapi.RequestDriverAssignationEventTypeSTOP, true
+	default:
+		return "", false
+	}
+}
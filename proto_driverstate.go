@@ -0,0 +1,69 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func driverStateToProto(driverID string, input *ttoapi.ExternalDriverState) *trusttrackv1.DriverState {
+	var output trusttrackv1.DriverState
+	output.SetDriverId(driverID)
+	if input.ObjectID != nil {
+		output.SetObjectId(*input.ObjectID)
+	}
+	if input.PlateNumber != nil {
+		output.SetPlateNumber(*input.PlateNumber)
+	}
+	if input.Activity != nil {
+		activity := driverStateActivityToProto(*input.Activity)
+		output.SetActivity(activity)
+		if activity == trusttrackv1.DriverState_ACTIVITY_UNKNOWN {
+			output.SetUnknownActivity(string(*input.Activity))
+		}
+	}
+	if input.StartTime != nil {
+		output.SetStartTime(timestamppb.New(*input.StartTime))
+	}
+	if input.EndTime != nil {
+		output.SetEndTime(timestamppb.New(*input.EndTime))
+	}
+	if input.Duration != nil {
+		output.SetDurationS(float64(*input.Duration))
+	}
+	if input.StartPoint != nil {
+		output.SetStartPosition(driverStatePositionToProto(input.StartPoint))
+	}
+	if input.EndPoint != nil {
+		output.SetEndPosition(driverStatePositionToProto(input.EndPoint))
+	}
+	return &output
+}
+
+func driverStatePositionToProto(input *ttoapi.ExternalLatLon) *trusttrackv1.Position {
+	var output trusttrackv1.Position
+	if input.Lat != nil {
+		output.SetLatitude(*input.Lat)
+	}
+	if input.Lon != nil {
+		output.SetLongitude(*input.Lon)
+	}
+	return &output
+}
+
+func driverStateActivityToProto(input ttoapi.ExternalDriverStateActivity) trusttrackv1.DriverState_Activity {
+	switch input {
+	case ttoapi.ExternalDriverStateActivityDRIVING:
+		return trusttrackv1.DriverState_DRIVING
+	case ttoapi.ExternalDriverStateActivityWORK:
+		return trusttrackv1.DriverState_WORKING
+	case ttoapi.ExternalDriverStateActivityAVAILABILITY:
+		return trusttrackv1.DriverState_AVAILABLE
+	case ttoapi.ExternalDriverStateActivityREST:
+		return trusttrackv1.DriverState_RESTING
+	case ttoapi.ExternalDriverStateActivityUNKNOWN:
+		return trusttrackv1.DriverState_ACTIVITY_NOT_AVAILABLE
+	default:
+		return trusttrackv1.DriverState_ACTIVITY_UNKNOWN
+	}
+}
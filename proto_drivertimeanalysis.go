@@ -0,0 +1,223 @@
+package trusttrack
+
+import (
+	"time"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func driverTimeAnalysisToProto(
+	driverID string,
+	input *ttoapi.CurrentDriverInfo,
+) *trusttrackv1.DriverTimeAnalysis {
+	var output trusttrackv1.DriverTimeAnalysis
+	output.SetDriverId(driverID)
+	if input.CalculatedUntil != nil {
+		output.SetCalculatedUntil(timestamppb.New(*input.CalculatedUntil))
+	}
+	if input.Type != nil {
+		role := driverTimeAnalysisRoleToProto(*input.Type)
+		output.SetRole(role)
+		if role == trusttrackv1.DriverTimeAnalysis_ROLE_UNKNOWN {
+			output.SetUnknownRole(string(*input.Type))
+		}
+	}
+	if input.State != nil {
+		output.SetState(driverTimeAnalysisStateToProto(input.State))
+	}
+	if input.CurrentDay != nil {
+		output.SetCurrentDay(driverTimeAnalysisCurrentDayToProto(input.CurrentDay))
+	}
+	if input.CurrentWeek != nil {
+		output.SetCurrentWeek(driverTimeAnalysisCurrentWeekToProto(input.CurrentWeek))
+	}
+	if input.PreviousWeek != nil {
+		var previousWeek trusttrackv1.DriverTimeAnalysis_PreviousWeek
+		if input.PreviousWeek.Driving != nil {
+			previousWeek.SetDriving(limitedDurationToProto(input.PreviousWeek.Driving))
+		}
+		if input.PreviousWeek.Resting != nil && input.PreviousWeek.Resting.Duration != nil {
+			previousWeek.SetResting(secondsToProto(*input.PreviousWeek.Resting.Duration))
+		}
+		output.SetPreviousWeek(&previousWeek)
+	}
+	if input.Infringements != nil {
+		var infringements trusttrackv1.DriverTimeAnalysis_Infringements
+		if d := input.Infringements.DailyDrivingLimitExceeded; d != nil && d.Duration != nil {
+			infringements.SetDailyDrivingLimitExceeded(secondsToProto(*d.Duration))
+		}
+		if d := input.Infringements.WeeklyDrivingLimitExceeded; d != nil && d.Duration != nil {
+			infringements.SetWeeklyDrivingLimitExceeded(secondsToProto(*d.Duration))
+		}
+		output.SetInfringements(&infringements)
+	}
+	if input.DataGapDuration != nil && input.DataGapDuration.Duration != nil {
+		output.SetDataGapDuration(secondsToProto(*input.DataGapDuration.Duration))
+	}
+	if input.LastDriverAssignationEvent != nil {
+		output.SetLastDriverAssignation(driverAssignationToProto(input.LastDriverAssignationEvent))
+	}
+	return &output
+}
+
+func driverTimeAnalysisStateToProto(input *ttoapi.State) *trusttrackv1.DriverTimeAnalysis_State {
+	var output trusttrackv1.DriverTimeAnalysis_State
+	if input.Activity != nil {
+		activity := driverTimeAnalysisActivityToProto(*input.Activity)
+		output.SetActivity(activity)
+		if activity == trusttrackv1.DriverTimeAnalysis_State_ACTIVITY_UNKNOWN {
+			output.SetUnknownActivity(string(*input.Activity))
+		}
+	}
+	if input.StartedAt != nil {
+		output.SetStartTime(timestamppb.New(*input.StartedAt))
+	}
+	if input.Duration != nil {
+		output.SetDuration(secondsToProto(*input.Duration))
+	}
+	return &output
+}
+
+func driverTimeAnalysisCurrentDayToProto(input *ttoapi.CurrentDay) *trusttrackv1.DriverTimeAnalysis_CurrentDay {
+	var output trusttrackv1.DriverTimeAnalysis_CurrentDay
+	if input.DrivingStatus != nil {
+		drivingStatus := driverTimeAnalysisDrivingStatusToProto(*input.DrivingStatus)
+		output.SetDrivingStatus(drivingStatus)
+		if drivingStatus == trusttrackv1.DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNKNOWN {
+			output.SetUnknownDrivingStatus(string(*input.DrivingStatus))
+		}
+	}
+	if input.Driving != nil {
+		if input.Driving.Regular != nil {
+			output.SetRegularDriving(limitedDurationToProto(input.Driving.Regular))
+		}
+		if input.Driving.Extra != nil {
+			output.SetExtendedDriving(limitedDurationToProto(input.Driving.Extra))
+		}
+	}
+	if input.Working != nil && input.Working.Duration != nil {
+		output.SetWorking(secondsToProto(*input.Working.Duration))
+	}
+	if input.Resting != nil {
+		if input.Resting.NextRest != nil {
+			output.SetNextRestTime(timestamppb.New(*input.Resting.NextRest))
+		}
+		if input.Resting.RestInProgress != nil {
+			output.SetRestInProgress(*input.Resting.RestInProgress)
+		}
+	}
+	return &output
+}
+
+func driverTimeAnalysisCurrentWeekToProto(input *ttoapi.CurrentWeek) *trusttrackv1.DriverTimeAnalysis_CurrentWeek {
+	var output trusttrackv1.DriverTimeAnalysis_CurrentWeek
+	if input.Driving != nil {
+		output.SetDriving(limitedDurationToProto(&ttoapi.LimitedDuration{
+			Duration:      input.Driving.Duration,
+			DurationLimit: input.Driving.DurationLimit,
+		}))
+		if input.Driving.ExtendedDailyDrivingPeriods != nil {
+			output.SetExtendedDailyDrivingPeriods(limitedUsageToProto(input.Driving.ExtendedDailyDrivingPeriods))
+		}
+	}
+	if input.Working != nil && input.Working.Duration != nil {
+		output.SetWorking(secondsToProto(*input.Working.Duration))
+	}
+	if input.Resting != nil {
+		if input.Resting.NextRest != nil {
+			output.SetNextRestTime(timestamppb.New(*input.Resting.NextRest))
+		}
+		if input.Resting.NextRestDuration != nil {
+			output.SetNextRestDuration(secondsToProto(*input.Resting.NextRestDuration))
+		}
+		if input.Resting.ReducedDailyRests != nil {
+			output.SetReducedDailyRests(limitedUsageToProto(input.Resting.ReducedDailyRests))
+		}
+		if input.Resting.RestInProgress != nil {
+			output.SetRestInProgress(*input.Resting.RestInProgress)
+		}
+		if input.Resting.StartedAt != nil {
+			output.SetRestStartTime(timestamppb.New(*input.Resting.StartedAt))
+		}
+	}
+	return &output
+}
+
+func limitedDurationToProto(input *ttoapi.LimitedDuration) *trusttrackv1.DriverTimeAnalysis_LimitedDuration {
+	var output trusttrackv1.DriverTimeAnalysis_LimitedDuration
+	if input.Duration != nil {
+		output.SetDuration(secondsToProto(*input.Duration))
+	}
+	if input.DurationLimit != nil {
+		output.SetLimit(secondsToProto(*input.DurationLimit))
+	}
+	return &output
+}
+
+func limitedUsageToProto(input *ttoapi.LimitedUsage) *trusttrackv1.DriverTimeAnalysis_LimitedUsage {
+	var output trusttrackv1.DriverTimeAnalysis_LimitedUsage
+	if input.Used != nil {
+		output.SetUsed(*input.Used)
+	}
+	if input.UsageLimit != nil {
+		output.SetLimit(*input.UsageLimit)
+	}
+	return &output
+}
+
+// secondsToProto converts a duration in seconds, as reported by the API, to a protobuf duration.
+func secondsToProto(seconds int64) *durationpb.Duration {
+	return durationpb.New(time.Duration(seconds) * time.Second)
+}
+
+func driverTimeAnalysisRoleToProto(input ttoapi.CurrentDriverInfoType) trusttrackv1.DriverTimeAnalysis_Role {
+	switch input {
+	case ttoapi.CurrentDriverInfoTypePRIMARY:
+		return trusttrackv1.DriverTimeAnalysis_PRIMARY
+	case ttoapi.CurrentDriverInfoTypeSECONDARY:
+		return trusttrackv1.DriverTimeAnalysis_SECONDARY
+	case ttoapi.CurrentDriverInfoTypeUNKNOWN:
+		return trusttrackv1.DriverTimeAnalysis_ROLE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.DriverTimeAnalysis_ROLE_UNKNOWN
+	}
+}
+
+func driverTimeAnalysisActivityToProto(input ttoapi.StateActivity) trusttrackv1.DriverTimeAnalysis_State_Activity {
+	switch input {
+	case ttoapi.StateActivityRESTING:
+		return trusttrackv1.DriverTimeAnalysis_State_RESTING
+	case ttoapi.StateActivityAVAILABLE:
+		return trusttrackv1.DriverTimeAnalysis_State_AVAILABLE
+	case ttoapi.StateActivityWORKING:
+		return trusttrackv1.DriverTimeAnalysis_State_WORKING
+	case ttoapi.StateActivityDRIVING:
+		return trusttrackv1.DriverTimeAnalysis_State_DRIVING
+	case ttoapi.StateActivityERROR:
+		return trusttrackv1.DriverTimeAnalysis_State_ERROR
+	case ttoapi.StateActivityUNAVAILABLE:
+		return trusttrackv1.DriverTimeAnalysis_State_UNAVAILABLE
+	case ttoapi.StateActivityUNKNOWN:
+		return trusttrackv1.DriverTimeAnalysis_State_ACTIVITY_NOT_AVAILABLE
+	default:
+		return trusttrackv1.DriverTimeAnalysis_State_ACTIVITY_UNKNOWN
+	}
+}
+
+func driverTimeAnalysisDrivingStatusToProto(
+	input ttoapi.CurrentDayDrivingStatus,
+) trusttrackv1.DriverTimeAnalysis_CurrentDay_DrivingStatus {
+	switch input {
+	case ttoapi.CurrentDayDrivingStatusSINGLE:
+		return trusttrackv1.DriverTimeAnalysis_CurrentDay_SINGLE
+	case ttoapi.CurrentDayDrivingStatusCREW:
+		return trusttrackv1.DriverTimeAnalysis_CurrentDay_CREW
+	case ttoapi.CurrentDayDrivingStatusUNKNOWN:
+		return trusttrackv1.DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_NOT_AVAILABLE
+	default:
+		return trusttrackv1.DriverTimeAnalysis_CurrentDay_DRIVING_STATUS_UNKNOWN
+	}
+}
@@ -0,0 +1,54 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func driverTimeTableToProto(input *ttoapi.ExternalDriverTimeTable) *trusttrackv1.DriverTimeTable {
+	var output trusttrackv1.DriverTimeTable
+	if input.DriverName != nil {
+		output.SetDriverName(*input.DriverName)
+	}
+	if input.Identifier != nil {
+		output.SetIdentifier(*input.Identifier)
+	}
+	if input.TimeCardNumber != nil {
+		output.SetTimeCardNumber(*input.TimeCardNumber)
+	}
+	if len(input.Days) > 0 {
+		days := make([]*trusttrackv1.DriverTimeTable_DailyTime, 0, len(input.Days))
+		for _, day := range input.Days {
+			days = append(days, dailyTimeToProto(&day))
+		}
+		output.SetDays(days)
+	}
+	if input.TotalDayWorkingHours != nil {
+		output.SetTotalDayWorkingH(*input.TotalDayWorkingHours)
+	}
+	if input.TotalNightWorkingHours != nil {
+		output.SetTotalNightWorkingH(*input.TotalNightWorkingHours)
+	}
+	if input.TotalWorkingHours != nil {
+		output.SetTotalWorkingH(*input.TotalWorkingHours)
+	}
+	return &output
+}
+
+func dailyTimeToProto(input *ttoapi.ExternalDailyTime) *trusttrackv1.DriverTimeTable_DailyTime {
+	var output trusttrackv1.DriverTimeTable_DailyTime
+	if input.Date != nil {
+		output.SetDate(timestamppb.New(*input.Date))
+	}
+	if input.Holiday != nil {
+		output.SetHoliday(*input.Holiday)
+	}
+	if input.WorkDurationAtDay != nil {
+		output.SetDayWorkDurationS(float64(*input.WorkDurationAtDay))
+	}
+	if input.WorkDurationAtNight != nil {
+		output.SetNightWorkDurationS(float64(*input.WorkDurationAtNight))
+	}
+	return &output
+}
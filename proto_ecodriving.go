@@ -0,0 +1,441 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+func ecodrivingToProto(input *ttoapi.ExternalEcodrivingSubjectParameters) *trusttrackv1.Ecodriving {
+	var output trusttrackv1.Ecodriving
+	if input.SubjectID != nil {
+		output.SetSubjectId(*input.SubjectID)
+	}
+	if input.Parameters == nil {
+		return &output
+	}
+	if input.Parameters.AccelerationParameters != nil {
+		output.SetAcceleration(ecodrivingAccelerationToProto(input.Parameters.AccelerationParameters))
+	}
+	if input.Parameters.BrakingParameters != nil {
+		output.SetBraking(ecodrivingBrakingToProto(input.Parameters.BrakingParameters))
+	}
+	if input.Parameters.CorneringParameters != nil {
+		output.SetCornering(ecodrivingCorneringToProto(input.Parameters.CorneringParameters))
+	}
+	if input.Parameters.CruiseControlParameters != nil {
+		output.SetCruiseControl(ecodrivingCruiseControlToProto(input.Parameters.CruiseControlParameters))
+	}
+	if input.Parameters.EngineParameters != nil {
+		output.SetEngine(ecodrivingEngineToProto(input.Parameters.EngineParameters))
+	}
+	if input.Parameters.IdlingParameters != nil {
+		output.SetIdling(ecodrivingIdlingToProto(input.Parameters.IdlingParameters))
+	}
+	if input.Parameters.MainParameters != nil {
+		output.SetMain(ecodrivingMainToProto(input.Parameters.MainParameters))
+	}
+	if input.Parameters.SpeedParameters != nil {
+		output.SetSpeed(ecodrivingSpeedToProto(input.Parameters.SpeedParameters))
+	}
+	return &output
+}
+
+func ecodrivingAccelerationToProto(input *ttoapi.ExternalAccelerationParameters) *trusttrackv1.Ecodriving_Acceleration {
+	var output trusttrackv1.Ecodriving_Acceleration
+	if input.AccelerationScore != nil {
+		output.SetAccelerationScore(int32(*input.AccelerationScore))
+	}
+	if input.BadAccelerationEventCount != nil {
+		output.SetBadAccelerationEventCount(*input.BadAccelerationEventCount)
+	}
+	if input.BadAccelerationEventRate != nil {
+		output.SetBadAccelerationEventRatePer_100Km(*input.BadAccelerationEventRate)
+	}
+	if input.GoodAccelerationEventCount != nil {
+		output.SetGoodAccelerationEventCount(*input.GoodAccelerationEventCount)
+	}
+	if input.GoodAccelerationEventRate != nil {
+		output.SetGoodAccelerationEventRatePer_100Km(*input.GoodAccelerationEventRate)
+	}
+	if input.HarshAccelerationEventCount != nil {
+		output.SetHarshAccelerationEventCount(*input.HarshAccelerationEventCount)
+	}
+	if input.HarshAccelerationEventRate != nil {
+		output.SetHarshAccelerationEventRatePer_100Km(*input.HarshAccelerationEventRate)
+	}
+	if input.MaximumAcceleration != nil {
+		output.SetMaximumAccelerationMps2(*input.MaximumAcceleration)
+	}
+	return &output
+}
+
+func ecodrivingBrakingToProto(input *ttoapi.ExternalBrakingParameters) *trusttrackv1.Ecodriving_Braking {
+	var output trusttrackv1.Ecodriving_Braking
+	if input.BrakingScore != nil {
+		output.SetBrakingScore(int32(*input.BrakingScore))
+	}
+	if input.BrakingDistance != nil {
+		output.SetBrakingDistanceKm(*input.BrakingDistance)
+	}
+	if input.BrakingDistancePercentage != nil {
+		output.SetBrakingDistancePercent(*input.BrakingDistancePercentage)
+	}
+	if input.BrakingDuration != nil {
+		output.SetBrakingDurationS(*input.BrakingDuration)
+	}
+	if input.EngineBrakingDistance != nil {
+		output.SetEngineBrakingDistanceKm(*input.EngineBrakingDistance)
+	}
+	if input.EngineBrakingDistancePercentage != nil {
+		output.SetEngineBrakingDistancePercent(*input.EngineBrakingDistancePercentage)
+	}
+	if input.NormalBrakingEventCount != nil {
+		output.SetNormalBrakingEventCount(*input.NormalBrakingEventCount)
+	}
+	if input.NormalBrakingEventRate != nil {
+		output.SetNormalBrakingEventRatePer_100Km(*input.NormalBrakingEventRate)
+	}
+	if input.NormalBrakingStopsRate != nil {
+		output.SetNormalBrakingStopsPercent(*input.NormalBrakingStopsRate)
+	}
+	if input.HarshBrakingEventCount != nil {
+		output.SetHarshBrakingEventCount(*input.HarshBrakingEventCount)
+	}
+	if input.HarshBrakingEventRate != nil {
+		output.SetHarshBrakingEventRatePer_100Km(*input.HarshBrakingEventRate)
+	}
+	if input.ExtremeBrakingEventCount != nil {
+		output.SetExtremeBrakingEventCount(*input.ExtremeBrakingEventCount)
+	}
+	if input.ExtremeBrakingEventRate != nil {
+		output.SetExtremeBrakingEventRatePer_100Km(*input.ExtremeBrakingEventRate)
+	}
+	if input.MaximumBraking != nil {
+		output.SetMaximumBrakingMps2(*input.MaximumBraking)
+	}
+	if input.ParkingBrakeAbuseEventCount != nil {
+		output.SetParkingBrakeAbuseEventCount(*input.ParkingBrakeAbuseEventCount)
+	}
+	if input.ParkingBrakeAbuseRate != nil {
+		output.SetParkingBrakeAbuseRatePer_100Km(*input.ParkingBrakeAbuseRate)
+	}
+	if input.RetarderDistance != nil {
+		output.SetRetarderDistanceKm(*input.RetarderDistance)
+	}
+	if input.RetarderDistancePercentage != nil {
+		output.SetRetarderDistancePercent(*input.RetarderDistancePercentage)
+	}
+	if input.RetarderDuration != nil {
+		output.SetRetarderDurationS(*input.RetarderDuration)
+	}
+	if input.RetarderDurationPercentage != nil {
+		output.SetRetarderDurationPercent(*input.RetarderDurationPercentage)
+	}
+	if input.RetarderWithoutCruiseControlDistance != nil {
+		output.SetRetarderWithoutCruiseControlDistanceKm(*input.RetarderWithoutCruiseControlDistance)
+	}
+	if input.RetarderWithoutCruiseControlDistancePercentage != nil {
+		output.SetRetarderWithoutCruiseControlDistancePercent(*input.RetarderWithoutCruiseControlDistancePercentage)
+	}
+	if input.StopsEventCount != nil {
+		output.SetStopsEventCount(*input.StopsEventCount)
+	}
+	if input.StopsRate != nil {
+		output.SetStopsRatePer_100Km(*input.StopsRate)
+	}
+	return &output
+}
+
+func ecodrivingCorneringToProto(input *ttoapi.ExternalCorneringParameters) *trusttrackv1.Ecodriving_Cornering {
+	var output trusttrackv1.Ecodriving_Cornering
+	if input.CorneringEventCount != nil {
+		output.SetCorneringEventCount(*input.CorneringEventCount)
+	}
+	if input.CorneringRate != nil {
+		output.SetCorneringRatePer_100Km(*input.CorneringRate)
+	}
+	if input.MaximumCornering != nil {
+		output.SetMaximumCorneringMps2(*input.MaximumCornering)
+	}
+	return &output
+}
+
+func ecodrivingCruiseControlToProto(input *ttoapi.ExternalCruiseControlParameters) *trusttrackv1.Ecodriving_CruiseControl {
+	var output trusttrackv1.Ecodriving_CruiseControl
+	if input.CruiseControlScore != nil {
+		output.SetCruiseControlScore(int32(*input.CruiseControlScore))
+	}
+	if input.CruiseControlPercentage != nil {
+		output.SetCruiseControlPercent(*input.CruiseControlPercentage)
+	}
+	if input.CruiseControlDistance != nil {
+		output.SetCruiseControlDistanceKm(*input.CruiseControlDistance)
+	}
+	if input.CruiseControlDistancePercentage != nil {
+		output.SetCruiseControlDistancePercent(*input.CruiseControlDistancePercentage)
+	}
+	if input.CruiseControlDistanceFromAllDistancePercentage != nil {
+		output.SetCruiseControlDistanceFromAllDistancePercent(*input.CruiseControlDistanceFromAllDistancePercentage)
+	}
+	if input.CruiseControlDuration != nil {
+		output.SetCruiseControlDurationS(*input.CruiseControlDuration)
+	}
+	if input.CruiseControlDurationPercentage != nil {
+		output.SetCruiseControlDurationPercent(*input.CruiseControlDurationPercentage)
+	}
+	if input.CruiseControlFuelConsumedCount != nil {
+		output.SetCruiseControlFuelConsumedL(*input.CruiseControlFuelConsumedCount)
+	}
+	if input.CruiseControlFuelConsumptionRate != nil {
+		output.SetCruiseControlFuelConsumptionLPer_100Km(*input.CruiseControlFuelConsumptionRate)
+	}
+	if input.CruiseControlCouldBeOnDistance != nil {
+		output.SetCruiseControlCouldBeOnDistanceKm(*input.CruiseControlCouldBeOnDistance)
+	}
+	if input.CruiseControlCouldBeOnDuration != nil {
+		output.SetCruiseControlCouldBeOnDurationS(*input.CruiseControlCouldBeOnDuration)
+	}
+	if input.CruiseControlWasOnDistance != nil {
+		output.SetCruiseControlWasOnDistanceKm(*input.CruiseControlWasOnDistance)
+	}
+	if input.CruiseControlWasOnDuration != nil {
+		output.SetCruiseControlWasOnDurationS(*input.CruiseControlWasOnDuration)
+	}
+	if input.CruiseControlWithAcceleratorDistance != nil {
+		output.SetCruiseControlWithAcceleratorDistanceKm(*input.CruiseControlWithAcceleratorDistance)
+	}
+	if input.CruiseControlWithAcceleratorDistancePercentage != nil {
+		output.SetCruiseControlWithAcceleratorDistancePercent(*input.CruiseControlWithAcceleratorDistancePercentage)
+	}
+	if input.CruiseControlWithAcceleratorDuration != nil {
+		output.SetCruiseControlWithAcceleratorDurationS(*input.CruiseControlWithAcceleratorDuration)
+	}
+	if input.CruiseControlWithAcceleratorDurationPercentage != nil {
+		output.SetCruiseControlWithAcceleratorDurationPercent(*input.CruiseControlWithAcceleratorDurationPercentage)
+	}
+	if input.CruiseControlWithAcceleratorFuelConsumedCount != nil {
+		output.SetCruiseControlWithAcceleratorFuelConsumedL(*input.CruiseControlWithAcceleratorFuelConsumedCount)
+	}
+	if input.CruiseControlWithAcceleratorFuelConsumptionRate != nil {
+		output.SetCruiseControlWithAcceleratorFuelConsumptionLPer_100Km(*input.CruiseControlWithAcceleratorFuelConsumptionRate)
+	}
+	if input.FreeRollingWithCruiseControlDistance != nil {
+		output.SetFreeRollingWithCruiseControlDistanceKm(*input.FreeRollingWithCruiseControlDistance)
+	}
+	if input.FreeRollingWithCruiseControlDistancePercentage != nil {
+		output.SetFreeRollingWithCruiseControlDistancePercent(*input.FreeRollingWithCruiseControlDistancePercentage)
+	}
+	if input.FreeRollingWithCruiseControlFuelConsumedCount != nil {
+		output.SetFreeRollingWithCruiseControlFuelConsumedL(*input.FreeRollingWithCruiseControlFuelConsumedCount)
+	}
+	if input.FreeRollingWithCruiseControlFuelConsumptionRate != nil {
+		output.SetFreeRollingWithCruiseControlFuelConsumptionLPer_100Km(*input.FreeRollingWithCruiseControlFuelConsumptionRate)
+	}
+	return &output
+}
+
+func ecodrivingEngineToProto(input *ttoapi.ExternalEngineParameters) *trusttrackv1.Ecodriving_Engine {
+	var output trusttrackv1.Ecodriving_Engine
+	if input.EngineScore != nil {
+		output.SetEngineScore(int32(*input.EngineScore))
+	}
+	if input.EngineOverloadedDistance != nil {
+		output.SetEngineOverloadedDistanceKm(*input.EngineOverloadedDistance)
+	}
+	if input.EngineOverloadedDistancePercentage != nil {
+		output.SetEngineOverloadedDistancePercent(*input.EngineOverloadedDistancePercentage)
+	}
+	if input.EngineOverloadedFuelConsumedCount != nil {
+		output.SetEngineOverloadedFuelConsumedL(*input.EngineOverloadedFuelConsumedCount)
+	}
+	if input.EngineOverloadedFuelConsumptionRate != nil {
+		output.SetEngineOverloadedFuelConsumptionLPer_100Km(*input.EngineOverloadedFuelConsumptionRate)
+	}
+	if input.FreeRollingDistance != nil {
+		output.SetFreeRollingDistanceKm(*input.FreeRollingDistance)
+	}
+	if input.FreeRollingDistancePercentage != nil {
+		output.SetFreeRollingDistancePercent(*input.FreeRollingDistancePercentage)
+	}
+	if input.FreeRollingWithoutCruiseControlDistance != nil {
+		output.SetFreeRollingWithoutCruiseControlDistanceKm(*input.FreeRollingWithoutCruiseControlDistance)
+	}
+	if input.FreeRollingWithoutCruiseControlDistancePercentage != nil {
+		output.SetFreeRollingWithoutCruiseControlDistancePercent(*input.FreeRollingWithoutCruiseControlDistancePercentage)
+	}
+	if input.FreeRollingWithoutCruiseControlFuelConsumedCount != nil {
+		output.SetFreeRollingWithoutCruiseControlFuelConsumedL(*input.FreeRollingWithoutCruiseControlFuelConsumedCount)
+	}
+	if input.FreeRollingWithoutCruiseControlFuelConsumptionRate != nil {
+		output.SetFreeRollingWithoutCruiseControlFuelConsumptionLPer_100Km(*input.FreeRollingWithoutCruiseControlFuelConsumptionRate)
+	}
+	if input.HighestGearDistance != nil {
+		output.SetHighestGearDistanceKm(*input.HighestGearDistance)
+	}
+	if input.HighestGearDistancePercentage != nil {
+		output.SetHighestGearDistancePercent(*input.HighestGearDistancePercentage)
+	}
+	if input.HighestGearFuelConsumedCount != nil {
+		output.SetHighestGearFuelConsumedL(*input.HighestGearFuelConsumedCount)
+	}
+	if input.HighestGearFuelConsumptionRate != nil {
+		output.SetHighestGearFuelConsumptionLPer_100Km(*input.HighestGearFuelConsumptionRate)
+	}
+	if input.MaximumRpm != nil {
+		output.SetMaximumRpm(*input.MaximumRpm)
+	}
+	if input.RpmInGreenBandDistance != nil {
+		output.SetRpmInGreenBandDistanceKm(*input.RpmInGreenBandDistance)
+	}
+	if input.RpmInRedBandDistance != nil {
+		output.SetRpmInRedBandDistanceKm(*input.RpmInRedBandDistance)
+	}
+	if input.RpmInRedBandDistancePercentage != nil {
+		output.SetRpmInRedBandDistancePercent(*input.RpmInRedBandDistancePercentage)
+	}
+	if input.RpmInRedBandDuration != nil {
+		output.SetRpmInRedBandDurationS(*input.RpmInRedBandDuration)
+	}
+	if input.RpmInRedBandPercentage != nil {
+		output.SetRpmInRedBandPercent(*input.RpmInRedBandPercentage)
+	}
+	if input.RpmRange1Distance != nil {
+		output.SetRpmRange1DistanceKm(*input.RpmRange1Distance)
+	}
+	if input.RpmRange1DistancePercentage != nil {
+		output.SetRpmRange1DistancePercent(*input.RpmRange1DistancePercentage)
+	}
+	if input.RpmRange1FuelConsumedCount != nil {
+		output.SetRpmRange1FuelConsumedL(*input.RpmRange1FuelConsumedCount)
+	}
+	if input.RpmRange1ConsumptionRate != nil {
+		output.SetRpmRange1FuelConsumptionLPer_100Km(*input.RpmRange1ConsumptionRate)
+	}
+	if input.RpmRange2Distance != nil {
+		output.SetRpmRange2DistanceKm(*input.RpmRange2Distance)
+	}
+	if input.RpmRange2DistancePercentage != nil {
+		output.SetRpmRange2DistancePercent(*input.RpmRange2DistancePercentage)
+	}
+	if input.RpmRange2FuelConsumedCount != nil {
+		output.SetRpmRange2FuelConsumedL(*input.RpmRange2FuelConsumedCount)
+	}
+	if input.RpmRange2ConsumptionRate != nil {
+		output.SetRpmRange2FuelConsumptionLPer_100Km(*input.RpmRange2ConsumptionRate)
+	}
+	if input.RpmRange3Distance != nil {
+		output.SetRpmRange3DistanceKm(*input.RpmRange3Distance)
+	}
+	if input.RpmRange3DistancePercentage != nil {
+		output.SetRpmRange3DistancePercent(*input.RpmRange3DistancePercentage)
+	}
+	if input.RpmRange3FuelConsumedCount != nil {
+		output.SetRpmRange3FuelConsumedL(*input.RpmRange3FuelConsumedCount)
+	}
+	if input.RpmRange3ConsumptionRate != nil {
+		output.SetRpmRange3FuelConsumptionLPer_100Km(*input.RpmRange3ConsumptionRate)
+	}
+	if input.RpmRange4Distance != nil {
+		output.SetRpmRange4DistanceKm(*input.RpmRange4Distance)
+	}
+	if input.RpmRange4DistancePercentage != nil {
+		output.SetRpmRange4DistancePercent(*input.RpmRange4DistancePercentage)
+	}
+	if input.RpmRange4FuelConsumedCount != nil {
+		output.SetRpmRange4FuelConsumedL(*input.RpmRange4FuelConsumedCount)
+	}
+	if input.RpmRange4ConsumptionRate != nil {
+		output.SetRpmRange4FuelConsumptionLPer_100Km(*input.RpmRange4ConsumptionRate)
+	}
+	return &output
+}
+
+func ecodrivingIdlingToProto(input *ttoapi.ExternalIdlingParameters) *trusttrackv1.Ecodriving_Idling {
+	var output trusttrackv1.Ecodriving_Idling
+	if input.IdlingScore != nil {
+		output.SetIdlingScore(int32(*input.IdlingScore))
+	}
+	if input.IdlingDuration != nil {
+		output.SetIdlingDurationS(*input.IdlingDuration)
+	}
+	if input.IdlingFuelConsumedCount != nil {
+		output.SetIdlingFuelConsumedL(*input.IdlingFuelConsumedCount)
+	}
+	if input.ExcessIdlingPercentage != nil {
+		output.SetExcessIdlingPercent(*input.ExcessIdlingPercentage)
+	}
+	if input.FuelUsedWhileIdlingPercentage != nil {
+		output.SetFuelUsedWhileIdlingPercent(*input.FuelUsedWhileIdlingPercentage)
+	}
+	return &output
+}
+
+func ecodrivingMainToProto(input *ttoapi.ExternalMainParameters) *trusttrackv1.Ecodriving_Main {
+	var output trusttrackv1.Ecodriving_Main
+	if input.TotalScore != nil {
+		output.SetTotalScore(int32(*input.TotalScore))
+	}
+	if input.Distance != nil {
+		output.SetDistanceKm(*input.Distance)
+	}
+	if input.DrivingDuration != nil {
+		output.SetDrivingDurationS(*input.DrivingDuration)
+	}
+	if input.FuelConsumedCount != nil {
+		output.SetFuelConsumedL(*input.FuelConsumedCount)
+	}
+	if input.FuelConsumptionRate != nil {
+		output.SetFuelConsumptionLPer_100Km(*input.FuelConsumptionRate)
+	}
+	if input.FuelConsumptionByWeight != nil {
+		output.SetFuelConsumptionByWeightLPer_100Tkm(*input.FuelConsumptionByWeight)
+	}
+	if input.AverageWeight != nil {
+		output.SetAverageWeightT(*input.AverageWeight)
+	}
+	if input.AverageGradient != nil {
+		output.SetAverageGradientPercent(*input.AverageGradient)
+	}
+	if input.AverageTurningAngle != nil {
+		output.SetAverageTurningAngleDeg(*input.AverageTurningAngle)
+	}
+	return &output
+}
+
+func ecodrivingSpeedToProto(input *ttoapi.ExternalSpeedParameters) *trusttrackv1.Ecodriving_Speed {
+	var output trusttrackv1.Ecodriving_Speed
+	if input.SpeedScore != nil {
+		output.SetSpeedScore(int32(*input.SpeedScore))
+	}
+	if input.AverageSpeed != nil {
+		output.SetAverageSpeedKmh(*input.AverageSpeed)
+	}
+	if input.AverageDrivingSpeed != nil {
+		output.SetAverageDrivingSpeedKmh(*input.AverageDrivingSpeed)
+	}
+	if input.MaximumSpeed != nil {
+		output.SetMaximumSpeedKmh(*input.MaximumSpeed)
+	}
+	if input.NormalSpeedDistance != nil {
+		output.SetNormalSpeedDistanceKm(*input.NormalSpeedDistance)
+	}
+	if input.OverspeedingDistance != nil {
+		output.SetOverspeedingDistanceKm(*input.OverspeedingDistance)
+	}
+	if input.OverspeedingDistancePercentage != nil {
+		output.SetOverspeedingDistancePercent(*input.OverspeedingDistancePercentage)
+	}
+	if input.OverspeedingDuration != nil {
+		output.SetOverspeedingDurationS(*input.OverspeedingDuration)
+	}
+	if input.OverspeedingPercentage != nil {
+		output.SetOverspeedingPercent(*input.OverspeedingPercentage)
+	}
+	if input.OverspeedingFuelConsumedCount != nil {
+		output.SetOverspeedingFuelConsumedL(*input.OverspeedingFuelConsumedCount)
+	}
+	if input.OverspeedingFuelConsumptionRate != nil {
+		output.SetOverspeedingFuelConsumptionLPer_100Km(*input.OverspeedingFuelConsumptionRate)
+	}
+	return &output
+}
@@ -0,0 +1,100 @@
+package trusttrack
+
+import (
+	"encoding/json"
+
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+func geozoneToProto(input *ttoapi.ExternalGeozone) *trusttrackv1.Geozone {
+	var output trusttrackv1.Geozone
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.Name != nil {
+		output.SetName(*input.Name)
+	}
+	if input.Notes != nil {
+		output.SetNotes(*input.Notes)
+	}
+	if input.Type != nil {
+		geozoneType := geozoneTypeToProto(*input.Type)
+		output.SetType(geozoneType)
+		if geozoneType == trusttrackv1.Geozone_TYPE_UNKNOWN {
+			output.SetUnknownType(string(*input.Type))
+		}
+	}
+	if input.Circle != nil {
+		output.SetCircle(geozoneCircleToProto(input.Circle))
+	}
+	if input.Feature != nil && input.Feature.Geometry != nil {
+		if polygon := geozonePolygonToProto(input.Feature.Geometry); polygon != nil {
+			output.SetPolygon(polygon)
+		}
+	}
+	return &output
+}
+
+func geozoneCircleToProto(input *ttoapi.ExternalCircle) *trusttrackv1.Geozone_Circle {
+	var output trusttrackv1.Geozone_Circle
+	if input.Latitude != nil || input.Longitude != nil {
+		var center trusttrackv1.Geozone_Point
+		if input.Latitude != nil {
+			center.SetLatitude(*input.Latitude)
+		}
+		if input.Longitude != nil {
+			center.SetLongitude(*input.Longitude)
+		}
+		output.SetCenter(&center)
+	}
+	if input.Radius != nil {
+		output.SetRadiusM(*input.Radius)
+	}
+	return &output
+}
+
+// geozonePolygonToProto converts a GeoJSON Polygon geometry to a proto polygon.
+// Returns nil when the geometry is not a polygon or its coordinates can't be parsed.
+func geozonePolygonToProto(input *ttoapi.GeoJSONObject) *trusttrackv1.Geozone_Polygon {
+	if input.Type == nil || *input.Type != "Polygon" || len(input.Coordinates) == 0 {
+		return nil
+	}
+	// GeoJSON positions are [longitude, latitude] pairs.
+	var coordinates [][][]float64
+	if err := json.Unmarshal(input.Coordinates, &coordinates); err != nil {
+		return nil
+	}
+	rings := make([]*trusttrackv1.Geozone_Polygon_Ring, 0, len(coordinates))
+	for _, ringCoordinates := range coordinates {
+		points := make([]*trusttrackv1.Geozone_Point, 0, len(ringCoordinates))
+		for _, position := range ringCoordinates {
+			if len(position) < 2 {
+				continue
+			}
+			var point trusttrackv1.Geozone_Point
+			point.SetLongitude(position[0])
+			point.SetLatitude(position[1])
+			points = append(points, &point)
+		}
+		var ring trusttrackv1.Geozone_Polygon_Ring
+		ring.SetPoints(points)
+		rings = append(rings, &ring)
+	}
+	var output trusttrackv1.Geozone_Polygon
+	output.SetRings(rings)
+	return &output
+}
+
+func geozoneTypeToProto(input ttoapi.ExternalGeozoneType) trusttrackv1.Geozone_Type {
+	switch input {
+	case ttoapi.ExternalGeozoneTypePOINT:
+		return trusttrackv1.Geozone_POINT
+	case ttoapi.ExternalGeozoneTypePOLYGON:
+		return trusttrackv1.Geozone_POLYGON
+	case ttoapi.ExternalGeozoneTypeUNKNOWN:
+		return trusttrackv1.Geozone_TYPE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.Geozone_TYPE_UNKNOWN
+	}
+}
@@ -0,0 +1,68 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func geozoneVisitToProto(input *ttoapi.ExternalGeozoneVisitByObject) *trusttrackv1.GeozoneVisit {
+	var output trusttrackv1.GeozoneVisit
+	if input.ObjectID != nil {
+		output.SetObjectId(*input.ObjectID)
+	}
+	if input.GeozoneID != nil {
+		output.SetGeozoneId(*input.GeozoneID)
+	}
+	if len(input.VisitData) > 0 {
+		events := make([]*trusttrackv1.GeozoneVisit_Event, 0, len(input.VisitData))
+		for _, visitData := range input.VisitData {
+			events = append(events, geozoneVisitEventToProto(&visitData))
+		}
+		output.SetEvents(events)
+	}
+	return &output
+}
+
+func geozoneVisitEventToProto(input *ttoapi.ExternalGeozoneVisitDataItem) *trusttrackv1.GeozoneVisit_Event {
+	var output trusttrackv1.GeozoneVisit_Event
+	if input.Datetime != nil {
+		output.SetTime(timestamppb.New(*input.Datetime))
+	}
+	if input.Direction != nil {
+		direction := geozoneVisitDirectionToProto(*input.Direction)
+		output.SetDirection(direction)
+		if direction == trusttrackv1.GeozoneVisit_Event_DIRECTION_UNKNOWN {
+			output.SetUnknownDirection(string(*input.Direction))
+		}
+	}
+	if input.Latitude != nil {
+		output.SetLatitude(*input.Latitude)
+	}
+	if input.Longitude != nil {
+		output.SetLongitude(*input.Longitude)
+	}
+	if input.Mileage != nil {
+		output.SetMileageKm(*input.Mileage)
+	}
+	if input.FuelConsumed != nil {
+		output.SetFuelConsumedL(*input.FuelConsumed)
+	}
+	if input.FuelLevel != nil {
+		output.SetFuelLevelPercent(*input.FuelLevel)
+	}
+	return &output
+}
+
+func geozoneVisitDirectionToProto(
+	input ttoapi.ExternalGeozoneVisitDataItemDirection,
+) trusttrackv1.GeozoneVisit_Event_Direction {
+	switch input {
+	case ttoapi.ExternalGeozoneVisitDataItemDirectionIN:
+		return trusttrackv1.GeozoneVisit_Event_ENTER
+	case ttoapi.ExternalGeozoneVisitDataItemDirectionOUT:
+		return trusttrackv1.GeozoneVisit_Event_EXIT
+	default:
+		return trusttrackv1.GeozoneVisit_Event_DIRECTION_UNKNOWN
+	}
+}
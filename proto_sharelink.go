@@ -0,0 +1,74 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func shareLinkToProto(input *ttoapi.ExternalShareLink) *trusttrackv1.ShareLink {
+	var output trusttrackv1.ShareLink
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.Domain != nil {
+		output.SetDomain(*input.Domain)
+	}
+	if input.Objects != nil {
+		objects := make([]*trusttrackv1.ShareLink_SharedObject, 0, len(input.Objects))
+		for _, object := range input.Objects {
+			var sharedObject trusttrackv1.ShareLink_SharedObject
+			if object.ID != nil {
+				sharedObject.SetObjectId(*object.ID)
+			}
+			if object.Name != nil {
+				sharedObject.SetName(*object.Name)
+			}
+			objects = append(objects, &sharedObject)
+		}
+		output.SetObjects(objects)
+	}
+	if input.ValidFrom != nil {
+		output.SetValidFromTime(timestamppb.New(*input.ValidFrom))
+	}
+	if input.ExpiresAt != nil {
+		output.SetExpireTime(timestamppb.New(*input.ExpiresAt))
+	}
+	if input.CreatedAt != nil {
+		output.SetCreateTime(timestamppb.New(*input.CreatedAt))
+	}
+	if input.UpdatedAt != nil {
+		output.SetUpdateTime(timestamppb.New(*input.UpdatedAt))
+	}
+	if input.CreatedByUserID != nil {
+		output.SetCreatorUserId(*input.CreatedByUserID)
+	}
+	return &output
+}
+
+func shareLinkCreateFromProto(input *trusttrackv1.ShareLink) *ttoapi.ExternalShareLinkCreate {
+	var output ttoapi.ExternalShareLinkCreate
+	for _, object := range input.GetObjects() {
+		output.Objects = append(output.Objects, ttoapi.ExternalObjectEntityCreate{
+			ID: new(object.GetObjectId()),
+		})
+	}
+	if input.HasValidFromTime() {
+		output.ValidFrom = new(input.GetValidFromTime().AsTime())
+	}
+	if input.HasExpireTime() {
+		output.ExpiresAt = new(input.GetExpireTime().AsTime())
+	}
+	return &output
+}
+
+func shareLinkUpdateFromProto(input *trusttrackv1.ShareLink) *ttoapi.ExternalShareLinkUpdate {
+	var output ttoapi.ExternalShareLinkUpdate
+	if input.HasValidFromTime() {
+		output.ValidFrom = new(input.GetValidFromTime().AsTime())
+	}
+	if input.HasExpireTime() {
+		output.ExpiresAt = new(input.GetExpireTime().AsTime())
+	}
+	return &output
+}
@@ -0,0 +1,305 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func tachoRequestToProto(input *ttoapi.TachoRequestResponse) *trusttrackv1.TachoRequest {
+	var output trusttrackv1.TachoRequest
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.Type != nil {
+		setTachoRequestType(&output, ttoapi.TachoRequestDetailResponseType(*input.Type))
+	}
+	if input.Status != nil {
+		setTachoRequestStatus(&output, ttoapi.TachoRequestDetailResponseStatus(*input.Status))
+	}
+	if input.Error != nil {
+		setTachoRequestError(&output, ttoapi.TachoRequestDetailResponseError(*input.Error))
+	}
+	if input.Origin != nil {
+		origin := tachoRequestOriginToProto(*input.Origin)
+		output.SetOrigin(origin)
+		if origin == trusttrackv1.TachoRequest_ORIGIN_UNKNOWN {
+			output.SetUnknownOrigin(string(*input.Origin))
+		}
+	}
+	if input.ObjectInfo != nil {
+		if input.ObjectInfo.ID != nil {
+			output.SetObjectId(*input.ObjectInfo.ID)
+		}
+		if input.ObjectInfo.Name != nil {
+			output.SetObjectName(*input.ObjectInfo.Name)
+		}
+	}
+	if input.DriverInfo != nil {
+		if input.DriverInfo.CardNumber != nil {
+			output.SetDriverCardNumber(*input.DriverInfo.CardNumber)
+		}
+		if input.DriverInfo.FirstName != nil {
+			output.SetDriverFirstName(*input.DriverInfo.FirstName)
+		}
+		if input.DriverInfo.LastName != nil {
+			output.SetDriverLastName(*input.DriverInfo.LastName)
+		}
+	}
+	if input.CreatedAt != nil {
+		output.SetCreateTime(timestamppb.New(*input.CreatedAt))
+	}
+	if input.UpdatedAt != nil {
+		output.SetUpdateTime(timestamppb.New(*input.UpdatedAt))
+	}
+	if input.UploadFtpStatus != nil {
+		output.SetFtpUploadStatus(tachoRequestUploadStatusToProto(*input.UploadFtpStatus))
+	}
+	if input.UploadRoadSoftStatus != nil {
+		output.SetRoadsoftUploadStatus(tachoRequestUploadStatusToProto(
+			ttoapi.TachoRequestResponseUploadFtpStatus(*input.UploadRoadSoftStatus),
+		))
+	}
+	if input.UploadTisWebStatus != nil {
+		output.SetTiswebUploadStatus(tachoRequestUploadStatusToProto(
+			ttoapi.TachoRequestResponseUploadFtpStatus(*input.UploadTisWebStatus),
+		))
+	}
+	return &output
+}
+
+func tachoRequestDetailToProto(input *ttoapi.TachoRequestDetailResponse) *trusttrackv1.TachoRequest {
+	var output trusttrackv1.TachoRequest
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.Type != nil {
+		setTachoRequestType(&output, *input.Type)
+	}
+	if input.Status != nil {
+		setTachoRequestStatus(&output, *input.Status)
+	}
+	if input.Error != nil {
+		setTachoRequestError(&output, *input.Error)
+	}
+	if input.ExternalObjectID != nil {
+		output.SetObjectId(*input.ExternalObjectID)
+	}
+	if input.FileName != nil {
+		output.SetFileName(*input.FileName)
+	}
+	if input.DataInterval != nil {
+		if input.DataInterval.Start != nil {
+			output.SetDataStartTime(timestamppb.New(*input.DataInterval.Start))
+		}
+		if input.DataInterval.End != nil {
+			output.SetDataEndTime(timestamppb.New(*input.DataInterval.End))
+		}
+	}
+	if input.CreatedAt != nil {
+		output.SetCreateTime(timestamppb.New(*input.CreatedAt))
+	}
+	if input.UpdatedAt != nil {
+		output.SetUpdateTime(timestamppb.New(*input.UpdatedAt))
+	}
+	return &output
+}
+
+func setTachoRequestType(output *trusttrackv1.TachoRequest, input ttoapi.TachoRequestDetailResponseType) {
+	requestType := tachoRequestTypeToProto(input)
+	output.SetType(requestType)
+	if requestType == trusttrackv1.TachoRequest_TYPE_UNKNOWN {
+		output.SetUnknownType(string(input))
+	}
+}
+
+func setTachoRequestStatus(output *trusttrackv1.TachoRequest, input ttoapi.TachoRequestDetailResponseStatus) {
+	status := tachoRequestStatusToProto(input)
+	output.SetStatus(status)
+	if status == trusttrackv1.TachoRequest_STATUS_UNKNOWN {
+		output.SetUnknownStatus(string(input))
+	}
+}
+
+func setTachoRequestError(output *trusttrackv1.TachoRequest, input ttoapi.TachoRequestDetailResponseError) {
+	requestError := tachoRequestErrorToProto(input)
+	output.SetError(requestError)
+	if requestError == trusttrackv1.TachoRequest_ERROR_UNKNOWN {
+		output.SetUnknownError(string(input))
+	}
+}
+
+// isTachoRequestDone reports whether a tachograph download request has reached a final status.
+func isTachoRequestDone(status trusttrackv1.TachoRequest_Status) bool {
+	switch status {
+	case trusttrackv1.TachoRequest_SUCCEEDED,
+		trusttrackv1.TachoRequest_SUCCEEDED_DIRTY,
+		trusttrackv1.TachoRequest_FAILED:
+		return true
+	default:
+		return false
+	}
+}
+
+func tachoRequestTypeToProto(input ttoapi.TachoRequestDetailResponseType) trusttrackv1.TachoRequest_Type {
+	switch input {
+	case ttoapi.TachoRequestDetailResponseTypeTACHODATA:
+		return trusttrackv1.TachoRequest_VEHICLE
+	case ttoapi.TachoRequestDetailResponseTypeDRIVERCARD:
+		return trusttrackv1.TachoRequest_DRIVER_CARD
+	default:
+		return trusttrackv1.TachoRequest_TYPE_UNKNOWN
+	}
+}
+
+func tachoRequestTypeFromProto(input trusttrackv1.TachoRequest_Type) (ttoapi.TachoFilterRequestTypes, bool) {
+	switch input {
+	case trusttrackv1.TachoRequest_VEHICLE:
+		return ttoapi.TachoFilterRequestTypesTACHODATA, true
+	case trusttrackv1.TachoRequest_DRIVER_CARD:
+		return ttoapi.TachoFilterRequestTypesDRIVERCARD, true
+	default:
+		return "", false
+	}
+}
+
+func tachoRequestStatusToProto(input ttoapi.TachoRequestDetailResponseStatus) trusttrackv1.TachoRequest_Status {
+	switch input {
+	case ttoapi.TachoRequestDetailResponseStatusPENDING:
+		return trusttrackv1.TachoRequest_PENDING
+	case ttoapi.TachoRequestDetailResponseStatusAUTHENTICATING:
+		return trusttrackv1.TachoRequest_AUTHENTICATING
+	case ttoapi.TachoRequestDetailResponseStatusAUTHENTICATIONCOMPLETED:
+		return trusttrackv1.TachoRequest_AUTHENTICATION_COMPLETED
+	case ttoapi.TachoRequestDetailResponseStatusDOWNLOADING:
+		return trusttrackv1.TachoRequest_DOWNLOADING
+	case ttoapi.TachoRequestDetailResponseStatusPENDINGVALIDATION:
+		return trusttrackv1.TachoRequest_PENDING_VALIDATION
+	case ttoapi.TachoRequestDetailResponseStatusSUCCEEDED:
+		return trusttrackv1.TachoRequest_SUCCEEDED
+	case ttoapi.TachoRequestDetailResponseStatusSUCCEEDEDDIRTY:
+		return trusttrackv1.TachoRequest_SUCCEEDED_DIRTY
+	case ttoapi.TachoRequestDetailResponseStatusFAILED:
+		return trusttrackv1.TachoRequest_FAILED
+	case ttoapi.TachoRequestDetailResponseStatusUNKNOWN:
+		return trusttrackv1.TachoRequest_STATUS_NOT_AVAILABLE
+	default:
+		return trusttrackv1.TachoRequest_STATUS_UNKNOWN
+	}
+}
+
+func tachoRequestStatusFromProto(input trusttrackv1.TachoRequest_Status) (ttoapi.TachoFilterRequestStatuses, bool) {
+	switch input {
+	case trusttrackv1.TachoRequest_PENDING:
+		return ttoapi.TachoFilterRequestStatusesPENDING, true
+	case trusttrackv1.TachoRequest_AUTHENTICATING:
+		return ttoapi.TachoFilterRequestStatusesAUTHENTICATING, true
+	case trusttrackv1.TachoRequest_AUTHENTICATION_COMPLETED:
+		return ttoapi.TachoFilterRequestStatusesAUTHENTICATIONCOMPLETED, true
+	case trusttrackv1.TachoRequest_DOWNLOADING:
+		return ttoapi.TachoFilterRequestStatusesDOWNLOADING, true
+	case trusttrackv1.TachoRequest_PENDING_VALIDATION:
+		return ttoapi.TachoFilterRequestStatusesPENDINGVALIDATION, true
+	case trusttrackv1.TachoRequest_SUCCEEDED:
+		return ttoapi.TachoFilterRequestStatusesSUCCEEDED, true
+	case trusttrackv1.TachoRequest_SUCCEEDED_DIRTY:
+		return ttoapi.TachoFilterRequestStatusesSUCCEEDEDDIRTY, true
+	case trusttrackv1.TachoRequest_FAILED:
+		return ttoapi.TachoFilterRequestStatusesFAILED, true
+	default:
+		return "", false
+	}
+}
+
+func tachoRequestErrorToProto(input ttoapi.TachoRequestDetailResponseError) trusttrackv1.TachoRequest_Error {
+	switch input {
+	case ttoapi.TachoRequestDetailResponseErrorNONE:
+		return trusttrackv1.TachoRequest_NONE
+	case ttoapi.TachoRequestDetailResponseErrorVALIDATIONFAILURE:
+		return trusttrackv1.TachoRequest_VALIDATION_FAILURE
+	case ttoapi.TachoRequestDetailResponseErrorDEVICECOMMUNICATIONFAILURE:
+		return trusttrackv1.TachoRequest_DEVICE_COMMUNICATION_FAILURE
+	case ttoapi.TachoRequestDetailResponseErrorSERVERFAILURE:
+		return trusttrackv1.TachoRequest_SERVER_FAILURE
+	case ttoapi.TachoRequestDetailResponseErrorAUTHORIZATIONFAILURE:
+		return trusttrackv1.TachoRequest_AUTHORIZATION_FAILURE
+	case ttoapi.TachoRequestDetailResponseErrorNOCARDINSLOT:
+		return trusttrackv1.TachoRequest_NO_CARD_IN_SLOT
+	case ttoapi.TachoRequestDetailResponseErrorUNSPECIFIED:
+		return trusttrackv1.TachoRequest_UNSPECIFIED_FAILURE
+	case ttoapi.TachoRequestDetailResponseErrorEXPIRED:
+		return trusttrackv1.TachoRequest_EXPIRED
+	case ttoapi.TachoRequestDetailResponseErrorDEVICEISBUSY:
+		return trusttrackv1.TachoRequest_DEVICE_IS_BUSY
+	case ttoapi.TachoRequestDetailResponseErrorDSRCABORTED:
+		return trusttrackv1.TachoRequest_DSRC_ABORTED
+	default:
+		return trusttrackv1.TachoRequest_ERROR_UNKNOWN
+	}
+}
+
+func tachoRequestOriginToProto(input ttoapi.TachoRequestResponseOrigin) trusttrackv1.TachoRequest_Origin {
+	switch input {
+	case ttoapi.TachoRequestResponseOriginONDEMAND:
+		return trusttrackv1.TachoRequest_ON_DEMAND
+	case ttoapi.TachoRequestResponseOriginMANUALIMPORT:
+		return trusttrackv1.TachoRequest_MANUAL_IMPORT
+	case ttoapi.TachoRequestResponseOriginSCHEDULER:
+		return trusttrackv1.TachoRequest_SCHEDULER
+	case ttoapi.TachoRequestResponseOriginAPI:
+		return trusttrackv1.TachoRequest_API
+	default:
+		return trusttrackv1.TachoRequest_ORIGIN_UNKNOWN
+	}
+}
+
+func tachoRequestUploadStatusToProto(input ttoapi.TachoRequestResponseUploadFtpStatus) trusttrackv1.TachoRequest_UploadStatus {
+	switch input {
+	case ttoapi.TachoRequestResponseUploadFtpStatusINPROGRESS:
+		return trusttrackv1.TachoRequest_IN_PROGRESS
+	case ttoapi.TachoRequestResponseUploadFtpStatusUPLOADED:
+		return trusttrackv1.TachoRequest_UPLOADED
+	case ttoapi.TachoRequestResponseUploadFtpStatusERROR:
+		return trusttrackv1.TachoRequest_UPLOAD_ERROR
+	case ttoapi.TachoRequestResponseUploadFtpStatusFILEALREADYEXISTSERROR:
+		return trusttrackv1.TachoRequest_FILE_ALREADY_EXISTS_ERROR
+	case ttoapi.TachoRequestResponseUploadFtpStatusACCOUNTNOTVALIDDMMERROR:
+		return trusttrackv1.TachoRequest_ACCOUNT_NOT_VALID_DMM_ERROR
+	case ttoapi.TachoRequestResponseUploadFtpStatusINVALIDACCOUNTERROR:
+		return trusttrackv1.TachoRequest_INVALID_ACCOUNT_ERROR
+	case ttoapi.TachoRequestResponseUploadFtpStatusNOTAUTHORIZEDFORROADSOFT:
+		return trusttrackv1.TachoRequest_NOT_AUTHORIZED_FOR_ROAD_SOFT
+	case ttoapi.TachoRequestResponseUploadFtpStatusNOTAUTHORIZEDFORVDO:
+		return trusttrackv1.TachoRequest_NOT_AUTHORIZED_FOR_VDO
+	case ttoapi.TachoRequestResponseUploadFtpStatusUNKNOWN:
+		return trusttrackv1.TachoRequest_UPLOAD_STATUS_NOT_AVAILABLE
+	default:
+		return trusttrackv1.TachoRequest_UPLOAD_STATUS_UNKNOWN
+	}
+}
+
+func vehicleTachoDownloadOptionFromProto(input trusttrackv1.ScheduleVehicleTachoDownloadRequest_Option) (ttoapi.VehicleTachoScheduleRequestOptions, bool) {
+	switch input {
+	case trusttrackv1.ScheduleVehicleTachoDownloadRequest_FAULTS_AND_EVENTS:
+		return ttoapi.VehicleTachoScheduleRequestOptionsTACHOFILEFAULTSANDEVENTS, true
+	case trusttrackv1.ScheduleVehicleTachoDownloadRequest_DETAILED_SPEED:
+		return ttoapi.VehicleTachoScheduleRequestOptionsTACHOFILEDETAILEDSPEED, true
+	case trusttrackv1.ScheduleVehicleTachoDownloadRequest_TECHNICAL_DATA:
+		return ttoapi.VehicleTachoScheduleRequestOptionsTACHOFILETECHNICALDATA, true
+	case trusttrackv1.ScheduleVehicleTachoDownloadRequest_SINCE_LAST_DOWNLOAD:
+		return ttoapi.VehicleTachoScheduleRequestOptionsSINCELASTDOWNLOAD, true
+	default:
+		return "", false
+	}
+}
+
+func driverCardTachoDownloadSlotFromProto(input trusttrackv1.ScheduleDriverCardTachoDownloadRequest_Slot) (ttoapi.DriverCardTachoScheduleRequestSlot, bool) {
+	switch input {
+	case trusttrackv1.ScheduleDriverCardTachoDownloadRequest_FIRST_SLOT:
+		return ttoapi.DriverCardTachoScheduleRequestSlotDRIVERCARDFIRSTSLOT, true
+	case trusttrackv1.ScheduleDriverCardTachoDownloadRequest_SECOND_SLOT:
+		return ttoapi.DriverCardTachoScheduleRequestSlotDRIVERCARDSECONDSLOT, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,23 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+)
+
+func userToProto(input *ttoapi.ExternalUser) *trusttrackv1.User {
+	var output trusttrackv1.User
+	if input.ID != nil {
+		output.SetId(*input.ID)
+	}
+	if input.Email != nil {
+		output.SetEmail(*input.Email)
+	}
+	if input.FullName != nil {
+		output.SetFullName(*input.FullName)
+	}
+	if input.Phone != nil {
+		output.SetPhone(*input.Phone)
+	}
+	return &output
+}
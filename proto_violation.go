@@ -0,0 +1,219 @@
+package trusttrack
+
+import (
+	"github.com/way-platform/trusttrack-go/internal/oapi/ttoapi"
+	trusttrackv1 "github.com/way-platform/trusttrack-go/proto/gen/go/wayplatform/connect/trusttrack/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func violationToProto(input *ttoapi.ExternalViolation) *trusttrackv1.Violation {
+	var output trusttrackv1.Violation
+	if input.CountryCode != nil {
+		output.SetCountryCode(*input.CountryCode)
+	}
+	if input.DateTime != nil {
+		output.SetTime(timestamppb.New(*input.DateTime))
+	}
+	if input.Driver != nil {
+		output.SetDriver(violationDriverToProto(input.Driver))
+	}
+	if len(input.Facts) > 0 {
+		facts := make([]*trusttrackv1.Violation_Fact, 0, len(input.Facts))
+		for _, fact := range input.Facts {
+			facts = append(facts, violationFactToProto(&fact))
+		}
+		output.SetFacts(facts)
+	}
+	if input.Severity != nil {
+		severity := violationSeverityToProto(*input.Severity)
+		output.SetSeverity(severity)
+		if severity == trusttrackv1.Violation_SEVERITY_UNKNOWN {
+			output.SetUnknownSeverity(string(*input.Severity))
+		}
+	}
+	if input.Type != nil {
+		violationType := violationTypeToProto(*input.Type)
+		output.SetType(violationType)
+		if violationType == trusttrackv1.Violation_TYPE_UNKNOWN {
+			output.SetUnknownType(string(*input.Type))
+		}
+	}
+	if input.Vehicle != nil {
+		output.SetVehicle(*input.Vehicle)
+	}
+	return &output
+}
+
+func violationDriverToProto(input *ttoapi.ExternalDriver) *trusttrackv1.Violation_Driver {
+	var output trusttrackv1.Violation_Driver
+	if input.FirstName != nil {
+		output.SetFirstName(*input.FirstName)
+	}
+	if input.LastName != nil {
+		output.SetLastName(*input.LastName)
+	}
+	if input.Identifier != nil {
+		output.SetCardNumber(*input.Identifier)
+	}
+	return &output
+}
+
+func violationFactToProto(input *ttoapi.ExternalFactualProperty) *trusttrackv1.Violation_Fact {
+	var output trusttrackv1.Violation_Fact
+	if input.Type != nil {
+		factType := violationFactTypeToProto(*input.Type)
+		output.SetType(factType)
+		if factType == trusttrackv1.Violation_Fact_TYPE_UNKNOWN {
+			output.SetUnknownType(string(*input.Type))
+		}
+	}
+	if input.Value != nil {
+		output.SetValue(*input.Value)
+	}
+	return &output
+}
+
+func violationFactTypeToProto(input ttoapi.ExternalFactualPropertyType) trusttrackv1.Violation_Fact_Type {
+	switch input {
+	case ttoapi.ExternalFactualPropertyTypeDURATION:
+		return trusttrackv1.Violation_Fact_DURATION
+	case ttoapi.ExternalFactualPropertyTypeUNKNOWN:
+		return trusttrackv1.Violation_Fact_TYPE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.Violation_Fact_TYPE_UNKNOWN
+	}
+}
+
+func violationSeverityToProto(input ttoapi.ExternalViolationSeverity) trusttrackv1.Violation_Severity {
+	switch input {
+	case ttoapi.ExternalViolationSeverityNOTAPPLICABLE:
+		return trusttrackv1.Violation_NOT_APPLICABLE
+	case ttoapi.ExternalViolationSeverityMINOR:
+		return trusttrackv1.Violation_MINOR
+	case ttoapi.ExternalViolationSeveritySERIOUS:
+		return trusttrackv1.Violation_SERIOUS
+	case ttoapi.ExternalViolationSeverityVERYSERIOUS:
+		return trusttrackv1.Violation_VERY_SERIOUS
+	case ttoapi.ExternalViolationSeverityMOSTSERIOUS:
+		return trusttrackv1.Violation_MOST_SERIOUS
+	case ttoapi.ExternalViolationSeverityUNKNOWN:
+		return trusttrackv1.Violation_SEVERITY_NOT_AVAILABLE
+	default:
+		return trusttrackv1.Violation_SEVERITY_UNKNOWN
+	}
+}
+
+func violationSeverityFromProto(
+	input trusttrackv1.Violation_Severity,
+) (ttoapi.ExternalDriverViolationParamsSeverities, bool) {
+	switch input {
+	case trusttrackv1.Violation_NOT_APPLICABLE:
+		return ttoapi.ExternalDriverViolationParamsSeveritiesNOTAPPLICABLE, true
+	case trusttrackv1.Violation_MINOR:
+		return ttoapi.ExternalDriverViolationParamsSeveritiesMINOR, true
+	case trusttrackv1.Violation_SERIOUS:
+		return ttoapi.ExternalDriverViolationParamsSeveritiesSERIOUS, true
+	case trusttrackv1.Violation_VERY_SERIOUS:
+		return ttoapi.ExternalDriverViolationParamsSeveritiesVERYSERIOUS, true
+	case trusttrackv1.Violation_MOST_SERIOUS:
+		return ttoapi.ExternalDriverViolationParamsSeveritiesMOSTSERIOUS, true
+	default:
+		return "", false
+	}
+}
+
+func violationTypeToProto(input ttoapi.ExternalViolationType) trusttrackv1.Violation_Type {
+	switch input {
+	case ttoapi.ExternalViolationTypeWEEKLYRESTNOTSTARTED:
+		return trusttrackv1.Violation_WEEKLY_REST_NOT_STARTED
+	case ttoapi.ExternalViolationTypeINSUFFICIENTREGULARWEEKLYREST:
+		return trusttrackv1.Violation_INSUFFICIENT_REGULAR_WEEKLY_REST
+	case ttoapi.ExternalViolationTypeINSUFFICIENTREDUCEDWEEKLYREST:
+		return trusttrackv1.Violation_INSUFFICIENT_REDUCED_WEEKLY_REST
+	case ttoapi.ExternalViolationTypeINSUFFICIENTREGULARDAILYREST:
+		return trusttrackv1.Violation_INSUFFICIENT_REGULAR_DAILY_REST
+	case ttoapi.ExternalViolationTypeINSUFFICIENTREDUCEDDAILYREST:
+		return trusttrackv1.Violation_INSUFFICIENT_REDUCED_DAILY_REST
+	case ttoapi.ExternalViolationTypeINSUFFICIENTCREWDAILYREST:
+		return trusttrackv1.Violation_INSUFFICIENT_CREW_DAILY_REST
+	case ttoapi.ExternalViolationTypeNOCOMPENSATIONFORREDUCEDWEEKLYREST:
+		return trusttrackv1.Violation_NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST
+	case ttoapi.ExternalViolationTypeREGULARDAILYDRIVINGLIMITEXCEEDED:
+		return trusttrackv1.Violation_REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeEXTENDEDDAILYDRIVINGLIMITEXCEEDED:
+		return trusttrackv1.Violation_EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeWEEKLYDRIVINGLIMITEXCEEDED:
+		return trusttrackv1.Violation_WEEKLY_DRIVING_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeFORTNIGHTLYDRIVINGLIMITEXCEEDED:
+		return trusttrackv1.Violation_FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeUNINTERRUPTEDDRIVINGLIMITEXCEEDED:
+		return trusttrackv1.Violation_UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeWEEKLYWORKLIMITEXCEEDED:
+		return trusttrackv1.Violation_WEEKLY_WORK_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeWEEKLYWORKAVERAGELIMITEXCEEDED:
+		return trusttrackv1.Violation_WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeNOBREAKAFTERSIXHOURWORK:
+		return trusttrackv1.Violation_NO_BREAK_AFTER_SIX_HOUR_WORK
+	case ttoapi.ExternalViolationTypeNOREDUCEDBREAKAFTERSIXHOURWORK:
+		return trusttrackv1.Violation_NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK
+	case ttoapi.ExternalViolationTypeNOCOUNTRYSETONWORKSTART:
+		return trusttrackv1.Violation_NO_COUNTRY_SET_ON_WORK_START
+	case ttoapi.ExternalViolationTypeNOCOUNTRYSETONWORKEND:
+		return trusttrackv1.Violation_NO_COUNTRY_SET_ON_WORK_END
+	case ttoapi.ExternalViolationTypeNIGHTSHIFTWORKLIMITEXCEEDED:
+		return trusttrackv1.Violation_NIGHT_SHIFT_WORK_LIMIT_EXCEEDED
+	case ttoapi.ExternalViolationTypeUNKNOWNACTIVITY:
+		return trusttrackv1.Violation_UNKNOWN_ACTIVITY
+	case ttoapi.ExternalViolationTypeUNKNOWN:
+		return trusttrackv1.Violation_TYPE_NOT_AVAILABLE
+	default:
+		return trusttrackv1.Violation_TYPE_UNKNOWN
+	}
+}
+
+func violationTypeFromProto(input trusttrackv1.Violation_Type) (ttoapi.ExternalDriverViolationParamsTypes, bool) {
+	switch input {
+	case trusttrackv1.Violation_WEEKLY_REST_NOT_STARTED:
+		return ttoapi.ExternalDriverViolationParamsTypesWEEKLYRESTNOTSTARTED, true
+	case trusttrackv1.Violation_INSUFFICIENT_REGULAR_WEEKLY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesINSUFFICIENTREGULARWEEKLYREST, true
+	case trusttrackv1.Violation_INSUFFICIENT_REDUCED_WEEKLY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesINSUFFICIENTREDUCEDWEEKLYREST, true
+	case trusttrackv1.Violation_INSUFFICIENT_REGULAR_DAILY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesINSUFFICIENTREGULARDAILYREST, true
+	case trusttrackv1.Violation_INSUFFICIENT_REDUCED_DAILY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesINSUFFICIENTREDUCEDDAILYREST, true
+	case trusttrackv1.Violation_INSUFFICIENT_CREW_DAILY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesINSUFFICIENTCREWDAILYREST, true
+	case trusttrackv1.Violation_NO_COMPENSATION_FOR_REDUCED_WEEKLY_REST:
+		return ttoapi.ExternalDriverViolationParamsTypesNOCOMPENSATIONFORREDUCEDWEEKLYREST, true
+	case trusttrackv1.Violation_REGULAR_DAILY_DRIVING_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesREGULARDAILYDRIVINGLIMITEXCEEDED, true
+	case trusttrackv1.Violation_EXTENDED_DAILY_DRIVING_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesEXTENDEDDAILYDRIVINGLIMITEXCEEDED, true
+	case trusttrackv1.Violation_WEEKLY_DRIVING_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesWEEKLYDRIVINGLIMITEXCEEDED, true
+	case trusttrackv1.Violation_FORTNIGHTLY_DRIVING_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesFORTNIGHTLYDRIVINGLIMITEXCEEDED, true
+	case trusttrackv1.Violation_UNINTERRUPTED_DRIVING_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesUNINTERRUPTEDDRIVINGLIMITEXCEEDED, true
+	case trusttrackv1.Violation_WEEKLY_WORK_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesWEEKLYWORKLIMITEXCEEDED, true
+	case trusttrackv1.Violation_WEEKLY_WORK_AVERAGE_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesWEEKLYWORKAVERAGELIMITEXCEEDED, true
+	case trusttrackv1.Violation_NO_BREAK_AFTER_SIX_HOUR_WORK:
+		return ttoapi.ExternalDriverViolationParamsTypesNOBREAKAFTERSIXHOURWORK, true
+	case trusttrackv1.Violation_NO_REDUCED_BREAK_AFTER_SIX_HOUR_WORK:
+		return ttoapi.ExternalDriverViolationParamsTypesNOREDUCEDBREAKAFTERSIXHOURWORK, true
+	case trusttrackv1.Violation_NO_COUNTRY_SET_ON_WORK_START:
+		return ttoapi.ExternalDriverViolationParamsTypesNOCOUNTRYSETONWORKSTART, true
+	case trusttrackv1.Violation_NO_COUNTRY_SET_ON_WORK_END:
+		return ttoapi.ExternalDriverViolationParamsTypesNOCOUNTRYSETONWORKEND, true
+	case trusttrackv1.Violation_NIGHT_SHIFT_WORK_LIMIT_EXCEEDED:
+		return ttoapi.ExternalDriverViolationParamsTypesNIGHTSHIFTWORKLIMITEXCEEDED, true
+	case trusttrackv1.Violation_UNKNOWN_ACTIVITY:
+		return ttoapi.ExternalDriverViolationParamsTypesUNKNOWNACTIVITY, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,272 @@
+package trusttrack
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// RateLimit configures client-side rate limiting of API requests.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests shared by all requests of the client.
+	// Zero means no limit.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests made at once.
+	// Defaults to 1.
+	Burst int
+
+	// Endpoints are additional limits for specific endpoints, keyed by [http.ServeMux]
+	// patterns such as "GET /objects/{id}/coordinates". The global limit also applies.
+	// [NewClient] returns an error for invalid or conflicting patterns.
+	Endpoints map[string]EndpointRateLimit
+}
+
+// EndpointRateLimit configures client-side rate limiting of an endpoint.
+type EndpointRateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests to the endpoint.
+	// Zero means no limit.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests made to the endpoint at once.
+	// Defaults to 1.
+	Burst int
+}
+
+// RateLimitState is a snapshot of the state of the client-side rate limiter.
+type RateLimitState struct {
+	// Tokens is the number of requests that can be made without waiting.
+	// It is negative when requests are waiting for the limiter.
+	Tokens float64
+
+	// EndpointTokens are the tokens of each endpoint limit, keyed by pattern.
+	EndpointTokens map[string]float64
+
+	// PausedUntil is when the pause after a rate limited response ends.
+	// It is zero or in the past when requests are not paused.
+	PausedUntil time.Time
+
+	// RateLimitedCount is the number of rate limited responses received from the API.
+	RateLimitedCount int
+}
+
+// rateLimiter is a token bucket rate limiter shared by all requests of a [Client].
+type rateLimiter struct {
+	global    *tokenBucket
+	endpoints map[string]*tokenBucket
+	mux       *http.ServeMux
+
+	mu               sync.Mutex
+	pausedUntil      time.Time
+	rateLimitedCount int
+}
+
+func newRateLimiter(limit RateLimit) (*rateLimiter, error) {
+	l := rateLimiter{
+		endpoints: map[string]*tokenBucket{},
+		mux:       http.NewServeMux(),
+	}
+	if limit.RequestsPerSecond > 0 {
+		l.global = newTokenBucket(limit.RequestsPerSecond, limit.Burst)
+	}
+	// Patterns are registered in order, so that conflicts are reported consistently.
+	for _, pattern := range slices.Sorted(maps.Keys(limit.Endpoints)) {
+		endpoint := limit.Endpoints[pattern]
+		if endpoint.RequestsPerSecond <= 0 {
+			continue
+		}
+		if err := handleEndpointPattern(l.mux, pattern); err != nil {
+			return nil, err
+		}
+		l.endpoints[pattern] = newTokenBucket(endpoint.RequestsPerSecond, endpoint.Burst)
+	}
+	return &l, nil
+}
+
+// handleEndpointPattern registers the pattern of an endpoint limit with the mux.
+// It returns an error instead of the panic of [http.ServeMux.Handle] for an invalid pattern.
+func handleEndpointPattern(mux *http.ServeMux, pattern string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid endpoint pattern %q: %v", pattern, r)
+		}
+	}()
+	// Only the matched pattern is used, requests are never served by the mux.
+	mux.Handle(pattern, http.NotFoundHandler())
+	return nil
+}
+
+// wait blocks until the request may be sent, or its context is done.
+//
+// The tokens taken for the request are returned when it is not sent, and the request
+// fails immediately when its context deadline is sooner than the wait.
+func (l *rateLimiter) wait(req *http.Request) error {
+	ctx := req.Context()
+	now := time.Now()
+	readyAt := now
+	var reserved []*tokenBucket
+	reserve := func(bucket *tokenBucket) {
+		reserved = append(reserved, bucket)
+		if at := now.Add(bucket.reserve(now)); at.After(readyAt) {
+			readyAt = at
+		}
+	}
+	if l.global != nil {
+		reserve(l.global)
+	}
+	if len(l.endpoints) > 0 {
+		if _, pattern := l.mux.Handler(req); pattern != "" {
+			reserve(l.endpoints[pattern])
+		}
+	}
+	cancel := func() {
+		for _, bucket := range reserved {
+			bucket.cancel(time.Now())
+		}
+	}
+	for {
+		// The pause is checked again after every wait, as it may be extended meanwhile.
+		l.mu.Lock()
+		sendAt := readyAt
+		if l.pausedUntil.After(sendAt) {
+			sendAt = l.pausedUntil
+		}
+		l.mu.Unlock()
+		delay := time.Until(sendAt)
+		if delay <= 0 {
+			return nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(sendAt) {
+			cancel()
+			return fmt.Errorf("rate limit wait of %v would exceed the context deadline: %w", delay, context.DeadlineExceeded)
+		}
+		if err := sleepWithContext(ctx, delay); err != nil {
+			cancel()
+			return err
+		}
+	}
+}
+
+// observe pauses all requests when the response asks the client to slow down.
+func (l *rateLimiter) observe(res *http.Response) {
+	if res.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rateLimitedCount++
+	if retryAfter, ok := parseRetryAfter(res); ok {
+		if until := time.Now().Add(retryAfter); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+}
+
+func (l *rateLimiter) state() RateLimitState {
+	now := time.Now()
+	l.mu.Lock()
+	state := RateLimitState{
+		PausedUntil:      l.pausedUntil,
+		RateLimitedCount: l.rateLimitedCount,
+	}
+	l.mu.Unlock()
+	if l.global != nil {
+		state.Tokens = l.global.available(now)
+	}
+	if len(l.endpoints) > 0 {
+		state.EndpointTokens = make(map[string]float64, len(l.endpoints))
+		for pattern, bucket := range l.endpoints {
+			state.EndpointTokens[pattern] = bucket.available(now)
+		}
+	}
+	return state
+}
+
+// tokenBucket is a token bucket that hands out reservations for future tokens.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	burst = max(burst, 1)
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// reserve takes a token and returns how long to wait until it is available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 || b.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by a reservation for a request that was not sent.
+func (b *tokenBucket) cancel(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// available returns the number of tokens available at the given time.
+func (b *tokenBucket) available(now time.Time) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return b.tokens
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	if now.After(b.last) {
+		b.last = now
+	}
+}
+
+// rateLimitTransport is an HTTP transport that waits for the rate limiter before each request.
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	next    http.RoundTripper
+}
+
+var _ http.RoundTripper = &rateLimitTransport{}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req); err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.limiter.observe(res)
+	return res, nil
+}
+
+// RateLimitState returns a snapshot of the state of the client-side rate limiter.
+// It returns the zero state when rate limiting is not configured with [WithRateLimit].
+func (c *Client) RateLimitState() RateLimitState {
+	if c.config.rateLimiter == nil {
+		return RateLimitState{}
+	}
+	return c.config.rateLimiter.state()
+}
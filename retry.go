@@ -2,6 +2,7 @@ package trusttrack
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/rand"
 	"errors"
@@ -11,16 +12,110 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 )
 
+// RetryPolicy configures how failed API requests are retried.
+//
+// Zero fields use the defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per request, including the first.
+	// Defaults to the retry count set with [WithRetryCount] plus one.
+	MaxAttempts int
+
+	// BaseDelay is the base delay of the exponential backoff between attempts.
+	// Defaults to 250ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the exponential backoff between attempts.
+	// Defaults to 10s.
+	MaxDelay time.Duration
+
+	// MaxElapsed is the maximum total time spent on a request, including delays between attempts.
+	// No retry is made when the next attempt would start after it. Defaults to no limit.
+	MaxElapsed time.Duration
+
+	// RetryableStatusCodes are the HTTP status codes that are retried.
+	// Server errors are only retried for idempotent requests.
+	// A Retry-After header sets the delay of a retry, but never makes other status codes retryable.
+	// Defaults to 429, 500, 502, 503 and 504.
+	RetryableStatusCodes []int
+
+	// ShouldRetry, when set, decides whether a failed attempt is retried instead of the default rules.
+	// Either the response or the error is set.
+	ShouldRetry func(request *http.Request, response *http.Response, err error) bool
+
+	// OnRetry, when set, is called before waiting for the next attempt, e.g. for logging or metrics.
+	OnRetry func(RetryAttempt)
+}
+
+// RetryAttempt describes a failed attempt that is about to be retried.
+type RetryAttempt struct {
+	// Request is the request being retried.
+	Request *http.Request
+
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+
+	// StatusCode is the HTTP status code of the failed attempt, or zero when Err is set.
+	StatusCode int
+
+	// Err is the error of the failed attempt, if any.
+	Err error
+
+	// Delay is the time to wait before the next attempt.
+	Delay time.Duration
+}
+
+// defaultRetryableStatusCodes are the HTTP status codes retried by default.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// withDefaults returns the policy with zero fields set to their defaults.
+func (p RetryPolicy) withDefaults(retryCount int) RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = max(retryCount, 0) + 1
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = defaultBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = defaultMaxDelay
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = defaultRetryableStatusCodes
+	}
+	return p
+}
+
 type retryTransport struct {
-	next http.RoundTripper
+	policy RetryPolicy
+	next   http.RoundTripper
 }
 
+// attemptsKey is the context key of the number of attempts made for a request.
+// It is set on the request of responses returned by the retry transport.
+type attemptsKey struct{}
+
 func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	const maxRetries = 3
+	var attempts int
+	res, err := t.roundTrip(req, &attempts)
+	if res != nil {
+		request := cmp.Or(res.Request, req)
+		res.Request = request.WithContext(context.WithValue(request.Context(), attemptsKey{}, attempts))
+	}
+	return res, err
+}
+
+func (t *retryTransport) roundTrip(req *http.Request, attemptCount *int) (*http.Response, error) {
+	start := time.Now()
 	// if body is present, it must be buffered if there is any chance of a retry
 	// since it can only be consumed once.
 	var br *bytes.Reader
@@ -34,18 +129,30 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		br = bytes.NewReader(buf.Bytes())
 		req.Body = io.NopCloser(br)
 	}
-	var attemptCount int
 	for {
 		res, err := t.next.RoundTrip(req)
-		attemptCount++
-		if attemptCount-1 >= maxRetries {
+		*attemptCount++
+		if *attemptCount >= t.policy.MaxAttempts {
+			return res, err
+		}
+		if !t.shouldRetry(err, req, res) {
+			return res, err
+		}
+		delay := retryDelay(*attemptCount, res, t.policy.BaseDelay, t.policy.MaxDelay)
+		if t.policy.MaxElapsed > 0 && time.Since(start)+delay > t.policy.MaxElapsed {
 			return res, err
 		}
-		shouldRetry := shouldRetry(err, req, res)
-		if !shouldRetry {
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) < delay {
+			// The next attempt would not start before the deadline.
 			return res, err
 		}
-		delay := retryDelay(attemptCount, res)
+		if t.policy.OnRetry != nil {
+			attempt := RetryAttempt{Request: req, Attempt: *attemptCount, Err: err, Delay: delay}
+			if res != nil {
+				attempt.StatusCode = res.StatusCode
+			}
+			t.policy.OnRetry(attempt)
+		}
 		if br != nil {
 			if _, serr := br.Seek(0, 0); serr != nil {
 				return res, fmt.Errorf("error seeking body buffer back to beginning after attempt: %w", serr)
@@ -62,6 +169,13 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 }
 
+func (t *retryTransport) shouldRetry(err error, request *http.Request, response *http.Response) bool {
+	if t.policy.ShouldRetry != nil {
+		return t.policy.ShouldRetry(request, response, err)
+	}
+	return shouldRetry(err, request, response, t.policy.RetryableStatusCodes)
+}
+
 func isDNSErr(err error) bool {
 	var dnse *net.DNSError
 	return errors.As(err, &dnse)
@@ -72,46 +186,57 @@ func isTimeoutErr(err error) bool {
 	return errors.As(err, &netErr) && netErr.Timeout()
 }
 
-func shouldRetry(err error, request *http.Request, response *http.Response) bool {
+func shouldRetry(err error, request *http.Request, response *http.Response, retryableStatusCodes []int) bool {
 	if err != nil {
 		return isDNSErr(err) || (isIdempotent(request) && isTimeoutErr(err))
 	}
-	if response.Header.Get("Retry-After") != "" {
-		return true
+	if !slices.Contains(retryableStatusCodes, response.StatusCode) {
+		return false
 	}
-	switch response.StatusCode {
-	case http.StatusTooManyRequests:
-		return true
-	case http.StatusBadGateway,
-		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout,
-		http.StatusInternalServerError:
+	if response.StatusCode >= 500 {
 		return isIdempotent(request)
-	default:
-		return false
 	}
+	return true
 }
 
-func retryDelay(attempt int, response *http.Response) time.Duration {
+func retryDelay(attempt int, response *http.Response, base, maxDelay time.Duration) time.Duration {
 	if response != nil {
-		if retryAfter := response.Header.Get("Retry-After"); retryAfter != "" {
-			if i, err := strconv.Atoi(retryAfter); err == nil {
-				return addJitter(time.Duration(i) * time.Second)
-			}
-			if t, err := time.Parse(http.TimeFormat, retryAfter); err == nil {
-				return addJitter(time.Until(t))
-			}
+		if retryAfter, ok := parseRetryAfter(response); ok {
+			return addJitter(retryAfter)
 		}
 	}
-	return expBackoff(attempt)
+	return backoff(attempt, base, maxDelay)
 }
 
+// parseRetryAfter returns the delay requested by the Retry-After header of the response, if any.
+func parseRetryAfter(response *http.Response) (time.Duration, bool) {
+	retryAfter := response.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+	if i, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Duration(i) * time.Second, true
+	}
+	if t, err := time.Parse(http.TimeFormat, retryAfter); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// Default backoff between attempts.
+const (
+	defaultBaseDelay = time.Millisecond * 250
+	defaultMaxDelay  = time.Second * 10
+)
+
 func expBackoff(attempt int) time.Duration {
+	return backoff(attempt, defaultBaseDelay, defaultMaxDelay)
+}
+
+func backoff(attempt int, base, maxDelay time.Duration) time.Duration {
 	// based on "full jitter": https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
-	const base = time.Millisecond * 250
-	const cap = time.Second * 10
 	exp := math.Pow(2, float64(attempt-1))
-	v := int64(math.Min(float64(cap), float64(base)*exp))
+	v := int64(math.Min(float64(maxDelay), float64(base)*exp))
 	if v <= 0 {
 		return 0
 	}
@@ -146,6 +271,18 @@ func isIdempotent(req *http.Request) bool {
 	return false
 }
 
+// markSafeToRetry marks a POST request that has no side effects, such as a search query,
+// as safe to retry by setting an Idempotency-Key, which makes [isIdempotent] report true.
+func markSafeToRetry(req *http.Request) {
+	req.Header.Set("Idempotency-Key", newIdempotencyKey())
+}
+
+// newIdempotencyKey returns a random value for the Idempotency-Key header,
+// which marks a non-idempotent request as safe to retry.
+func newIdempotencyKey() string {
+	return rand.Text()
+}
+
 func sleepWithContext(ctx context.Context, duration time.Duration) error {
 	timer := time.NewTimer(duration)
 	defer timer.Stop()
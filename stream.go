@@ -0,0 +1,317 @@
+package trusttrack
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// inProcessTransport is an [http.RoundTripper] that serves requests with an
+// in-process [http.Handler], streaming the response body as it is written.
+//
+// It is used to expose streams decoded by the client as connect server streams.
+type inProcessTransport struct {
+	handler http.Handler
+}
+
+var _ http.RoundTripper = &inProcessTransport{}
+
+// RoundTrip implements [http.RoundTripper].
+func (t *inProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+	pr, pw := io.Pipe()
+	w := &pipeResponseWriter{
+		header:      make(http.Header),
+		body:        pw,
+		wroteHeader: make(chan struct{}),
+	}
+	go func() {
+		defer func() { _ = pw.Close() }()
+		t.handler.ServeHTTP(w, req)
+		w.WriteHeader(http.StatusOK)
+	}()
+	select {
+	case <-w.wroteHeader:
+	case <-ctx.Done():
+		cancel()
+		_ = pr.Close()
+		return nil, ctx.Err()
+	}
+	return &http.Response{
+		Status:        http.StatusText(w.status),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.sentHeader,
+		Body:          &cancelOnCloseReader{ReadCloser: pr, cancel: cancel},
+		ContentLength: -1,
+		Request:       req,
+	}, nil
+}
+
+// pipeResponseWriter is an [http.ResponseWriter] that writes the response body to a pipe.
+type pipeResponseWriter struct {
+	header      http.Header
+	sentHeader  http.Header
+	status      int
+	body        *io.PipeWriter
+	once        sync.Once
+	wroteHeader chan struct{}
+}
+
+var (
+	_ http.ResponseWriter = &pipeResponseWriter{}
+	_ http.Flusher        = &pipeResponseWriter{}
+)
+
+// Header implements [http.ResponseWriter].
+func (w *pipeResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *pipeResponseWriter) WriteHeader(statusCode int) {
+	w.once.Do(func() {
+		w.status = statusCode
+		w.sentHeader = w.header.Clone()
+		close(w.wroteHeader)
+	})
+}
+
+// Write implements [http.ResponseWriter].
+func (w *pipeResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+// Flush implements [http.Flusher].
+// Writes to the pipe are unbuffered, so there is nothing to flush.
+func (w *pipeResponseWriter) Flush() {}
+
+// cancelOnCloseReader cancels the in-process request when the response body is closed.
+type cancelOnCloseReader struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements [io.Closer].
+func (r *cancelOnCloseReader) Close() error {
+	r.cancel()
+	return r.ReadCloser.Close()
+}
+
+// streamDecoder incrementally decodes JSON values from a streamed response body.
+//
+// The format is detected from the first non-whitespace byte of the body:
+//   - '[' is a JSON array, decoded element by element.
+//   - '{' is a sequence of JSON values, such as newline-delimited JSON.
+//   - anything else is a server-sent event stream with JSON data fields.
+type streamDecoder struct {
+	r           *bufio.Reader
+	started     bool
+	json        *json.Decoder
+	array       bool
+	lastEventID string
+}
+
+// malformedValueError is returned by [streamDecoder.Decode] for a value that could not be
+// decoded. The value is consumed, so decoding can continue with the next value.
+type malformedValueError struct {
+	err error
+}
+
+// Error implements [error].
+func (e *malformedValueError) Error() string {
+	return "malformed value: " + e.err.Error()
+}
+
+// Unwrap returns the decoding error.
+func (e *malformedValueError) Unwrap() error {
+	return e.err
+}
+
+func newStreamDecoder(r io.Reader) *streamDecoder {
+	return &streamDecoder{r: bufio.NewReader(r)}
+}
+
+// Decode decodes the next value in the stream into v.
+// It returns [io.EOF] when the stream ends cleanly, and a [*malformedValueError]
+// when the value could not be decoded but the stream can still be read.
+func (d *streamDecoder) Decode(v any) error {
+	if !d.started {
+		if err := d.detect(); err != nil {
+			return err
+		}
+	}
+	if d.json == nil {
+		return d.decodeEvent(v)
+	}
+	if d.array && !d.json.More() {
+		if _, err := d.json.Token(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	// The value is read before it is decoded, so that a value that doesn't decode into v
+	// doesn't stop the stream, unlike a syntax error.
+	var data json.RawMessage
+	if err := d.json.Decode(&data); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return &malformedValueError{err: err}
+	}
+	return nil
+}
+
+// LastEventID returns the ID of the last dispatched server-sent event.
+func (d *streamDecoder) LastEventID() string {
+	return d.lastEventID
+}
+
+func (d *streamDecoder) detect() error {
+	for {
+		b, err := d.r.Peek(1)
+		if err != nil {
+			return err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = d.r.ReadByte()
+			continue
+		case '[':
+			d.json = json.NewDecoder(d.r)
+			if _, err := d.json.Token(); err != nil {
+				return err
+			}
+			d.array = true
+		case '{':
+			d.json = json.NewDecoder(d.r)
+		}
+		d.started = true
+		return nil
+	}
+}
+
+func (d *streamDecoder) decodeEvent(v any) error {
+	var data bytes.Buffer
+	var hasData bool
+	var eventID *string
+	for {
+		line, err := d.r.ReadString('\n')
+		if err != nil && (line == "" || !errors.Is(err, io.EOF)) {
+			// An incomplete event at the end of the stream is discarded.
+			return err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if eventID != nil {
+				d.lastEventID = *eventID
+			}
+			if !hasData || len(bytes.TrimSpace(data.Bytes())) == 0 {
+				// Events without data, such as keep-alives, are skipped.
+				data.Reset()
+				hasData = false
+				continue
+			}
+			if err := json.Unmarshal(data.Bytes(), v); err != nil {
+				return &malformedValueError{err: err}
+			}
+			return nil
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "data":
+			if hasData {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+			hasData = true
+		case "id":
+			eventID = &value
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// doStreaming sends a request whose response body is consumed at the pace of the caller.
+//
+// The client timeout would otherwise include the time the caller spends processing the
+// body, so it applies to receiving the response headers and to each read of the body instead.
+func (cc clientConfig) doStreaming(req *http.Request) (*http.Response, error) {
+	httpClient := cc.httpClient()
+	timeout := httpClient.Timeout
+	httpClient.Timeout = 0
+	ctx, cancel := context.WithCancelCause(req.Context())
+	var timer *time.Timer
+	if timeout > 0 {
+		timer = time.AfterFunc(timeout, func() {
+			cancel(fmt.Errorf("timeout awaiting response headers after %v: %w", timeout, context.DeadlineExceeded))
+		})
+	}
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if timer != nil {
+		timer.Stop()
+	}
+	if err != nil {
+		if ctx.Err() != nil && req.Context().Err() == nil {
+			err = context.Cause(ctx)
+		}
+		cancel(nil)
+		return nil, err
+	}
+	res.Body = &readTimeoutBody{
+		ReadCloser: res.Body,
+		ctx:        ctx,
+		parent:     req.Context(),
+		cancel:     cancel,
+		timeout:    timeout,
+	}
+	return res, nil
+}
+
+// readTimeoutBody is a response body that fails a read blocked for longer than the timeout.
+type readTimeoutBody struct {
+	io.ReadCloser
+	ctx     context.Context
+	parent  context.Context
+	cancel  context.CancelCauseFunc
+	timeout time.Duration
+}
+
+// Read implements [io.Reader].
+func (b *readTimeoutBody) Read(p []byte) (int, error) {
+	if b.timeout > 0 {
+		timer := time.AfterFunc(b.timeout, func() {
+			b.cancel(fmt.Errorf("timeout reading response body after %v: %w", b.timeout, context.DeadlineExceeded))
+		})
+		defer timer.Stop()
+	}
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && b.ctx.Err() != nil && b.parent.Err() == nil {
+		err = context.Cause(b.ctx)
+	}
+	return n, err
+}
+
+// Close implements [io.Closer].
+func (b *readTimeoutBody) Close() error {
+	b.cancel(nil)
+	return b.ReadCloser.Close()
+}